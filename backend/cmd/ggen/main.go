@@ -1,10 +1,10 @@
 package main
 
 import (
-	"go.uber.org/fx"
+	"context"
+	"os"
 
-	"g_gen/internal/di"
-	"g_gen/internal/server"
+	"g_gen/internal/cli"
 )
 
 // Swagger メタデータ
@@ -25,15 +25,14 @@ import (
 
 // @securityDefinitions.basic  BasicAuth
 
+// @securityDefinitions.apikey  BearerAuth
+// @in                          header
+// @name                        Authorization
+// @description                 「Bearer 」に続けてトークンを指定します
+
 // @externalDocs.description  OpenAPI
 // @externalDocs.url          https://swagger.io/resources/open-api/
 
 func main() {
-	app := fx.New(
-		di.Provider(),
-		fx.Invoke(server.RegisterRoutes),
-	)
-
-	// Run the application
-	app.Run()
+	os.Exit(cli.Run(context.Background(), os.Args[1:], os.Stdout, os.Stderr))
 }
@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"g_gen/internal/domain/query"
+	"g_gen/internal/infra/schemacheck"
+)
+
+// runCheck 生成したモデル・クエリとデータベースのスキーマを比較し、差異があれば表示して失敗する
+func runCheck(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs := newFlagSet("check", stderr)
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	return withContainer(ctx, stderr, func(ctx context.Context, c *container) error {
+		conn := c.db.Conn(ctx)
+		report, err := schemacheck.Check(conn, schemacheck.Targets(query.Use(conn)))
+		if err != nil {
+			return err
+		}
+
+		if err := report.Write(stdout); err != nil {
+			return err
+		}
+
+		if len(report.Differences) > 0 {
+			return fmt.Errorf("モデルとデータベースのスキーマが異なります。ggen generate で生成し直すか、マイグレーションを確認してください")
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,187 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"go.uber.org/dig"
+	"go.uber.org/fx"
+
+	"g_gen/internal/di"
+	"g_gen/internal/env"
+	"g_gen/internal/infra/db"
+	"g_gen/internal/infra/logger"
+)
+
+// 終了コード
+const (
+	// ExitOK 正常終了
+	ExitOK = 0
+	// ExitFailure 実行時のエラー（データベース接続、ファイルの読み込みなど）
+	ExitFailure = 1
+	// ExitUsage サブコマンドやフラグの指定誤り
+	ExitUsage = 2
+)
+
+// stopTimeout 終了時にDB接続などを閉じるまでの待ち時間
+const stopTimeout = 15 * time.Second
+
+// command サブコマンド
+type command struct {
+	name    string
+	summary string
+	run     func(ctx context.Context, args []string, stdout, stderr io.Writer) error
+}
+
+func commands() []*command {
+	return []*command{
+		{name: "serve", summary: "APIサーバーを起動する", run: runServe},
+		{name: "seed", summary: "市区町村データを取り込む（stats で件数を集計する）", run: runSeed},
+		{name: "migrate", summary: "マイグレーションを実行する", run: runMigrate},
+		{name: "generate", summary: "データベースからモデルとクエリを生成する", run: runGenerate},
+		{name: "check", summary: "生成したモデルとデータベースのスキーマの差異を確認する", run: runCheck},
+		{name: "scaffold", summary: "テーブルのリポジトリからハンドラーまでを生成する", run: runScaffold},
+		{name: "openapi", summary: "OpenAPIのドキュメントからリクエスト・レスポンスの型とハンドラーのインターフェースを生成する", run: runOpenAPI},
+	}
+}
+
+// usageError サブコマンドやフラグの指定誤り。ExitUsageで終了する
+type usageError struct {
+	err error
+}
+
+func (e *usageError) Error() string { return e.err.Error() }
+
+func (e *usageError) Unwrap() error { return e.err }
+
+func usageErrorf(format string, args ...any) error {
+	return &usageError{err: fmt.Errorf(format, args...)}
+}
+
+// Run 引数のサブコマンドを実行し、終了コードを返す
+func Run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		printUsage(stderr)
+
+		return ExitUsage
+	}
+
+	name := args[0]
+	if name == "-h" || name == "-help" || name == "--help" || name == "help" {
+		printUsage(stdout)
+
+		return ExitOK
+	}
+
+	for _, c := range commands() {
+		if c.name != name {
+			continue
+		}
+
+		return exitCode(c.run(ctx, args[1:], stdout, stderr), stderr)
+	}
+
+	fmt.Fprintf(stderr, "不明なサブコマンドです: %s\n\n", name)
+	printUsage(stderr)
+
+	return ExitUsage
+}
+
+// exitCode エラーを出力し、エラーの種類に応じた終了コードを返す
+func exitCode(err error, stderr io.Writer) int {
+	if err == nil || errors.Is(err, flag.ErrHelp) {
+		return ExitOK
+	}
+
+	fmt.Fprintln(stderr, "エラー:", err)
+
+	var uerr *usageError
+	if errors.As(err, &uerr) {
+		return ExitUsage
+	}
+
+	return ExitFailure
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "使い方: ggen <サブコマンド> [フラグ]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "サブコマンド:")
+	for _, c := range commands() {
+		fmt.Fprintf(w, "  %-10s %s\n", c.name, c.summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "各サブコマンドのフラグは ggen <サブコマンド> -h で確認できます")
+}
+
+// newFlagSet サブコマンドのフラグ。解析エラーは終了させずに呼び出し元へ返す
+func newFlagSet(name string, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet("ggen "+name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	return fs
+}
+
+// parseFlags フラグを解析する。位置引数は受け付けない
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+
+		return &usageError{err: err}
+	}
+
+	if fs.NArg() > 0 {
+		return usageErrorf("不明な引数です: %v", fs.Args())
+	}
+
+	return nil
+}
+
+// container サブコマンドで共有する依存
+type container struct {
+	env    *env.Values
+	logger *logger.Logger
+	db     db.Client
+}
+
+// withContainer fxで共有の依存を組み立ててfnを実行し、終了後にDB接続を閉じる
+// 標準出力は集計結果などの出力に使うため、ログは標準エラーに出力する
+func withContainer(
+	ctx context.Context,
+	stderr io.Writer,
+	fn func(ctx context.Context, c *container) error,
+) error {
+	var c container
+	app := fx.New(
+		di.Core(),
+		fx.Decorate(func() *logger.Logger {
+			cfg := logger.DefaultConfig()
+			cfg.Output = stderr
+
+			return logger.New(cfg)
+		}),
+		fx.Populate(&c.env, &c.logger, &c.db),
+		fx.NopLogger,
+	)
+	if err := app.Err(); err != nil {
+		// 依存の組み立て経路は利用者に不要なため、原因のエラーだけを返す
+		return dig.RootCause(err)
+	}
+
+	if err := app.Start(ctx); err != nil {
+		return err
+	}
+
+	runErr := fn(ctx, &c)
+
+	stopCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), stopTimeout)
+	defer cancel()
+
+	return errors.Join(runErr, app.Stop(stopCtx))
+}
@@ -0,0 +1,130 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"g_gen/internal/cli"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantCode   int
+		wantStdout string
+		wantStderr string
+	}{
+		{
+			name:       "failure/サブコマンドなし",
+			args:       []string{},
+			wantCode:   cli.ExitUsage,
+			wantStderr: "使い方: ggen <サブコマンド> [フラグ]",
+		},
+		{
+			name:       "Success/ヘルプ",
+			args:       []string{"-h"},
+			wantCode:   cli.ExitOK,
+			wantStdout: "  generate   データベースからモデルとクエリを生成する",
+		},
+		{
+			name:       "failure/不明なサブコマンド",
+			args:       []string{"import"},
+			wantCode:   cli.ExitUsage,
+			wantStderr: "不明なサブコマンドです: import",
+		},
+		{
+			name:       "Success/サブコマンドのヘルプ",
+			args:       []string{"seed", "stats", "-h"},
+			wantCode:   cli.ExitOK,
+			wantStderr: "集計の出力先ファイル（- は標準出力）",
+		},
+		{
+			name:       "failure/不明なフラグ",
+			args:       []string{"seed", "-files", "municipalities.csv"},
+			wantCode:   cli.ExitUsage,
+			wantStderr: "flag provided but not defined: -files",
+		},
+		{
+			name:       "failure/位置引数",
+			args:       []string{"serve", "8080"},
+			wantCode:   cli.ExitUsage,
+			wantStderr: "エラー: 不明な引数です: [8080]",
+		},
+		{
+			name:       "failure/スキーマの確認に位置引数",
+			args:       []string{"check", "prefectures"},
+			wantCode:   cli.ExitUsage,
+			wantStderr: "エラー: 不明な引数です: [prefectures]",
+		},
+		{
+			name:       "failure/生成するテーブルの指定なし",
+			args:       []string{"scaffold", "-table", "inspection_sites"},
+			wantCode:   cli.ExitUsage,
+			wantStderr: "エラー: -table と -label を指定してください",
+		},
+		{
+			name:       "failure/生成するテーブルのモデルがない",
+			args:       []string{"scaffold", "-table", "inspection_sites", "-label", "点検箇所", "-dir", "../.."},
+			wantCode:   cli.ExitFailure,
+			wantStderr: "エラー: モデルを読み込めませんでした。ggen generate でモデルを生成してください",
+		},
+		{
+			name:       "Success/生成済みのハンドラーの型がOpenAPIのドキュメントと一致する",
+			args:       []string{"openapi", "-dir", "../..", "-check"},
+			wantCode:   cli.ExitOK,
+			wantStdout: "最新: internal/handler/openapi.gen.go",
+		},
+		{
+			name:       "failure/OpenAPIのドキュメントがない",
+			args:       []string{"openapi", "-dir", "../..", "-spec", "api/swagger.yaml", "-check"},
+			wantCode:   cli.ExitFailure,
+			wantStderr: "エラー: open ../../api/swagger.yaml: no such file or directory",
+		},
+		{
+			name:       "failure/差分の出力形式が正しくない",
+			args:       []string{"seed", "-dry-run", "-diff-format", "xml"},
+			wantCode:   cli.ExitUsage,
+			wantStderr: "エラー: 差分の出力形式が正しくありません: xml",
+		},
+		{
+			name:       "failure/マイグレーションの操作なし",
+			args:       []string{"migrate"},
+			wantCode:   cli.ExitUsage,
+			wantStderr: "エラー: 操作を指定してください (up, down, goto, force, status, lint)",
+		},
+		{
+			name:       "failure/不明なマイグレーションの操作",
+			args:       []string{"migrate", "redo"},
+			wantCode:   cli.ExitUsage,
+			wantStderr: "エラー: 不明な操作です: redo (up, down, goto, force, status, lint)",
+		},
+		{
+			name:       "Success/マイグレーションの検査",
+			args:       []string{"migrate", "lint"},
+			wantCode:   cli.ExitOK,
+			wantStdout: "件のマイグレーションに問題はありません",
+		},
+		{
+			name:       "failure/バージョンが数値でない",
+			args:       []string{"migrate", "goto", "-version", "latest"},
+			wantCode:   cli.ExitUsage,
+			wantStderr: `invalid value "latest" for flag -version`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+
+			code := cli.Run(context.Background(), tt.args, &stdout, &stderr)
+
+			assert.Equal(t, tt.wantCode, code)
+			assert.Contains(t, stdout.String(), tt.wantStdout)
+			assert.Contains(t, stderr.String(), tt.wantStderr)
+		})
+	}
+}
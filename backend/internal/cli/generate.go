@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+
+	"g_gen/internal/infra/modelgen"
+)
+
+// defaultGenerateConfig リレーションの設定ファイルの既定のパス。ファイルがなければ外部キーから導出したリレーションだけを使う
+const defaultGenerateConfig = "./gormgen.yaml"
+
+// runGenerate データベースのテーブルから、外部キーのリレーション付きのモデルとクエリを生成する
+func runGenerate(ctx context.Context, args []string, _, stderr io.Writer) error {
+	flags := newFlagSet("generate", stderr)
+	outPath := flags.String("out", "./internal/domain/query", "クエリの出力先ディレクトリ")
+	configPath := flags.String("config", defaultGenerateConfig, "リレーションを上書き・追加する設定ファイル")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	cfg, err := modelgen.LoadConfig(*configPath)
+	if errors.Is(err, fs.ErrNotExist) && *configPath == defaultGenerateConfig {
+		cfg, err = nil, nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return withContainer(ctx, stderr, func(ctx context.Context, c *container) error {
+		return modelgen.Generate(c.db.Conn(ctx), *outPath, cfg)
+	})
+}
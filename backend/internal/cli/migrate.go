@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"g_gen/internal/infra/migration"
+	"g_gen/migrations"
+)
+
+// runMigrate バイナリに埋め込んだマイグレーションを適用・ロールバックする
+func runMigrate(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	if len(args) == 0 {
+		return usageErrorf("操作を指定してください (up, down, goto, force, status, lint)")
+	}
+
+	action, args := args[0], args[1:]
+	fs := newFlagSet("migrate "+action, stderr)
+
+	// lintはデータベースに接続せずに、埋め込んだマイグレーションファイルだけを検査する
+	if action == "lint" {
+		if err := parseFlags(fs, args); err != nil {
+			return err
+		}
+
+		return lintMigrations(stdout)
+	}
+
+	var run func(ctx context.Context, m *migration.Migrator) error
+	switch action {
+	case "up":
+		run = func(ctx context.Context, m *migration.Migrator) error {
+			return m.Up(ctx)
+		}
+	case "down":
+		steps := fs.Int("steps", 1, "ロールバックする件数")
+		run = func(ctx context.Context, m *migration.Migrator) error {
+			return m.Down(ctx, *steps)
+		}
+	case "goto":
+		version := fs.Uint64("version", 0, "適用またはロールバックする先のバージョン（0はすべてロールバック）")
+		run = func(ctx context.Context, m *migration.Migrator) error {
+			return m.Goto(ctx, *version)
+		}
+	case "force":
+		version := fs.Uint64("version", 0, "SQLを実行せずに記録する適用済みのバージョン")
+		run = func(ctx context.Context, m *migration.Migrator) error {
+			return m.Force(ctx, *version)
+		}
+	case "status":
+		run = func(ctx context.Context, m *migration.Migrator) error {
+			status, err := m.Status(ctx)
+			if err != nil {
+				return err
+			}
+
+			return writeMigrationStatus(stdout, status)
+		}
+	default:
+		return usageErrorf("不明な操作です: %s (up, down, goto, force, status, lint)", action)
+	}
+
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	return withContainer(ctx, stderr, func(ctx context.Context, c *container) error {
+		m, err := migration.New(c.db, migrations.FS, c.logger)
+		if err != nil {
+			return err
+		}
+
+		return run(ctx, m)
+	})
+}
+
+// lintMigrations 埋め込んだアップマイグレーションに注記のない破壊的な文がないか検査する
+func lintMigrations(stdout io.Writer) error {
+	all, err := migration.Load(migrations.FS)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, m := range all {
+		errs = append(errs, migration.Lint(m))
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("アップマイグレーションに破壊的な文があります:\n%w", err)
+	}
+
+	fmt.Fprintf(stdout, "%d件のマイグレーションに問題はありません\n", len(all))
+
+	return nil
+}
+
+// writeMigrationStatus 適用状況を表形式で書き出す
+func writeMigrationStatus(w io.Writer, status *migration.Status) error {
+	fmt.Fprintf(w, "現在のバージョン: %d", status.Version)
+	if status.Dirty {
+		fmt.Fprint(w, "（途中で失敗しています）")
+	}
+	fmt.Fprintln(w)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "VERSION\tNAME\tSTATUS")
+	for _, m := range status.Migrations {
+		state := "未適用"
+		if m.Applied {
+			state = "適用済み"
+		}
+		fmt.Fprintf(tw, "%06d\t%s\t%s\n", m.Version, m.Name, state)
+	}
+
+	return tw.Flush()
+}
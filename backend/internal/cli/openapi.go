@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"g_gen/internal/openapigen"
+)
+
+// runOpenAPI OpenAPIのドキュメントからリクエスト・レスポンスの型とハンドラーのインターフェースを生成する
+// -check の場合は書き込まず、生成済みのファイルがドキュメントと一致しなければ失敗する
+func runOpenAPI(_ context.Context, args []string, stdout, stderr io.Writer) error {
+	flags := newFlagSet("openapi", stderr)
+	dir := flags.String("dir", ".", "backendディレクトリ")
+	spec := flags.String("spec", "api/openapi.yaml", "OpenAPIのドキュメント（-dir からの相対パス）")
+	out := flags.String("out", "internal/handler/openapi.gen.go", "出力先のファイル（-dir からの相対パス）")
+	pkg := flags.String("package", "handler", "出力先のパッケージ名")
+	check := flags.Bool("check", false, "書き込まずに、生成済みのファイルがドキュメントと一致するか確認する")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	doc, err := openapigen.Load(filepath.Join(*dir, *spec))
+	if err != nil {
+		return err
+	}
+
+	src, err := openapigen.Generate(doc, openapigen.Options{Package: *pkg, Source: filepath.ToSlash(filepath.Clean(*spec))})
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(*dir, *out)
+	current, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	if *check {
+		if !bytes.Equal(current, src) {
+			return fmt.Errorf("%s が %s と一致しません。ggen openapi で生成し直してください", *out, *spec)
+		}
+		fmt.Fprintln(stdout, "最新:", *out)
+
+		return nil
+	}
+
+	if bytes.Equal(current, src) {
+		fmt.Fprintln(stdout, "変更なし:", *out)
+
+		return nil
+	}
+
+	if err := os.WriteFile(path, src, 0o644); err != nil {
+		return err
+	}
+	fmt.Fprintln(stdout, "生成:", *out)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"g_gen/internal/scaffold"
+)
+
+// runScaffold ggen generate で生成したテーブルのモデルから、リポジトリからハンドラーまでの縦割りを生成して登録する
+func runScaffold(_ context.Context, args []string, stdout, stderr io.Writer) error {
+	flags := newFlagSet("scaffold", stderr)
+	table := flags.String("table", "", "生成するテーブル名（ggen generate で生成したモデルが必要）")
+	label := flags.String("label", "", "APIドキュメントやエラーメッセージに使う日本語名（例: 被害報告）")
+	dir := flags.String("dir", ".", "backendディレクトリ")
+	force := flags.Bool("force", false, "生成するファイルが既にある場合は上書きする")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	if *table == "" || *label == "" {
+		return usageErrorf("-table と -label を指定してください")
+	}
+
+	s, err := scaffold.Load(*dir, *table, *label)
+	if err != nil {
+		return err
+	}
+
+	result, err := scaffold.Generate(*dir, s, *force)
+	if err != nil {
+		return err
+	}
+
+	for _, path := range result.Created {
+		fmt.Fprintln(stdout, "生成:", path)
+	}
+	for _, path := range result.Updated {
+		fmt.Fprintln(stdout, "登録:", path)
+	}
+	fmt.Fprintln(stdout)
+	fmt.Fprintln(stdout, "make mockgen でモックを、make swag でAPIドキュメントを生成してください")
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"g_gen/internal/importer"
+	"g_gen/internal/infra/datastore"
+)
+
+// defaultSeedFile 取り込むファイルの既定値（backendディレクトリからの相対パス）
+const defaultSeedFile = "cmd/seed/municipality/municipalities.csv"
+
+// runSeed 市区町村データを取り込む。-dry-run の場合は差分だけを出力する
+func runSeed(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	if len(args) > 0 && args[0] == "stats" {
+		return runSeedStats(ctx, args[1:], stdout, stderr)
+	}
+
+	fs := newFlagSet("seed", stderr)
+	filePath := fs.String("file", defaultSeedFile, "取り込むファイルのパス")
+	format := fs.String("format", "", "ファイルの形式（csv または xlsx。省略時は拡張子から判定）")
+	encoding := fs.String("encoding", "utf-8", "CSVファイルの文字コード（utf-8 または shift_jis）")
+	sheet := fs.String("sheet", "", "xlsxファイルのシート名（省略時は先頭のシート）")
+	dryRun := fs.Bool("dry-run", false, "データベースを更新せず、現在のテーブルとの差分だけを出力する")
+	diffFormat := fs.String("diff-format", "json", "差分の出力形式（json または csv）")
+	diffOutput := fs.String("diff-output", "-", "差分の出力先ファイル（- は標準出力）")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if *diffFormat != "json" && *diffFormat != "csv" {
+		return usageErrorf("差分の出力形式が正しくありません: %s", *diffFormat)
+	}
+
+	source := importer.Source{
+		Path:     *filePath,
+		Format:   importer.SourceFormat(*format),
+		Encoding: importer.SourceEncoding(*encoding),
+		Sheet:    *sheet,
+	}
+
+	return withContainer(ctx, stderr, func(ctx context.Context, c *container) error {
+		municipalityImporter := importer.NewMunicipalityImporter(ctx, c.db, c.logger)
+
+		if *dryRun {
+			report, err := municipalityImporter.Diff(source)
+			if err != nil {
+				return fmt.Errorf("差分の作成に失敗しました: %w", err)
+			}
+
+			if err := writeDiff(report, *diffFormat, *diffOutput, stdout); err != nil {
+				return fmt.Errorf("差分の出力に失敗しました: %w", err)
+			}
+
+			c.logger.Info(
+				"ドライランが完了しました（データベースは更新していません）",
+				"inserted", report.Summary.Inserted,
+				"updated", report.Summary.Updated,
+				"deactivated", report.Summary.Deactivated,
+				"unchanged", report.Summary.Unchanged,
+				"skipped", report.Summary.Skipped,
+			)
+
+			return nil
+		}
+
+		summary, err := municipalityImporter.Import(source)
+		if err != nil {
+			return fmt.Errorf("インポートに失敗しました: %w", err)
+		}
+
+		c.logger.Info(
+			"インポートが完了しました",
+			"inserted", summary.Inserted,
+			"updated", summary.Updated,
+			"deactivated", summary.Deactivated,
+			"unchanged", summary.Unchanged,
+			"skipped", summary.Skipped,
+		)
+
+		return nil
+	})
+}
+
+// runSeedStats インポート後のデータ確認のため、市区町村テーブルの件数の集計をJSONで出力する
+func runSeedStats(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs := newFlagSet("seed stats", stderr)
+	output := fs.String("output", "-", "集計の出力先ファイル（- は標準出力）")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	return withContainer(ctx, stderr, func(ctx context.Context, c *container) error {
+		stats, err := datastore.NewMunicipalityRepository(ctx, c.db).Statistics(ctx)
+		if err != nil {
+			return fmt.Errorf("集計に失敗しました: %w", err)
+		}
+
+		w, closeOutput, err := openOutput(*output, stdout)
+		if err != nil {
+			return err
+		}
+		defer closeOutput()
+
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(stats); err != nil {
+			return fmt.Errorf("集計の出力に失敗しました: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// openOutput 出力先ファイルを開く。- の場合は標準出力を返す
+func openOutput(output string, stdout io.Writer) (io.Writer, func(), error) {
+	if output == "-" {
+		return stdout, func() {}, nil
+	}
+
+	file, err := os.Create(output)
+	if err != nil {
+		return nil, nil, fmt.Errorf("出力先ファイルを作成できませんでした: %w", err)
+	}
+
+	return file, func() { file.Close() }, nil
+}
+
+// writeDiff 差分を指定した形式で出力先に書き出す
+func writeDiff(report *importer.DiffReport, format, output string, stdout io.Writer) error {
+	w, closeOutput, err := openOutput(output, stdout)
+	if err != nil {
+		return err
+	}
+	defer closeOutput()
+
+	if format == "csv" {
+		return report.WriteCSV(w)
+	}
+
+	return report.WriteJSON(w)
+}
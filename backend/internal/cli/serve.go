@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"go.uber.org/dig"
+	"go.uber.org/fx"
+
+	"g_gen/internal/di"
+	"g_gen/internal/server"
+)
+
+// runServe APIサーバーを起動し、終了シグナルを受け取るまで待つ
+func runServe(ctx context.Context, args []string, _, stderr io.Writer) error {
+	fs := newFlagSet("serve", stderr)
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	app := fx.New(
+		di.Provider(),
+		// サーバーより先に起動時のマイグレーションを登録し、適用してからリクエストを受け付ける
+		fx.Invoke(di.AutoMigrate, server.RegisterRoutes),
+	)
+	if err := app.Err(); err != nil {
+		return dig.RootCause(err)
+	}
+
+	if err := app.Start(ctx); err != nil {
+		return err
+	}
+
+	signal := <-app.Wait()
+
+	stopCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), stopTimeout)
+	defer cancel()
+
+	if err := app.Stop(stopCtx); err != nil {
+		return err
+	}
+
+	if signal.ExitCode != 0 {
+		return errors.New("サーバーが異常終了しました")
+	}
+
+	return nil
+}
@@ -2,6 +2,9 @@ package di
 
 import (
 	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
 
 	"github.com/gin-gonic/gin"
 	"go.uber.org/fx"
@@ -9,11 +12,17 @@ import (
 	domain "g_gen/internal/domain/repository"
 	"g_gen/internal/env"
 	"g_gen/internal/handler"
+	"g_gen/internal/infra/background"
+	"g_gen/internal/infra/cache"
 	"g_gen/internal/infra/datastore"
 	"g_gen/internal/infra/db"
 	"g_gen/internal/infra/logger"
+	"g_gen/internal/infra/migration"
+	"g_gen/internal/infra/storage"
+	"g_gen/internal/pagination"
 	"g_gen/internal/server/middleware"
 	"g_gen/internal/usecase"
+	"g_gen/migrations"
 )
 
 // ProvideLogger creates a new logger instance
@@ -27,12 +36,7 @@ func ProvideEnvValues() (*env.Values, error) {
 }
 
 // ProvideDBClient creates a new database client
-func ProvideDBClient(lc fx.Lifecycle, l *logger.Logger) (db.Client, error) {
-	e, err := env.NewValues()
-	if err != nil {
-		l.Error("failed to load environment variables", "error", err)
-		return nil, err
-	}
+func ProvideDBClient(lc fx.Lifecycle, l *logger.Logger, e *env.Values) (db.Client, error) {
 	dbClient, err := db.NewSQLHandler(&db.DatabaseConfig{
 		Host:            e.DatabaseHost,
 		Port:            e.DatabasePort,
@@ -54,13 +58,86 @@ func ProvideDBClient(lc fx.Lifecycle, l *logger.Logger) (db.Client, error) {
 	lc.Append(fx.Hook{
 		OnStop: func(ctx context.Context) error {
 			l.Info("Closing database connection")
-			return nil // Add proper cleanup if needed
+			return dbClient.Close()
 		},
 	})
 
 	return dbClient, nil
 }
 
+// ProvideMigrator creates a new migrator for the embedded migration files
+func ProvideMigrator(dbClient db.Client, l *logger.Logger) (*migration.Migrator, error) {
+	return migration.New(dbClient, migrations.FS, l)
+}
+
+// AutoMigrate ローカル環境でAUTO_MIGRATEが有効な場合、サーバーの起動前に未適用のマイグレーションを適用する
+func AutoMigrate(lc fx.Lifecycle, e *env.Values, migrator *migration.Migrator) {
+	if !e.AutoMigrate || !e.IsLocal() {
+		return
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: migrator.Up,
+	})
+}
+
+// ProvideCursorCodec creates a new cursor codec for list pagination
+// ローカル環境でCURSOR_SECRETが未設定の場合は起動ごとに鍵を生成する（再起動前に発行したカーソルは無効になる）
+func ProvideCursorCodec(e *env.Values) (*pagination.CursorCodec, error) {
+	if e.CursorSecret != "" {
+		return pagination.NewCursorCodec([]byte(e.CursorSecret)), nil
+	}
+
+	if !e.IsLocal() {
+		return nil, errors.New("CURSOR_SECRET is required")
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+
+	return pagination.NewCursorCodec(secret), nil
+}
+
+// ProvideFileStorage creates a new file storage for STORAGE_BACKEND
+func ProvideFileStorage(e *env.Values) (domain.FileStorage, error) {
+	switch e.StorageBackend {
+	case "local":
+		return storage.NewLocal(e.StorageLocalDir), nil
+	case "s3":
+		if e.StorageS3Bucket == "" {
+			return nil, errors.New("STORAGE_S3_BUCKET is required")
+		}
+
+		return storage.NewS3(storage.S3Config{
+			Endpoint:        e.StorageS3Endpoint,
+			Region:          e.StorageS3Region,
+			Bucket:          e.StorageS3Bucket,
+			AccessKeyID:     e.StorageS3AccessKeyID,
+			SecretAccessKey: e.StorageS3SecretAccessKey,
+			UsePathStyle:    e.StorageS3UsePathStyle,
+		}), nil
+	default:
+		return nil, fmt.Errorf("unknown STORAGE_BACKEND: %q", e.StorageBackend)
+	}
+}
+
+// ProvideTaskRunner creates a new background task runner
+// 停止時は実行中の処理（写真の処理など）の終了を待ち、待ちきれない場合はキャンセルする
+func ProvideTaskRunner(lc fx.Lifecycle, l *logger.Logger) domain.TaskRunner {
+	runner := background.NewRunner(l)
+
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			l.Info("Waiting for background tasks")
+			return runner.Shutdown(ctx)
+		},
+	})
+
+	return runner
+}
+
 // ProvideGinEngine creates and configures a new Gin engine
 func ProvideGinEngine(l *logger.Logger) *gin.Engine {
 	r := gin.Default()
@@ -74,32 +151,260 @@ func ProvideGinEngine(l *logger.Logger) *gin.Engine {
 	return r
 }
 
+// ProvideCacheRegistry creates a new cache registry
+// 別プロセス（インポーターなど）からの破棄の通知をサーバーの起動中に待ち受ける
+func ProvideCacheRegistry(lc fx.Lifecycle, l *logger.Logger, dbClient db.Client) *cache.Registry {
+	registry := cache.NewRegistry()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			sqlDB, err := dbClient.Conn(ctx).DB()
+			if err != nil {
+				return err
+			}
+
+			go cache.Listen(ctx, sqlDB, registry, l)
+
+			return nil
+		},
+		OnStop: func(context.Context) error {
+			cancel()
+			return nil
+		},
+	})
+
+	return registry
+}
+
 // ProvidePrefectureRepository creates a new prefecture repository
-func ProvidePrefectureRepository(dbClient db.Client) domain.PrefectureRepository {
+// PREFECTURE_CACHE_TTLが0の場合はキャッシュしない
+func ProvidePrefectureRepository(
+	dbClient db.Client,
+	e *env.Values,
+	registry *cache.Registry,
+) domain.PrefectureRepository {
 	ctx := context.Background()
-	return datastore.NewPrefectureRepository(ctx, dbClient)
+	repo := datastore.NewPrefectureRepository(ctx, dbClient)
+	if e.PrefectureCacheTTL <= 0 {
+		return repo
+	}
+
+	return datastore.NewCachedPrefectureRepository(repo, cache.Config{
+		TTL:        e.PrefectureCacheTTL,
+		MaxEntries: e.PrefectureCacheMaxEntries,
+	}, registry)
 }
 
 // ProvidePrefectureUseCase creates a new prefecture use case
-func ProvidePrefectureUseCase(repo domain.PrefectureRepository) usecase.PrefectureUseCase {
-	return usecase.NewPrefectureUseCase(repo)
+func ProvidePrefectureUseCase(
+	repo domain.PrefectureRepository,
+	regionRepo domain.RegionRepository,
+) usecase.PrefectureUseCase {
+	return usecase.NewPrefectureUseCase(repo, regionRepo)
 }
 
 // ProvidePrefectureHandler creates a new prefecture handler
-func ProvidePrefectureHandler(l *logger.Logger, prefectureUseCase usecase.PrefectureUseCase) handler.PrefectureHandler {
-	return handler.NewPrefectureHandler(l, prefectureUseCase)
+func ProvidePrefectureHandler(
+	l *logger.Logger,
+	prefectureUseCase usecase.PrefectureUseCase,
+	cursorCodec *pagination.CursorCodec,
+) handler.PrefectureHandler {
+	return handler.NewPrefectureHandler(l, prefectureUseCase, cursorCodec)
+}
+
+// ProvideRegionRepository creates a new region repository
+func ProvideRegionRepository(dbClient db.Client) domain.RegionRepository {
+	ctx := context.Background()
+	return datastore.NewRegionRepository(ctx, dbClient)
+}
+
+// ProvideRegionUseCase creates a new region use case
+func ProvideRegionUseCase(repo domain.RegionRepository) usecase.RegionUseCase {
+	return usecase.NewRegionUseCase(repo)
+}
+
+// ProvideRegionHandler creates a new region handler
+func ProvideRegionHandler(
+	l *logger.Logger,
+	regionUseCase usecase.RegionUseCase,
+	prefectureUseCase usecase.PrefectureUseCase,
+	cursorCodec *pagination.CursorCodec,
+) handler.RegionHandler {
+	return handler.NewRegionHandler(l, regionUseCase, prefectureUseCase, cursorCodec)
+}
+
+// ProvideMunicipalityRepository creates a new municipality repository
+func ProvideMunicipalityRepository(dbClient db.Client) domain.Municipality {
+	ctx := context.Background()
+	return datastore.NewMunicipalityRepository(ctx, dbClient)
+}
+
+// ProvideMunicipalitySuccessionRepository creates a new municipality succession repository
+func ProvideMunicipalitySuccessionRepository(dbClient db.Client) domain.MunicipalitySuccessionRepository {
+	ctx := context.Background()
+	return datastore.NewMunicipalitySuccessionRepository(ctx, dbClient)
+}
+
+// ProvideMunicipalityUseCase creates a new municipality use case
+func ProvideMunicipalityUseCase(
+	repo domain.Municipality,
+	successionRepo domain.MunicipalitySuccessionRepository,
+) usecase.MunicipalityUseCase {
+	return usecase.NewMunicipalityUseCase(repo, successionRepo)
+}
+
+// ProvideMunicipalityHandler creates a new municipality handler
+func ProvideMunicipalityHandler(
+	l *logger.Logger,
+	municipalityUseCase usecase.MunicipalityUseCase,
+	cursorCodec *pagination.CursorCodec,
+) handler.MunicipalityHandler {
+	return handler.NewMunicipalityHandler(l, municipalityUseCase, cursorCodec)
+}
+
+// ProvideAdminHandler creates a new admin handler
+func ProvideAdminHandler(l *logger.Logger, municipalityUseCase usecase.MunicipalityUseCase) handler.AdminHandler {
+	return handler.NewAdminHandler(l, municipalityUseCase)
+}
+
+// ProvideWorkCategoryRepository creates a new work category repository
+func ProvideWorkCategoryRepository(dbClient db.Client) domain.WorkCategoryRepository {
+	ctx := context.Background()
+	return datastore.NewWorkCategoryRepository(ctx, dbClient)
+}
+
+// ProvideWorkCategoryUseCase creates a new work category use case
+func ProvideWorkCategoryUseCase(repo domain.WorkCategoryRepository) usecase.WorkCategoryUseCase {
+	return usecase.NewWorkCategoryUseCase(repo)
+}
+
+// ProvideWorkCategoryHandler creates a new work category handler
+func ProvideWorkCategoryHandler(
+	l *logger.Logger,
+	workCategoryUseCase usecase.WorkCategoryUseCase,
+	cursorCodec *pagination.CursorCodec,
+) handler.WorkCategoryHandler {
+	return handler.NewWorkCategoryHandler(l, workCategoryUseCase, cursorCodec)
+}
+
+// ProvideDamageReportRepository creates a new damage report repository
+func ProvideDamageReportRepository(dbClient db.Client) domain.DamageReportRepository {
+	ctx := context.Background()
+	return datastore.NewDamageReportRepository(ctx, dbClient)
+}
+
+// ProvideDamageReportUseCase creates a new damage report use case
+func ProvideDamageReportUseCase(
+	repo domain.DamageReportRepository,
+	municipalityRepo domain.Municipality,
+	successionRepo domain.MunicipalitySuccessionRepository,
+	workCategoryRepo domain.WorkCategoryRepository,
+) usecase.DamageReportUseCase {
+	return usecase.NewDamageReportUseCase(repo, municipalityRepo, successionRepo, workCategoryRepo)
+}
+
+// ProvideDamageReportHandler creates a new damage report handler
+func ProvideDamageReportHandler(
+	l *logger.Logger,
+	damageReportUseCase usecase.DamageReportUseCase,
+	cursorCodec *pagination.CursorCodec,
+) handler.DamageReportHandler {
+	return handler.NewDamageReportHandler(l, damageReportUseCase, cursorCodec)
+}
+
+// ProvideSupportApplicationRepository creates a new support application repository
+func ProvideSupportApplicationRepository(dbClient db.Client) domain.SupportApplicationRepository {
+	ctx := context.Background()
+	return datastore.NewSupportApplicationRepository(ctx, dbClient)
+}
+
+// ProvideSupportApplicationUseCase creates a new support application use case
+func ProvideSupportApplicationUseCase(
+	repo domain.SupportApplicationRepository,
+	damageReportRepo domain.DamageReportRepository,
+) usecase.SupportApplicationUseCase {
+	return usecase.NewSupportApplicationUseCase(repo, damageReportRepo)
+}
+
+// ProvideSupportApplicationHandler creates a new support application handler
+func ProvideSupportApplicationHandler(
+	l *logger.Logger,
+	supportApplicationUseCase usecase.SupportApplicationUseCase,
+	cursorCodec *pagination.CursorCodec,
+) handler.SupportApplicationHandler {
+	return handler.NewSupportApplicationHandler(l, supportApplicationUseCase, cursorCodec)
+}
+
+// ProvideDamageReportAttachmentRepository creates a new damage report attachment repository
+func ProvideDamageReportAttachmentRepository(dbClient db.Client) domain.DamageReportAttachmentRepository {
+	ctx := context.Background()
+	return datastore.NewDamageReportAttachmentRepository(ctx, dbClient)
+}
+
+// ProvideDamageReportAttachmentUseCase creates a new damage report attachment use case
+func ProvideDamageReportAttachmentUseCase(
+	repo domain.DamageReportAttachmentRepository,
+	damageReportRepo domain.DamageReportRepository,
+	fileStorage domain.FileStorage,
+	taskRunner domain.TaskRunner,
+	e *env.Values,
+) usecase.DamageReportAttachmentUseCase {
+	return usecase.NewDamageReportAttachmentUseCase(repo, damageReportRepo, fileStorage, taskRunner, e.AttachmentMaxSize)
+}
+
+// ProvideDamageReportAttachmentHandler creates a new damage report attachment handler
+func ProvideDamageReportAttachmentHandler(
+	l *logger.Logger,
+	damageReportAttachmentUseCase usecase.DamageReportAttachmentUseCase,
+	cursorCodec *pagination.CursorCodec,
+	e *env.Values,
+) handler.DamageReportAttachmentHandler {
+	return handler.NewDamageReportAttachmentHandler(l, damageReportAttachmentUseCase, cursorCodec, e.AttachmentMaxSize)
+}
+
+// Core 環境変数・ロガー・DBクライアントなど、APIサーバーとCLIのすべてのサブコマンドで共有する依存
+func Core() fx.Option {
+	return fx.Provide(
+		ProvideLogger,
+		ProvideEnvValues,
+		ProvideDBClient,
+	)
 }
 
 func Provider() fx.Option {
 	return fx.Options(
+		Core(),
 		fx.Provide(
-			ProvideLogger,
-			ProvideEnvValues,
-			ProvideDBClient,
+			ProvideMigrator,
+			ProvideCursorCodec,
+			ProvideCacheRegistry,
+			ProvideFileStorage,
+			ProvideTaskRunner,
 			ProvideGinEngine,
 			ProvidePrefectureRepository,
 			ProvidePrefectureUseCase,
 			ProvidePrefectureHandler,
+			ProvideRegionRepository,
+			ProvideRegionUseCase,
+			ProvideRegionHandler,
+			ProvideMunicipalityRepository,
+			ProvideMunicipalitySuccessionRepository,
+			ProvideMunicipalityUseCase,
+			ProvideMunicipalityHandler,
+			ProvideAdminHandler,
+			ProvideWorkCategoryRepository,
+			ProvideWorkCategoryUseCase,
+			ProvideWorkCategoryHandler,
+			ProvideDamageReportRepository,
+			ProvideDamageReportUseCase,
+			ProvideDamageReportHandler,
+			ProvideSupportApplicationRepository,
+			ProvideSupportApplicationUseCase,
+			ProvideSupportApplicationHandler,
+			ProvideDamageReportAttachmentRepository,
+			ProvideDamageReportAttachmentUseCase,
+			ProvideDamageReportAttachmentHandler,
 		),
 	)
 }
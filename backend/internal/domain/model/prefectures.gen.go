@@ -4,14 +4,21 @@
 
 package model
 
+import (
+	"time"
+)
+
 const TableNamePrefecture = "prefectures"
 
 // Prefecture mapped from table <prefectures>
 type Prefecture struct {
-	ID             int32          `gorm:"column:id;type:integer;primaryKey;autoIncrement:true;comment:都道府県名" json:"id"` // 都道府県名
+	ID             int64          `gorm:"column:id;type:bigint;primaryKey;autoIncrement:true;comment:都道府県名" json:"id"` // 都道府県名
 	Code           string         `gorm:"column:code;type:character varying(2);not null;index:idx_prefectures_code,priority:1" json:"code"`
-	Name           string         `gorm:"column:name;type:character varying(10);not null;index:idx_prefectures_name,priority:1;comment:都道府県名" json:"name"` // 都道府県名
+	Name           string         `gorm:"column:name;type:character varying(10);not null;index:idx_prefectures_name,priority:1;comment:都道府県名" json:"name"`                   // 都道府県名
+	RegionID       int64          `gorm:"column:region_id;type:bigint;not null;index:idx_prefectures_region_id,priority:1;comment:地方区分ID（外部キー、地方区分マスタのID）" json:"region_id"` // 地方区分ID（外部キー、地方区分マスタのID）
+	UpdatedAt      time.Time      `gorm:"column:updated_at;type:timestamp with time zone;not null;default:CURRENT_TIMESTAMP;comment:更新日時" json:"updated_at"`                 // 更新日時
 	Municipalities []Municipality `gorm:"foreignKey:PrefectureCode;references:Code" json:"municipalities"`
+	Region         Region         `gorm:"foreignKey:RegionID;references:ID" json:"region"`
 }
 
 // TableName Prefecture's table name
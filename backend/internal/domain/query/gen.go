@@ -16,44 +16,74 @@ import (
 )
 
 var (
-	Q            = new(Query)
-	Municipality *municipality
-	Prefecture   *prefecture
-	WorkCategory *workCategory
+	Q                            = new(Query)
+	DamageReport                 *damageReport
+	DamageReportAttachment       *damageReportAttachment
+	Municipality                 *municipality
+	MunicipalitySuccession       *municipalitySuccession
+	Prefecture                   *prefecture
+	Region                       *region
+	SupportApplication           *supportApplication
+	SupportApplicationTransition *supportApplicationTransition
+	WorkCategory                 *workCategory
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
+	DamageReport = &Q.DamageReport
+	DamageReportAttachment = &Q.DamageReportAttachment
 	Municipality = &Q.Municipality
+	MunicipalitySuccession = &Q.MunicipalitySuccession
 	Prefecture = &Q.Prefecture
+	Region = &Q.Region
+	SupportApplication = &Q.SupportApplication
+	SupportApplicationTransition = &Q.SupportApplicationTransition
 	WorkCategory = &Q.WorkCategory
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:           db,
-		Municipality: newMunicipality(db, opts...),
-		Prefecture:   newPrefecture(db, opts...),
-		WorkCategory: newWorkCategory(db, opts...),
+		db:                           db,
+		DamageReport:                 newDamageReport(db, opts...),
+		DamageReportAttachment:       newDamageReportAttachment(db, opts...),
+		Municipality:                 newMunicipality(db, opts...),
+		MunicipalitySuccession:       newMunicipalitySuccession(db, opts...),
+		Prefecture:                   newPrefecture(db, opts...),
+		Region:                       newRegion(db, opts...),
+		SupportApplication:           newSupportApplication(db, opts...),
+		SupportApplicationTransition: newSupportApplicationTransition(db, opts...),
+		WorkCategory:                 newWorkCategory(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	Municipality municipality
-	Prefecture   prefecture
-	WorkCategory workCategory
+	DamageReport                 damageReport
+	DamageReportAttachment       damageReportAttachment
+	Municipality                 municipality
+	MunicipalitySuccession       municipalitySuccession
+	Prefecture                   prefecture
+	Region                       region
+	SupportApplication           supportApplication
+	SupportApplicationTransition supportApplicationTransition
+	WorkCategory                 workCategory
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:           db,
-		Municipality: q.Municipality.clone(db),
-		Prefecture:   q.Prefecture.clone(db),
-		WorkCategory: q.WorkCategory.clone(db),
+		db:                           db,
+		DamageReport:                 q.DamageReport.clone(db),
+		DamageReportAttachment:       q.DamageReportAttachment.clone(db),
+		Municipality:                 q.Municipality.clone(db),
+		MunicipalitySuccession:       q.MunicipalitySuccession.clone(db),
+		Prefecture:                   q.Prefecture.clone(db),
+		Region:                       q.Region.clone(db),
+		SupportApplication:           q.SupportApplication.clone(db),
+		SupportApplicationTransition: q.SupportApplicationTransition.clone(db),
+		WorkCategory:                 q.WorkCategory.clone(db),
 	}
 }
 
@@ -67,24 +97,42 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:           db,
-		Municipality: q.Municipality.replaceDB(db),
-		Prefecture:   q.Prefecture.replaceDB(db),
-		WorkCategory: q.WorkCategory.replaceDB(db),
+		db:                           db,
+		DamageReport:                 q.DamageReport.replaceDB(db),
+		DamageReportAttachment:       q.DamageReportAttachment.replaceDB(db),
+		Municipality:                 q.Municipality.replaceDB(db),
+		MunicipalitySuccession:       q.MunicipalitySuccession.replaceDB(db),
+		Prefecture:                   q.Prefecture.replaceDB(db),
+		Region:                       q.Region.replaceDB(db),
+		SupportApplication:           q.SupportApplication.replaceDB(db),
+		SupportApplicationTransition: q.SupportApplicationTransition.replaceDB(db),
+		WorkCategory:                 q.WorkCategory.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	Municipality IMunicipalityDo
-	Prefecture   IPrefectureDo
-	WorkCategory IWorkCategoryDo
+	DamageReport                 IDamageReportDo
+	DamageReportAttachment       IDamageReportAttachmentDo
+	Municipality                 IMunicipalityDo
+	MunicipalitySuccession       IMunicipalitySuccessionDo
+	Prefecture                   IPrefectureDo
+	Region                       IRegionDo
+	SupportApplication           ISupportApplicationDo
+	SupportApplicationTransition ISupportApplicationTransitionDo
+	WorkCategory                 IWorkCategoryDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		Municipality: q.Municipality.WithContext(ctx),
-		Prefecture:   q.Prefecture.WithContext(ctx),
-		WorkCategory: q.WorkCategory.WithContext(ctx),
+		DamageReport:                 q.DamageReport.WithContext(ctx),
+		DamageReportAttachment:       q.DamageReportAttachment.WithContext(ctx),
+		Municipality:                 q.Municipality.WithContext(ctx),
+		MunicipalitySuccession:       q.MunicipalitySuccession.WithContext(ctx),
+		Prefecture:                   q.Prefecture.WithContext(ctx),
+		Region:                       q.Region.WithContext(ctx),
+		SupportApplication:           q.SupportApplication.WithContext(ctx),
+		SupportApplicationTransition: q.SupportApplicationTransition.WithContext(ctx),
+		WorkCategory:                 q.WorkCategory.WithContext(ctx),
 	}
 }
 
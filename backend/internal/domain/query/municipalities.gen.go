@@ -28,7 +28,7 @@ func newMunicipality(db *gorm.DB, opts ...gen.DOOption) municipality {
 
 	tableName := _municipality.municipalityDo.TableName()
 	_municipality.ALL = field.NewAsterisk(tableName)
-	_municipality.ID = field.NewInt32(tableName, "id")
+	_municipality.ID = field.NewInt64(tableName, "id")
 	_municipality.PrefectureCode = field.NewString(tableName, "prefecture_code")
 	_municipality.OrganizationCode = field.NewString(tableName, "organization_code")
 	_municipality.PrefectureNameKanji = field.NewString(tableName, "prefecture_name_kanji")
@@ -36,10 +36,29 @@ func newMunicipality(db *gorm.DB, opts ...gen.DOOption) municipality {
 	_municipality.PrefectureNameKana = field.NewString(tableName, "prefecture_name_kana")
 	_municipality.MunicipalityNameKana = field.NewString(tableName, "municipality_name_kana")
 	_municipality.IsActive = field.NewBool(tableName, "is_active")
+	_municipality.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_municipality.Prefecture = municipalityBelongsToPrefecture{
 		db: db.Session(&gorm.Session{}),
 
 		RelationField: field.NewRelation("Prefecture", "model.Prefecture"),
+		Region: struct {
+			field.RelationField
+		}{
+			RelationField: field.NewRelation("Prefecture.Region", "model.Region"),
+		},
+		Municipalities: struct {
+			field.RelationField
+			Prefecture struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Prefecture.Municipalities", "model.Municipality"),
+			Prefecture: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Prefecture.Municipalities.Prefecture", "model.Prefecture"),
+			},
+		},
 	}
 
 	_municipality.fillFieldMap()
@@ -51,7 +70,7 @@ type municipality struct {
 	municipalityDo
 
 	ALL                   field.Asterisk
-	ID                    field.Int32  // 内部ID（主キー、自動採番）
+	ID                    field.Int64  // 内部ID（主キー、自動採番）
 	PrefectureCode        field.String // 都道府県ID（外部キー、都道府県マスタのID）
 	OrganizationCode      field.String // 団体コード（総務省地方公共団体コード、6桁）
 	PrefectureNameKanji   field.String // 都道府県名（漢字表記）
@@ -59,6 +78,7 @@ type municipality struct {
 	PrefectureNameKana    field.String // 都道府県名（カタカナ表記）
 	MunicipalityNameKana  field.String // 市区町村名（カタカナ表記）
 	IsActive              field.Bool   // 有効フラグ（TRUE: 有効、FALSE: 無効）
+	UpdatedAt             field.Time   // 更新日時
 	Prefecture            municipalityBelongsToPrefecture
 
 	fieldMap map[string]field.Expr
@@ -76,7 +96,7 @@ func (m municipality) As(alias string) *municipality {
 
 func (m *municipality) updateTableName(table string) *municipality {
 	m.ALL = field.NewAsterisk(table)
-	m.ID = field.NewInt32(table, "id")
+	m.ID = field.NewInt64(table, "id")
 	m.PrefectureCode = field.NewString(table, "prefecture_code")
 	m.OrganizationCode = field.NewString(table, "organization_code")
 	m.PrefectureNameKanji = field.NewString(table, "prefecture_name_kanji")
@@ -84,6 +104,7 @@ func (m *municipality) updateTableName(table string) *municipality {
 	m.PrefectureNameKana = field.NewString(table, "prefecture_name_kana")
 	m.MunicipalityNameKana = field.NewString(table, "municipality_name_kana")
 	m.IsActive = field.NewBool(table, "is_active")
+	m.UpdatedAt = field.NewTime(table, "updated_at")
 
 	m.fillFieldMap()
 
@@ -100,7 +121,7 @@ func (m *municipality) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (m *municipality) fillFieldMap() {
-	m.fieldMap = make(map[string]field.Expr, 9)
+	m.fieldMap = make(map[string]field.Expr, 10)
 	m.fieldMap["id"] = m.ID
 	m.fieldMap["prefecture_code"] = m.PrefectureCode
 	m.fieldMap["organization_code"] = m.OrganizationCode
@@ -109,6 +130,7 @@ func (m *municipality) fillFieldMap() {
 	m.fieldMap["prefecture_name_kana"] = m.PrefectureNameKana
 	m.fieldMap["municipality_name_kana"] = m.MunicipalityNameKana
 	m.fieldMap["is_active"] = m.IsActive
+	m.fieldMap["updated_at"] = m.UpdatedAt
 
 }
 
@@ -129,6 +151,16 @@ type municipalityBelongsToPrefecture struct {
 	db *gorm.DB
 
 	field.RelationField
+
+	Region struct {
+		field.RelationField
+	}
+	Municipalities struct {
+		field.RelationField
+		Prefecture struct {
+			field.RelationField
+		}
+	}
 }
 
 func (a municipalityBelongsToPrefecture) Where(conds ...field.Expr) *municipalityBelongsToPrefecture {
@@ -28,13 +28,42 @@ func newPrefecture(db *gorm.DB, opts ...gen.DOOption) prefecture {
 
 	tableName := _prefecture.prefectureDo.TableName()
 	_prefecture.ALL = field.NewAsterisk(tableName)
-	_prefecture.ID = field.NewInt32(tableName, "id")
+	_prefecture.ID = field.NewInt64(tableName, "id")
 	_prefecture.Code = field.NewString(tableName, "code")
 	_prefecture.Name = field.NewString(tableName, "name")
+	_prefecture.RegionID = field.NewInt64(tableName, "region_id")
+	_prefecture.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_prefecture.Municipalities = prefectureHasManyMunicipalities{
 		db: db.Session(&gorm.Session{}),
 
 		RelationField: field.NewRelation("Municipalities", "model.Municipality"),
+		Prefecture: struct {
+			field.RelationField
+			Region struct {
+				field.RelationField
+			}
+			Municipalities struct {
+				field.RelationField
+			}
+		}{
+			RelationField: field.NewRelation("Municipalities.Prefecture", "model.Prefecture"),
+			Region: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Municipalities.Prefecture.Region", "model.Region"),
+			},
+			Municipalities: struct {
+				field.RelationField
+			}{
+				RelationField: field.NewRelation("Municipalities.Prefecture.Municipalities", "model.Municipality"),
+			},
+		},
+	}
+
+	_prefecture.Region = prefectureBelongsToRegion{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Region", "model.Region"),
 	}
 
 	_prefecture.fillFieldMap()
@@ -46,11 +75,15 @@ type prefecture struct {
 	prefectureDo
 
 	ALL            field.Asterisk
-	ID             field.Int32 // 都道府県名
+	ID             field.Int64 // 都道府県名
 	Code           field.String
 	Name           field.String // 都道府県名
+	RegionID       field.Int64  // 地方区分ID（外部キー、地方区分マスタのID）
+	UpdatedAt      field.Time   // 更新日時
 	Municipalities prefectureHasManyMunicipalities
 
+	Region prefectureBelongsToRegion
+
 	fieldMap map[string]field.Expr
 }
 
@@ -66,9 +99,11 @@ func (p prefecture) As(alias string) *prefecture {
 
 func (p *prefecture) updateTableName(table string) *prefecture {
 	p.ALL = field.NewAsterisk(table)
-	p.ID = field.NewInt32(table, "id")
+	p.ID = field.NewInt64(table, "id")
 	p.Code = field.NewString(table, "code")
 	p.Name = field.NewString(table, "name")
+	p.RegionID = field.NewInt64(table, "region_id")
+	p.UpdatedAt = field.NewTime(table, "updated_at")
 
 	p.fillFieldMap()
 
@@ -85,10 +120,12 @@ func (p *prefecture) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (p *prefecture) fillFieldMap() {
-	p.fieldMap = make(map[string]field.Expr, 4)
+	p.fieldMap = make(map[string]field.Expr, 7)
 	p.fieldMap["id"] = p.ID
 	p.fieldMap["code"] = p.Code
 	p.fieldMap["name"] = p.Name
+	p.fieldMap["region_id"] = p.RegionID
+	p.fieldMap["updated_at"] = p.UpdatedAt
 
 }
 
@@ -96,12 +133,15 @@ func (p prefecture) clone(db *gorm.DB) prefecture {
 	p.prefectureDo.ReplaceConnPool(db.Statement.ConnPool)
 	p.Municipalities.db = db.Session(&gorm.Session{Initialized: true})
 	p.Municipalities.db.Statement.ConnPool = db.Statement.ConnPool
+	p.Region.db = db.Session(&gorm.Session{Initialized: true})
+	p.Region.db.Statement.ConnPool = db.Statement.ConnPool
 	return p
 }
 
 func (p prefecture) replaceDB(db *gorm.DB) prefecture {
 	p.prefectureDo.ReplaceDB(db)
 	p.Municipalities.db = db.Session(&gorm.Session{})
+	p.Region.db = db.Session(&gorm.Session{})
 	return p
 }
 
@@ -109,6 +149,16 @@ type prefectureHasManyMunicipalities struct {
 	db *gorm.DB
 
 	field.RelationField
+
+	Prefecture struct {
+		field.RelationField
+		Region struct {
+			field.RelationField
+		}
+		Municipalities struct {
+			field.RelationField
+		}
+	}
 }
 
 func (a prefectureHasManyMunicipalities) Where(conds ...field.Expr) *prefectureHasManyMunicipalities {
@@ -186,6 +236,87 @@ func (a prefectureHasManyMunicipalitiesTx) Unscoped() *prefectureHasManyMunicipa
 	return &a
 }
 
+type prefectureBelongsToRegion struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a prefectureBelongsToRegion) Where(conds ...field.Expr) *prefectureBelongsToRegion {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a prefectureBelongsToRegion) WithContext(ctx context.Context) *prefectureBelongsToRegion {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a prefectureBelongsToRegion) Session(session *gorm.Session) *prefectureBelongsToRegion {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a prefectureBelongsToRegion) Model(m *model.Prefecture) *prefectureBelongsToRegionTx {
+	return &prefectureBelongsToRegionTx{a.db.Model(m).Association(a.Name())}
+}
+
+func (a prefectureBelongsToRegion) Unscoped() *prefectureBelongsToRegion {
+	a.db = a.db.Unscoped()
+	return &a
+}
+
+type prefectureBelongsToRegionTx struct{ tx *gorm.Association }
+
+func (a prefectureBelongsToRegionTx) Find() (result *model.Region, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a prefectureBelongsToRegionTx) Append(values ...*model.Region) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a prefectureBelongsToRegionTx) Replace(values ...*model.Region) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a prefectureBelongsToRegionTx) Delete(values ...*model.Region) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a prefectureBelongsToRegionTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a prefectureBelongsToRegionTx) Count() int64 {
+	return a.tx.Count()
+}
+
+func (a prefectureBelongsToRegionTx) Unscoped() *prefectureBelongsToRegionTx {
+	a.tx = a.tx.Unscoped()
+	return &a
+}
+
 type prefectureDo struct{ gen.DO }
 
 type IPrefectureDo interface {
@@ -28,11 +28,12 @@ func newWorkCategory(db *gorm.DB, opts ...gen.DOOption) workCategory {
 
 	tableName := _workCategory.workCategoryDo.TableName()
 	_workCategory.ALL = field.NewAsterisk(tableName)
-	_workCategory.ID = field.NewInt32(tableName, "id")
+	_workCategory.ID = field.NewInt64(tableName, "id")
 	_workCategory.CategoryName = field.NewString(tableName, "category_name")
 	_workCategory.IconName = field.NewString(tableName, "icon_name")
 	_workCategory.SortOrder = field.NewInt32(tableName, "sort_order")
 	_workCategory.IsActive = field.NewBool(tableName, "is_active")
+	_workCategory.UpdatedAt = field.NewTime(tableName, "updated_at")
 
 	_workCategory.fillFieldMap()
 
@@ -43,11 +44,12 @@ type workCategory struct {
 	workCategoryDo
 
 	ALL          field.Asterisk
-	ID           field.Int32  // 工種区分ID（主キー、自動掲番）
+	ID           field.Int64  // 工種区分ID（主キー、自動掲番）
 	CategoryName field.String // 工種区分名（漢字表記）
 	IconName     field.String // アイコンファイル名
 	SortOrder    field.Int32  // 表示順序
 	IsActive     field.Bool   // 有効フラグ（TRUE: 有効、FALSE: 無効）
+	UpdatedAt    field.Time   // 更新日時
 
 	fieldMap map[string]field.Expr
 }
@@ -64,11 +66,12 @@ func (w workCategory) As(alias string) *workCategory {
 
 func (w *workCategory) updateTableName(table string) *workCategory {
 	w.ALL = field.NewAsterisk(table)
-	w.ID = field.NewInt32(table, "id")
+	w.ID = field.NewInt64(table, "id")
 	w.CategoryName = field.NewString(table, "category_name")
 	w.IconName = field.NewString(table, "icon_name")
 	w.SortOrder = field.NewInt32(table, "sort_order")
 	w.IsActive = field.NewBool(table, "is_active")
+	w.UpdatedAt = field.NewTime(table, "updated_at")
 
 	w.fillFieldMap()
 
@@ -85,12 +88,13 @@ func (w *workCategory) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (w *workCategory) fillFieldMap() {
-	w.fieldMap = make(map[string]field.Expr, 5)
+	w.fieldMap = make(map[string]field.Expr, 6)
 	w.fieldMap["id"] = w.ID
 	w.fieldMap["category_name"] = w.CategoryName
 	w.fieldMap["icon_name"] = w.IconName
 	w.fieldMap["sort_order"] = w.SortOrder
 	w.fieldMap["is_active"] = w.IsActive
+	w.fieldMap["updated_at"] = w.UpdatedAt
 }
 
 func (w workCategory) clone(db *gorm.DB) workCategory {
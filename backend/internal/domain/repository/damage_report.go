@@ -0,0 +1,35 @@
+//go:generate mockgen -source=damage_report.go -destination=../../../tests/mock/domain/damage_report.mock.go
+package domain
+
+import (
+	"context"
+
+	"g_gen/internal/domain/model"
+	"g_gen/internal/pagination"
+)
+
+// 被害報告の状態
+const (
+	// DamageReportStatusDraft 作成中。登録直後の状態
+	DamageReportStatusDraft = "draft"
+	// DamageReportStatusReported 報告済み
+	DamageReportStatusReported = "reported"
+	// DamageReportStatusConfirmed 確定
+	DamageReportStatusConfirmed = "confirmed"
+	// DamageReportStatusWithdrawn 取り下げ
+	DamageReportStatusWithdrawn = "withdrawn"
+)
+
+type DamageReportRepository interface {
+	FindAll(ctx context.Context, params pagination.Params) ([]*model.DamageReport, pagination.Meta, error)
+	FindByID(ctx context.Context, id int) (*model.DamageReport, error)
+	Create(ctx context.Context, damageReport *model.DamageReport) error
+	// Update 被害報告の内容を更新する。状態は Transition で変更する
+	Update(ctx context.Context, damageReport *model.DamageReport) error
+	// Transition 被害報告の状態を遷移前の状態から遷移後の状態に変更する
+	// 遷移前の状態でなくなっている場合は、他の操作と競合したものとして状態遷移エラーを返す
+	Transition(ctx context.Context, id int, from, to string) error
+	// PrefillFromPhoto 添付された写真の撮影位置から、作成中の被害報告の未設定の位置を補う
+	// 入力済みの位置と被災日は変更しない。作成中でない被害報告は変更しない
+	PrefillFromPhoto(ctx context.Context, id int, latitude, longitude float64) error
+}
@@ -0,0 +1,22 @@
+//go:generate mockgen -source=damage_report_attachment.go -destination=../../../tests/mock/domain/damage_report_attachment.mock.go
+package domain
+
+import (
+	"context"
+
+	"g_gen/internal/domain/model"
+	"g_gen/internal/pagination"
+)
+
+type DamageReportAttachmentRepository interface {
+	FindByDamageReportID(
+		ctx context.Context,
+		damageReportID int,
+		params pagination.Params,
+	) ([]*model.DamageReportAttachment, pagination.Meta, error)
+	// FindByID 被害報告に添付されたファイルを取得する。他の被害報告の添付ファイルは存在しないものとして扱う
+	FindByID(ctx context.Context, damageReportID, id int) (*model.DamageReportAttachment, error)
+	Create(ctx context.Context, attachment *model.DamageReportAttachment) error
+	// UpdatePhotoMetadata 写真から読み取った撮影日時・撮影位置と、作成したサムネイルのキーを更新する
+	UpdatePhotoMetadata(ctx context.Context, attachment *model.DamageReportAttachment) error
+}
@@ -0,0 +1,18 @@
+//go:generate mockgen -source=file_storage.go -destination=../../../tests/mock/domain/file_storage.mock.go
+package domain
+
+import (
+	"context"
+	"io"
+)
+
+// FileStorage 添付ファイルなどのファイル本体の保存先
+// キーは "/" 区切りの相対パスとし、保存先の実装（ローカルのファイルシステム・S3互換のストレージ）によらず同じキーで読み書きする
+type FileStorage interface {
+	// Put ファイルを保存する。同じキーのファイルがある場合は上書きする
+	Put(ctx context.Context, key string, body io.ReadSeeker, size int64, contentType string) error
+	// Get ファイルを取得する。呼び出し側で閉じること
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete ファイルを削除する。ファイルがない場合もエラーにしない
+	Delete(ctx context.Context, key string) error
+}
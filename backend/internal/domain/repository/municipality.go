@@ -5,10 +5,52 @@ import (
 	"context"
 
 	"g_gen/internal/domain/model"
+	"g_gen/internal/pagination"
 )
 
+// MunicipalitySearchCondition 市区町村の検索条件
+type MunicipalitySearchCondition struct {
+	// NameKeywords 市区町村名（漢字表記）に部分一致させる検索語。いずれかに一致すれば対象とする
+	NameKeywords []string
+	// KanaKeyword 市区町村名（カナ表記）に部分一致させる半角カナの検索語。空の場合はカナ表記を検索しない
+	KanaKeyword string
+	// PrefectureCode 都道府県コードでの絞り込み。空の場合は絞り込まない
+	PrefectureCode string
+}
+
+// MunicipalityStatistics 市区町村テーブルの件数の集計。インポート後のデータの確認に使う
+type MunicipalityStatistics struct {
+	Total int64 `json:"total"`
+	// PrefectureLevel 都道府県レベルの行（市区町村名が空の行）の件数
+	PrefectureLevel int64 `json:"prefecture_level"`
+	// MunicipalityLevel 市区町村レベルの行の件数
+	MunicipalityLevel int64                          `json:"municipality_level"`
+	Active            int64                          `json:"active"`
+	Inactive          int64                          `json:"inactive"`
+	Prefectures       []*PrefectureMunicipalityCount `json:"prefectures"`
+}
+
+// PrefectureMunicipalityCount 都道府県ごとの市区町村の件数
+type PrefectureMunicipalityCount struct {
+	PrefectureCode    string `json:"prefecture_code"`
+	PrefectureName    string `json:"prefecture_name"`
+	Total             int64  `json:"total"`
+	PrefectureLevel   int64  `json:"prefecture_level"`
+	MunicipalityLevel int64  `json:"municipality_level"`
+	Active            int64  `json:"active"`
+	Inactive          int64  `json:"inactive"`
+}
+
 type Municipality interface {
-	FindAll(ctx context.Context) ([]*model.Municipality, error)
+	FindAll(ctx context.Context, params pagination.Params) ([]*model.Municipality, pagination.Meta, error)
 	FindByID(ctx context.Context, id int) (*model.Municipality, error)
-	FindByPrefectureCode(ctx context.Context, prefectureCode string) ([]*model.Municipality, error)
+	FindByOrganizationCode(ctx context.Context, organizationCode string) (*model.Municipality, error)
+	FindByPrefectureCode(
+		ctx context.Context,
+		prefectureCode string,
+		params pagination.Params,
+	) ([]*model.Municipality, pagination.Meta, error)
+	Search(ctx context.Context, cond MunicipalitySearchCondition) ([]*model.Municipality, error)
+	// Statistics 無効化された市区町村を含めた件数を都道府県ごとに集計する
+	Statistics(ctx context.Context) (*MunicipalityStatistics, error)
 }
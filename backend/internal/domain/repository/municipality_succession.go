@@ -0,0 +1,18 @@
+//go:generate mockgen -source=municipality_succession.go -destination=../../../tests/mock/domain/municipality_succession.mock.go
+package domain
+
+import (
+	"context"
+	"time"
+
+	"g_gen/internal/domain/model"
+)
+
+type MunicipalitySuccessionRepository interface {
+	// FindEffectiveByPredecessorCode 指定日時点で施行済みの承継情報を施行日の新しい順に取得する
+	FindEffectiveByPredecessorCode(
+		ctx context.Context,
+		predecessorCode string,
+		at time.Time,
+	) ([]*model.MunicipalitySuccession, error)
+}
@@ -5,9 +5,13 @@ import (
 	"context"
 
 	"g_gen/internal/domain/model"
+	"g_gen/internal/pagination"
 )
 
 type PrefectureRepository interface {
-	FindAll(ctx context.Context) ([]*model.Prefecture, error)
+	FindAll(ctx context.Context, params pagination.Params) ([]*model.Prefecture, pagination.Meta, error)
 	FindByCode(ctx context.Context, code string) (*model.Prefecture, error)
+	FindByRegionID(ctx context.Context, regionID int, params pagination.Params) ([]*model.Prefecture, pagination.Meta, error)
+	// FindByRegionIDs 複数の地方区分に属する都道府県をすべて取得する。地方区分ごとにまとめる場合に使う
+	FindByRegionIDs(ctx context.Context, regionIDs []int) ([]*model.Prefecture, error)
 }
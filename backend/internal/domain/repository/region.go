@@ -0,0 +1,14 @@
+//go:generate mockgen -source=region.go -destination=../../../tests/mock/domain/region.mock.go
+package domain
+
+import (
+	"context"
+
+	"g_gen/internal/domain/model"
+	"g_gen/internal/pagination"
+)
+
+type RegionRepository interface {
+	FindAll(ctx context.Context, params pagination.Params) ([]*model.Region, pagination.Meta, error)
+	FindByID(ctx context.Context, id int) (*model.Region, error)
+}
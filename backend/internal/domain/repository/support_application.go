@@ -0,0 +1,49 @@
+//go:generate mockgen -source=support_application.go -destination=../../../tests/mock/domain/support_application.mock.go
+package domain
+
+import (
+	"context"
+
+	"g_gen/internal/domain/model"
+	"g_gen/internal/pagination"
+)
+
+// 支援申請の状態
+const (
+	// SupportApplicationStatusDraft 作成中。登録直後の状態
+	SupportApplicationStatusDraft = "draft"
+	// SupportApplicationStatusSubmitted 提出済み。市区町村の受付待ち
+	SupportApplicationStatusSubmitted = "submitted"
+	// SupportApplicationStatusMunicipalityReview 市区町村審査中
+	SupportApplicationStatusMunicipalityReview = "municipality_review"
+	// SupportApplicationStatusPrefectureReview 都道府県審査中
+	SupportApplicationStatusPrefectureReview = "prefecture_review"
+	// SupportApplicationStatusApproved 承認
+	SupportApplicationStatusApproved = "approved"
+	// SupportApplicationStatusRejected 却下
+	SupportApplicationStatusRejected = "rejected"
+	// SupportApplicationStatusReturned 差戻し。申請者が修正して再提出する
+	SupportApplicationStatusReturned = "returned"
+)
+
+// 支援申請を操作する利用者の役割
+const (
+	// RoleApplicant 申請者（農業者）
+	RoleApplicant = "applicant"
+	// RoleMunicipality 市区町村の担当者
+	RoleMunicipality = "municipality"
+	// RolePrefecture 都道府県の担当者
+	RolePrefecture = "prefecture"
+)
+
+type SupportApplicationRepository interface {
+	FindByID(ctx context.Context, id int) (*model.SupportApplication, error)
+	FindTransitions(
+		ctx context.Context,
+		id int,
+		params pagination.Params,
+	) ([]*model.SupportApplicationTransition, pagination.Meta, error)
+	Create(ctx context.Context, application *model.SupportApplication) error
+	// Transition 支援申請の状態を遷移前の状態から遷移後の状態に変更し、遷移履歴を登録する
+	Transition(ctx context.Context, transition *model.SupportApplicationTransition) error
+}
@@ -0,0 +1,11 @@
+//go:generate mockgen -source=task_runner.go -destination=../../../tests/mock/domain/task_runner.mock.go
+package domain
+
+import "context"
+
+// TaskRunner 写真の処理など時間のかかる処理を、レスポンスを返した後にバックグラウンドで実行する
+type TaskRunner interface {
+	// Go fn をバックグラウンドで実行する。fn には ctx のトレースIDだけを引き継いだ、リクエストの終了ではキャンセルされないコンテキストを渡す
+	// fn が返したエラーは、name とトレースIDをつけてログに出力する
+	Go(ctx context.Context, name string, fn func(ctx context.Context) error)
+}
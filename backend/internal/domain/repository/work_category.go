@@ -0,0 +1,20 @@
+//go:generate mockgen -source=work_category.go -destination=../../../tests/mock/domain/work_category.mock.go
+package domain
+
+import (
+	"context"
+
+	"g_gen/internal/domain/model"
+	"g_gen/internal/pagination"
+)
+
+type WorkCategoryRepository interface {
+	FindActive(ctx context.Context, params pagination.Params) ([]*model.WorkCategory, pagination.Meta, error)
+	// FindAllActive 有効な工種区分を表示順にすべて取得する。並び替えの結果を返す場合に使う
+	FindAllActive(ctx context.Context) ([]*model.WorkCategory, error)
+	FindByID(ctx context.Context, id int) (*model.WorkCategory, error)
+	Create(ctx context.Context, workCategory *model.WorkCategory) error
+	Update(ctx context.Context, workCategory *model.WorkCategory) error
+	Deactivate(ctx context.Context, id int) error
+	Reorder(ctx context.Context, ids []int) error
+}
@@ -13,6 +13,53 @@ type Values struct {
 	TestDB
 	Env        string `default:"local" split_words:"true"`
 	ServerPort string `required:"true" split_words:"true"`
+	// AutoMigrate APIサーバーの起動時に未適用のマイグレーションを適用する。ローカル環境でのみ有効
+	AutoMigrate bool `default:"false" split_words:"true"`
+	// CursorSecret 一覧取得のカーソルの署名に使う鍵。ローカル環境以外では必須
+	CursorSecret string `split_words:"true"`
+	// AdminAPIToken 管理用API（/admin）のBearerトークン。未設定の場合は管理用APIを利用できない
+	AdminAPIToken string `split_words:"true"`
+	// RoleTokens 支援申請を操作する利用者のBearerトークンと役割の組（トークン:役割をカンマ区切りで指定する）
+	RoleTokens map[string]string `split_words:"true"`
+	// TrustUserRoleHeader トークンがない場合に X-User-Role ヘッダの役割を使う。開発用で、ローカル環境でのみ有効
+	TrustUserRoleHeader bool `default:"false" split_words:"true"`
+	CacheControl
+	RepositoryCache
+	Storage
+}
+
+// CacheControl ルートグループごとのCache-Controlヘッダ
+type CacheControl struct {
+	// MasterDataCacheControl 都道府県・地方区分・市区町村APIのCache-Control
+	MasterDataCacheControl string `default:"private, max-age=300" split_words:"true"`
+	// WorkCategoryCacheControl 工種区分APIのCache-Control。画面から変更されるため既定では毎回再検証させる
+	WorkCategoryCacheControl string `default:"no-cache" split_words:"true"`
+}
+
+// RepositoryCache リポジトリごとのプロセス内キャッシュの設定。TTLが0の場合はキャッシュしない
+type RepositoryCache struct {
+	// PrefectureCacheTTL 都道府県リポジトリのキャッシュの有効期間
+	PrefectureCacheTTL time.Duration `default:"10m" split_words:"true"`
+	// PrefectureCacheMaxEntries 都道府県リポジトリのキャッシュの上限件数（取得方法ごと）
+	PrefectureCacheMaxEntries int `default:"128" split_words:"true"`
+}
+
+// Storage 添付ファイルの保存先の設定
+type Storage struct {
+	// StorageBackend 保存先（local ローカルのファイルシステム、s3 S3互換のストレージ）
+	StorageBackend string `default:"local" split_words:"true"`
+	// StorageLocalDir local の場合に保存するディレクトリ
+	StorageLocalDir string `default:"./storage" split_words:"true"`
+	// StorageS3Endpoint s3 の場合の接続先。MinIOなどを使う場合に指定し、空の場合はAWSのS3に接続する
+	StorageS3Endpoint        string `split_words:"true"`
+	StorageS3Region          string `default:"ap-northeast-1" split_words:"true"`
+	StorageS3Bucket          string `split_words:"true"`
+	StorageS3AccessKeyID     string `split_words:"true"`
+	StorageS3SecretAccessKey string `split_words:"true"`
+	// StorageS3UsePathStyle バケット名をパスに含める。MinIOでは true にする
+	StorageS3UsePathStyle bool `default:"false" split_words:"true"`
+	// AttachmentMaxSize 添付できるファイルの最大サイズ（バイト）
+	AttachmentMaxSize int64 `default:"20971520" split_words:"true"`
 }
 
 type DB struct {
@@ -8,15 +8,43 @@ type (
 )
 
 const (
-	SystemError             ErrorCode = "E100000" // システムエラー
-	ValidationError         ErrorCode = "E100001"
-	PrefectureNotFoundError ErrorCode = "E100002" // 都道府県が存在しないエラー
+	SystemError                     ErrorCode = "E100000" // システムエラー
+	ValidationError                 ErrorCode = "E100001"
+	PrefectureNotFoundError         ErrorCode = "E100002" // 都道府県が存在しないエラー
+	MunicipalityNotFoundError       ErrorCode = "E100003" // 市区町村が存在しないエラー
+	WorkCategoryNotFoundError       ErrorCode = "E100004" // 工種区分が存在しないエラー
+	RegionNotFoundError             ErrorCode = "E100005" // 地方区分が存在しないエラー
+	DamageReportNotFoundError       ErrorCode = "E100006" // 被害報告が存在しないエラー
+	SupportApplicationNotFoundError ErrorCode = "E100007" // 支援申請が存在しないエラー
+	InvalidStateTransitionError     ErrorCode = "E100008" // 現在の状態から遷移できないエラー
+	TransitionForbiddenError        ErrorCode = "E100009" // 役割に許可されていない遷移のエラー
+	AttachmentNotFoundError         ErrorCode = "E100010" // 添付ファイルが存在しないエラー
+	AttachmentTooLargeError         ErrorCode = "E100011" // 添付ファイルのサイズが上限を超えるエラー
+	UnsupportedAttachmentTypeError  ErrorCode = "E100012" // 添付できない種類のファイルのエラー
+	ThumbnailNotFoundError          ErrorCode = "E100013" // サムネイルが存在しないエラー
+	SuccessionCycleError            ErrorCode = "E100014" // 市区町村の承継が循環しているエラー
+	UnauthorizedError               ErrorCode = "E100015" // 認証されていないエラー
+	ForbiddenError                  ErrorCode = "E100016" // APIの利用が許可されていないエラー
 )
 
 const (
-	SystemErrorMessage             ErrorMessage = "システムエラーが発生しました"
-	ValidationErrorMessage         ErrorMessage = "入力値に誤りがあります"
-	PrefectureNotFoundErrorMessage ErrorMessage = "都道府県は存在しません"
+	SystemErrorMessage                     ErrorMessage = "システムエラーが発生しました"
+	ValidationErrorMessage                 ErrorMessage = "入力値に誤りがあります"
+	PrefectureNotFoundErrorMessage         ErrorMessage = "都道府県は存在しません"
+	MunicipalityNotFoundErrorMessage       ErrorMessage = "市区町村は存在しません"
+	WorkCategoryNotFoundErrorMessage       ErrorMessage = "工種区分は存在しません"
+	RegionNotFoundErrorMessage             ErrorMessage = "地方区分は存在しません"
+	DamageReportNotFoundErrorMessage       ErrorMessage = "被害報告は存在しません"
+	SupportApplicationNotFoundErrorMessage ErrorMessage = "支援申請は存在しません"
+	InvalidStateTransitionErrorMessage     ErrorMessage = "現在の状態ではこの操作はできません"
+	TransitionForbiddenErrorMessage        ErrorMessage = "この操作を行う権限がありません"
+	AttachmentNotFoundErrorMessage         ErrorMessage = "添付ファイルは存在しません"
+	AttachmentTooLargeErrorMessage         ErrorMessage = "添付ファイルのサイズが上限を超えています"
+	UnsupportedAttachmentTypeErrorMessage  ErrorMessage = "添付できるのは写真（JPEG・PNG・WebP）とPDFのみです"
+	ThumbnailNotFoundErrorMessage          ErrorMessage = "サムネイルは存在しません"
+	SuccessionCycleErrorMessage            ErrorMessage = "市区町村の承継が循環しているため、団体コードを解決できません"
+	UnauthorizedErrorMessage               ErrorMessage = "認証が必要です"
+	ForbiddenErrorMessage                  ErrorMessage = "このAPIを利用する権限がありません"
 )
 
 func NewAPIError(code ErrorCode, msg ErrorMessage, originalErr error, internalMsg string) *APIError {
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"g_gen/internal/infra/logger"
+	"g_gen/internal/usecase"
+)
+
+type adminHandler struct {
+	appLogger           *logger.Logger
+	municipalityUseCase usecase.MunicipalityUseCase
+}
+
+func NewAdminHandler(
+	l *logger.Logger,
+	municipalityUseCase usecase.MunicipalityUseCase,
+) AdminHandler {
+	return &adminHandler{
+		appLogger:           l,
+		municipalityUseCase: municipalityUseCase,
+	}
+}
+
+// GetMunicipalityStatistics @title 市区町村データ統計取得
+// @id GetMunicipalityStatistics
+// @tags admin
+// @accept json
+// @produce json
+// @Description 無効化された市区町村を含めた件数を、全体と都道府県ごとに集計します。インポート後のデータ確認に使います。
+// @Description 環境変数 ADMIN_API_TOKEN のトークンをBearerトークンとして指定します（未設定の場合は403）。
+// @Summary 市区町村データ統計取得
+// @Security BearerAuth
+// @Success 200 {object} MunicipalityStatistics
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/municipalities/statistics [get]
+func (h *adminHandler) GetMunicipalityStatistics(c *gin.Context) {
+	ctx := c.Request.Context()
+	stats, err := h.municipalityUseCase.GetMunicipalityStatistics(ctx)
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to get municipality statistics")
+
+		return
+	}
+
+	prefectures := make([]*PrefectureMunicipalityCount, len(stats.Prefectures))
+	for i, p := range stats.Prefectures {
+		prefectures[i] = &PrefectureMunicipalityCount{
+			PrefectureCode:    p.PrefectureCode,
+			PrefectureName:    p.PrefectureName,
+			Total:             p.Total,
+			PrefectureLevel:   p.PrefectureLevel,
+			MunicipalityLevel: p.MunicipalityLevel,
+			Active:            p.Active,
+			Inactive:          p.Inactive,
+		}
+	}
+
+	c.JSON(http.StatusOK, &MunicipalityStatistics{
+		Total:             stats.Total,
+		PrefectureLevel:   stats.PrefectureLevel,
+		MunicipalityLevel: stats.MunicipalityLevel,
+		Active:            stats.Active,
+		Inactive:          stats.Inactive,
+		Prefectures:       prefectures,
+	})
+}
@@ -0,0 +1,99 @@
+package handler_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	domain "g_gen/internal/domain/repository"
+	"g_gen/internal/handler"
+	"g_gen/internal/infra/logger"
+	mockusecase "g_gen/tests/mock/usecase"
+)
+
+func TestAdminHandler_GetMunicipalityStatistics(t *testing.T) {
+	tests := []struct {
+		name       string
+		mockSetup  func(mockUseCase *mockusecase.MockMunicipalityUseCase)
+		wantStatus int
+		wantBody   func() string
+	}{
+		{
+			name: "Success",
+			mockSetup: func(mockUseCase *mockusecase.MockMunicipalityUseCase) {
+				mockUseCase.EXPECT().GetMunicipalityStatistics(gomock.Any()).Return(&domain.MunicipalityStatistics{
+					Total:             3,
+					PrefectureLevel:   1,
+					MunicipalityLevel: 2,
+					Active:            2,
+					Inactive:          1,
+					Prefectures: []*domain.PrefectureMunicipalityCount{
+						{PrefectureCode: "13", PrefectureName: "東京都", Total: 3, PrefectureLevel: 1, MunicipalityLevel: 2, Active: 2, Inactive: 1},
+					},
+				}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				return `{"total":3,"prefecture_level":1,"municipality_level":2,"active":2,"inactive":1,"prefectures":[` +
+					`{"prefecture_code":"13","prefecture_name":"東京都","total":3,"prefecture_level":1,"municipality_level":2,"active":2,"inactive":1}]}`
+			},
+		},
+		{
+			name: "Success/データなし",
+			mockSetup: func(mockUseCase *mockusecase.MockMunicipalityUseCase) {
+				mockUseCase.EXPECT().GetMunicipalityStatistics(gomock.Any()).
+					Return(&domain.MunicipalityStatistics{Prefectures: []*domain.PrefectureMunicipalityCount{}}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				return `{"total":0,"prefecture_level":0,"municipality_level":0,"active":0,"inactive":0,"prefectures":[]}`
+			},
+		},
+		{
+			name: "Error",
+			mockSetup: func(mockUseCase *mockusecase.MockMunicipalityUseCase) {
+				mockUseCase.EXPECT().GetMunicipalityStatistics(gomock.Any()).Return(nil, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodGet, "/admin/municipalities/statistics", nil)
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+
+			uc := mockusecase.NewMockMunicipalityUseCase(ctrl)
+			tt.mockSetup(uc)
+
+			mockHandler := handler.NewAdminHandler(appLogger, uc)
+			mockHandler.GetMunicipalityStatistics(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+
+			if tt.wantBody != nil {
+				wantBody := tt.wantBody()
+				resBody := rec.Body.String()
+				if !cmp.Equal(wantBody, resBody) {
+					t.Errorf("diff: %s", cmp.Diff(wantBody, resBody))
+				}
+			}
+		})
+	}
+}
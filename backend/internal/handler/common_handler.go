@@ -1,6 +1,9 @@
 package handler
 
 import (
+	"net/http"
+	"time"
+
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
@@ -9,6 +12,9 @@ import (
 
 const traceIDKey = "trace_id"
 
+// UserRoleKey 認証のミドルウェアが設定する、操作する利用者の役割のキー
+const UserRoleKey = "user_role"
+
 func GetTraceID(c *gin.Context) string {
 	traceID, exists := c.Get(traceIDKey)
 	if !exists {
@@ -18,8 +24,6 @@ func GetTraceID(c *gin.Context) string {
 	return traceID.(string)
 }
 
-type EmptyResponse struct{}
-
 func handleError(c *gin.Context, err error, appLogger *logger.Logger, message string) {
 	res := CreateErrResponse(err)
 	res.outputErrorLog(appLogger, message, GetTraceID(c))
@@ -31,3 +35,12 @@ func handleValidationError(c *gin.Context, err error, appLogger *logger.Logger,
 	res.outputErrorLog(appLogger, message, GetTraceID(c))
 	c.AbortWithStatusJSON(res.status, res)
 }
+
+// setLastModified 条件付きGET（If-Modified-Since）のためにLast-Modifiedヘッダを設定する
+func setLastModified(c *gin.Context, updatedAt time.Time) {
+	if updatedAt.IsZero() {
+		return
+	}
+
+	c.Header("Last-Modified", updatedAt.UTC().Format(http.TimeFormat))
+}
@@ -0,0 +1,277 @@
+package handler
+
+import (
+	"errors"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	"g_gen/internal/domain/model"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/infra/logger"
+	"g_gen/internal/pagination"
+	"g_gen/internal/usecase"
+)
+
+// multipartOverhead ファイル本体以外にmultipartのリクエストに含まれる境界・ヘッダーの分として、サイズの上限に加える余裕
+const multipartOverhead = 1 << 20
+
+// uploadAttachmentForm 添付ファイル登録のmultipartのフォーム
+type uploadAttachmentForm struct {
+	File *multipart.FileHeader `form:"file" binding:"required" ja:"ファイル"`
+}
+
+type damageReportAttachmentHandler struct {
+	appLogger                     *logger.Logger
+	damageReportAttachmentUseCase usecase.DamageReportAttachmentUseCase
+	cursorCodec                   *pagination.CursorCodec
+	maxSize                       int64
+}
+
+// NewDamageReportAttachmentHandler maxSize は添付できるファイルの最大サイズ（バイト）。これを大きく超えるリクエストは読み込まずに拒否する
+func NewDamageReportAttachmentHandler(
+	l *logger.Logger,
+	damageReportAttachmentUseCase usecase.DamageReportAttachmentUseCase,
+	cursorCodec *pagination.CursorCodec,
+	maxSize int64,
+) DamageReportAttachmentHandler {
+	return &damageReportAttachmentHandler{
+		appLogger:                     l,
+		damageReportAttachmentUseCase: damageReportAttachmentUseCase,
+		cursorCodec:                   cursorCodec,
+		maxSize:                       maxSize,
+	}
+}
+
+// ListDamageReportAttachments @title 添付ファイル一覧取得
+// @id ListDamageReportAttachments
+// @tags damage-report-attachments
+// @accept json
+// @produce json
+// @Param id path int true "被害報告ID"
+// @Param page query int false "ページ番号（既定1）"
+// @Param per_page query int false "1ページあたりの件数（既定20、最大100）"
+// @Param sort query string false "ソート条件（id, created_at。降順は先頭に-）"
+// @Param cursor query string false "前のレスポンスのnext_cursor。指定した場合はpageを無視してカーソルの位置から取得"
+// @Summary 添付ファイル一覧取得
+// @Success 200 {object} AttachmentListResponse
+// @Header 200 {string} Link "前後のページへのリンク"
+// @Header 200 {integer} X-Total-Count "総件数"
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 被害報告に添付された写真・書類の一覧をページ単位で取得します。並び順の指定がない場合は添付した順です。
+// @Router /damage-reports/{id}/attachments [get]
+func (h *damageReportAttachmentHandler) ListDamageReportAttachments(c *gin.Context) {
+	var uri ListDamageReportAttachmentsPathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid damage report id")
+
+		return
+	}
+
+	var query ListDamageReportAttachmentsQueryParams
+	if err := c.ShouldBindQuery(&query); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid damage report attachment list request")
+
+		return
+	}
+
+	params, err := toPaginationParams(c, &PaginationRequest{
+		Page:    query.Page,
+		PerPage: query.PerPage,
+		Sort:    query.Sort,
+		Cursor:  query.Cursor,
+	}, h.cursorCodec)
+	if err != nil {
+		handleError(c, err, h.appLogger, "invalid damage report attachment list cursor")
+
+		return
+	}
+
+	attachments, meta, err := h.damageReportAttachmentUseCase.ListAttachments(c.Request.Context(), uri.ID, params)
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to list damage report attachments")
+
+		return
+	}
+
+	response := make([]*AttachmentResponse, len(attachments))
+	for i, a := range attachments {
+		response[i] = toAttachmentResponse(a)
+	}
+
+	c.JSON(http.StatusOK, &AttachmentListResponse{
+		Items:      response,
+		Pagination: writePaginationHeaders(c, meta, h.cursorCodec),
+	})
+}
+
+// UploadDamageReportAttachment @title 添付ファイル登録
+// @id UploadDamageReportAttachment
+// @tags damage-report-attachments
+// @accept multipart/form-data
+// @produce json
+// @Param id path int true "被害報告ID"
+// @Param file formData file true "添付するファイル（JPEG・PNG・WebP・PDF）"
+// @Summary 添付ファイル登録
+// @Success 201 {object} AttachmentResponse
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 404 {object} ErrorResponse
+// @Failure 413 {object} ErrorResponse
+// @Failure 415 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 被害報告に写真（JPEG・PNG・WebP）またはPDFを添付します。ファイルの種類はファイル名ではなく内容から判定します。写真の場合は、EXIFの撮影日時・撮影位置の読み取りとサムネイルの作成をレスポンスの後にバックグラウンドで行い、作成中の被害報告の位置が未設定の場合は撮影位置で補います。被災日は変更せず、撮影日を添付ファイルの被災日の候補として返します。
+// @Router /damage-reports/{id}/attachments [post]
+func (h *damageReportAttachmentHandler) UploadDamageReportAttachment(c *gin.Context) {
+	var uri UploadDamageReportAttachmentPathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid damage report id")
+
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxSize+multipartOverhead)
+
+	var form uploadAttachmentForm
+	if err := c.ShouldBindWith(&form, binding.FormMultipart); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			handleError(c, myerrors.NewAPIError(
+				myerrors.AttachmentTooLargeError,
+				myerrors.AttachmentTooLargeErrorMessage,
+				err,
+				"attachment request too large",
+			), h.appLogger, "failed to upload damage report attachment")
+
+			return
+		}
+
+		handleValidationError(c, err, h.appLogger, "invalid damage report attachment request")
+
+		return
+	}
+
+	file, err := form.File.Open()
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to open damage report attachment")
+
+		return
+	}
+	defer file.Close()
+
+	attachment, err := h.damageReportAttachmentUseCase.UploadAttachment(
+		c.Request.Context(),
+		uri.ID,
+		form.File.Filename,
+		form.File.Size,
+		file,
+	)
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to upload damage report attachment")
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, toAttachmentResponse(attachment))
+}
+
+// DownloadDamageReportAttachment @title 添付ファイル取得
+// @id DownloadDamageReportAttachment
+// @tags damage-report-attachments
+// @accept json
+// @produce image/jpeg,image/png,image/webp,application/pdf
+// @Param id path int true "被害報告ID"
+// @Param attachment_id path int true "添付ファイルID"
+// @Summary 添付ファイル取得
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 被害報告に添付されたファイルの本体を取得します。
+// @Router /damage-reports/{id}/attachments/{attachment_id} [get]
+func (h *damageReportAttachmentHandler) DownloadDamageReportAttachment(c *gin.Context) {
+	var uri DownloadDamageReportAttachmentPathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid damage report attachment id")
+
+		return
+	}
+
+	attachment, body, err := h.damageReportAttachmentUseCase.DownloadAttachment(c.Request.Context(), uri.ID, uri.AttachmentID)
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to download damage report attachment")
+
+		return
+	}
+	defer body.Close()
+
+	// ブラウザで開かずに保存させ、内容からの種類の推測（スニッフィング）もさせない
+	c.DataFromReader(http.StatusOK, attachment.Size, attachment.ContentType, body, map[string]string{
+		"Content-Disposition":    mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}),
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+// DownloadDamageReportAttachmentThumbnail @title 添付ファイルのサムネイル取得
+// @id DownloadDamageReportAttachmentThumbnail
+// @tags damage-report-attachments
+// @accept json
+// @produce image/jpeg
+// @Param id path int true "被害報告ID"
+// @Param attachment_id path int true "添付ファイルID"
+// @Summary 添付ファイルのサムネイル取得
+// @Success 200 {file} file
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 添付された写真から作成した320×240のJPEGのサムネイルを取得します。サムネイルは添付の後にバックグラウンドで作成するため、作成前（has_thumbnail が false）と写真以外の添付ファイルは404になります。
+// @Router /damage-reports/{id}/attachments/{attachment_id}/thumbnail [get]
+func (h *damageReportAttachmentHandler) DownloadDamageReportAttachmentThumbnail(c *gin.Context) {
+	var uri DownloadDamageReportAttachmentThumbnailPathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid damage report attachment id")
+
+		return
+	}
+
+	body, err := h.damageReportAttachmentUseCase.DownloadThumbnail(c.Request.Context(), uri.ID, uri.AttachmentID)
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to download damage report attachment thumbnail")
+
+		return
+	}
+	defer body.Close()
+
+	// サムネイルはサーバーで作成したJPEGのため、一覧でそのまま表示させる
+	c.DataFromReader(http.StatusOK, -1, "image/jpeg", body, map[string]string{
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+func toAttachmentResponse(a *model.DamageReportAttachment) *AttachmentResponse {
+	// 被災日の候補は撮影日時のタイムゾーンでの日付とする
+	var suggestedDamageDate *string
+	if a.CapturedAt != nil {
+		date := a.CapturedAt.Format(time.DateOnly)
+		suggestedDamageDate = &date
+	}
+
+	return &AttachmentResponse{
+		ID:                  a.ID,
+		DamageReportID:      a.DamageReportID,
+		FileName:            a.FileName,
+		ContentType:         a.ContentType,
+		Size:                a.Size,
+		CapturedAt:          a.CapturedAt,
+		SuggestedDamageDate: suggestedDamageDate,
+		Latitude:            a.Latitude,
+		Longitude:           a.Longitude,
+		HasThumbnail:        a.ThumbnailKey != nil,
+		CreatedAt:           a.CreatedAt,
+	}
+}
@@ -0,0 +1,550 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"g_gen/internal/domain/model"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/handler"
+	"g_gen/internal/infra/logger"
+	"g_gen/internal/pagination"
+	mockusecase "g_gen/tests/mock/usecase"
+)
+
+// attachmentMaxSize テストで使う添付ファイルの最大サイズ
+const attachmentMaxSize = 1024
+
+var (
+	attachmentCreatedAt  = time.Date(2026, 7, 2, 9, 30, 0, 0, time.UTC)
+	attachmentCapturedAt = time.Date(2026, 6, 30, 14, 5, 0, 0, time.FixedZone("JST", 9*60*60))
+	// attachmentSuggested 撮影日時のタイムゾーンでの撮影日
+	attachmentSuggested = "2026-06-30"
+	attachmentLatitude  = 35.685175
+	attachmentLongitude = 139.752799
+	attachmentThumbnail = "damage-reports/1/a-thumbnail.jpg"
+)
+
+// expectedAttachmentModel 写真の処理（EXIFの読み取り・サムネイルの作成）を終えた添付ファイル
+func expectedAttachmentModel() *model.DamageReportAttachment {
+	return &model.DamageReportAttachment{
+		ID:             1,
+		DamageReportID: 1,
+		FileName:       "被災状況.jpg",
+		ContentType:    "image/jpeg",
+		Size:           9,
+		StorageKey:     "damage-reports/1/a",
+		CreatedAt:      attachmentCreatedAt,
+		CapturedAt:     &attachmentCapturedAt,
+		Latitude:       &attachmentLatitude,
+		Longitude:      &attachmentLongitude,
+		ThumbnailKey:   &attachmentThumbnail,
+	}
+}
+
+func expectedAttachmentResponse() *handler.AttachmentResponse {
+	return &handler.AttachmentResponse{
+		ID:                  1,
+		DamageReportID:      1,
+		FileName:            "被災状況.jpg",
+		ContentType:         "image/jpeg",
+		Size:                9,
+		CapturedAt:          &attachmentCapturedAt,
+		SuggestedDamageDate: &attachmentSuggested,
+		Latitude:            &attachmentLatitude,
+		Longitude:           &attachmentLongitude,
+		HasThumbnail:        true,
+		CreatedAt:           attachmentCreatedAt,
+	}
+}
+
+// uploadedAttachmentModel 登録した直後の、写真の処理を終える前の添付ファイル
+func uploadedAttachmentModel() *model.DamageReportAttachment {
+	a := expectedAttachmentModel()
+	a.CapturedAt, a.Latitude, a.Longitude, a.ThumbnailKey = nil, nil, nil, nil
+
+	return a
+}
+
+func uploadedAttachmentResponse() *handler.AttachmentResponse {
+	r := expectedAttachmentResponse()
+	r.CapturedAt, r.SuggestedDamageDate, r.Latitude, r.Longitude, r.HasThumbnail = nil, nil, nil, nil, false
+
+	return r
+}
+
+// multipartBody field のファイルを含むmultipartのリクエストボディとContent-Typeを作る
+func multipartBody(t *testing.T, field, fileName string, content []byte) (*bytes.Buffer, string) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	if field != "" {
+		part, err := w.CreateFormFile(field, fileName)
+		require.NoError(t, err)
+		_, err = part.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+
+	return &body, w.FormDataContentType()
+}
+
+func TestDamageReportAttachmentHandler_ListDamageReportAttachments(t *testing.T) {
+	params := pagination.NewParams(1, 20, nil, map[string]string{})
+	nextCursor := &pagination.Cursor{Sort: "id", Keys: []string{"1"}}
+	encodedNextCursor := testCursorCodec.Encode(nextCursor)
+	cursorParams := pagination.NewParams(1, 1, nil, map[string]string{})
+	cursorParams.Cursor = nextCursor
+
+	tests := []struct {
+		name       string
+		id         string
+		query      string
+		mockSetup  func(mockUseCase *mockusecase.MockDamageReportAttachmentUseCase)
+		wantStatus int
+		wantBody   func() string
+	}{
+		{
+			name: "Success",
+			id:   "1",
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportAttachmentUseCase) {
+				mockUseCase.EXPECT().ListAttachments(gomock.Any(), 1, params).
+					Return([]*model.DamageReportAttachment{expectedAttachmentModel()}, pagination.NewMeta(params, 1, nil), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				responseJSON, _ := json.Marshal(&handler.AttachmentListResponse{
+					Items: []*handler.AttachmentResponse{expectedAttachmentResponse()},
+					Pagination: &handler.PaginationResponse{
+						Page:       1,
+						PerPage:    20,
+						TotalCount: 1,
+						TotalPages: 1,
+					},
+				})
+				return string(responseJSON)
+			},
+		},
+		{
+			name:  "Success/カーソル",
+			id:    "1",
+			query: "?per_page=1&cursor=" + encodedNextCursor,
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportAttachmentUseCase) {
+				mockUseCase.EXPECT().ListAttachments(gomock.Any(), 1, cursorParams).
+					Return([]*model.DamageReportAttachment{expectedAttachmentModel()}, pagination.NewMeta(cursorParams, 2, nil), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				responseJSON, _ := json.Marshal(&handler.AttachmentListResponse{
+					Items: []*handler.AttachmentResponse{expectedAttachmentResponse()},
+					Pagination: &handler.PaginationResponse{
+						PerPage:    1,
+						TotalCount: 2,
+						TotalPages: 2,
+					},
+				})
+				return string(responseJSON)
+			},
+		},
+		{
+			name:       "Invalid Cursor",
+			id:         "1",
+			query:      "?cursor=invalid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Invalid ID",
+			id:         "abc",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Damage Report Not Found",
+			id:   "99",
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportAttachmentUseCase) {
+				mockUseCase.EXPECT().ListAttachments(gomock.Any(), 99, params).Return(nil, pagination.Meta{}, &myerrors.APIError{
+					Code:    myerrors.DamageReportNotFoundError,
+					Message: myerrors.DamageReportNotFoundErrorMessage,
+				})
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodGet, "/damage-reports/"+tt.id+"/attachments"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+			c.Params = []gin.Param{{Key: "id", Value: tt.id}}
+
+			uc := mockusecase.NewMockDamageReportAttachmentUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			mockHandler := handler.NewDamageReportAttachmentHandler(appLogger, uc, testCursorCodec, attachmentMaxSize)
+			mockHandler.ListDamageReportAttachments(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+
+			if tt.wantBody != nil {
+				wantBody := tt.wantBody()
+				resBody := rec.Body.String()
+				if !cmp.Equal(wantBody, resBody) {
+					t.Errorf("diff: %s", cmp.Diff(wantBody, resBody))
+				}
+			}
+		})
+	}
+}
+
+func TestDamageReportAttachmentHandler_UploadDamageReportAttachment(t *testing.T) {
+	content := []byte("\xFF\xD8\xFF\xE0photo")
+
+	tests := []struct {
+		name        string
+		id          string
+		body        func(t *testing.T) (io.Reader, string)
+		mockSetup   func(mockUseCase *mockusecase.MockDamageReportAttachmentUseCase)
+		wantStatus  int
+		wantBody    func() string
+		wantDetails []handler.ValidationError
+	}{
+		{
+			name: "Success",
+			id:   "1",
+			body: func(t *testing.T) (io.Reader, string) {
+				return multipartBody(t, "file", "被災状況.jpg", content)
+			},
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportAttachmentUseCase) {
+				mockUseCase.EXPECT().UploadAttachment(gomock.Any(), 1, "被災状況.jpg", int64(len(content)), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ int, _ string, _ int64, body io.ReadSeeker) (*model.DamageReportAttachment, error) {
+						got, err := io.ReadAll(body)
+						assert.NoError(t, err)
+						assert.Equal(t, content, got)
+
+						return uploadedAttachmentModel(), nil
+					})
+			},
+			wantStatus: http.StatusCreated,
+			wantBody: func() string {
+				responseJSON, _ := json.Marshal(uploadedAttachmentResponse())
+				return string(responseJSON)
+			},
+		},
+		{
+			name: "Validation Error/ファイルなし",
+			id:   "1",
+			body: func(t *testing.T) (io.Reader, string) {
+				return multipartBody(t, "", "", nil)
+			},
+			wantStatus: http.StatusBadRequest,
+			wantDetails: []handler.ValidationError{
+				{Attribute: "ファイル", Tag: "required", Message: "ファイルは必須フィールドです"},
+			},
+		},
+		{
+			name: "Validation Error/multipartではない",
+			id:   "1",
+			body: func(t *testing.T) (io.Reader, string) {
+				return strings.NewReader(`{"file":"photo"}`), "application/json"
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Invalid ID",
+			id:   "abc",
+			body: func(t *testing.T) (io.Reader, string) {
+				return multipartBody(t, "file", "被災状況.jpg", content)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Too Large/リクエストを読み込まずに拒否",
+			id:   "1",
+			body: func(t *testing.T) (io.Reader, string) {
+				return multipartBody(t, "file", "被災状況.jpg", make([]byte, attachmentMaxSize+2<<20))
+			},
+			wantStatus: http.StatusRequestEntityTooLarge,
+			wantBody: func() string {
+				return `{"code":"E100011","message":"添付ファイルのサイズが上限を超えています"}`
+			},
+		},
+		{
+			name: "Unsupported Media Type",
+			id:   "1",
+			body: func(t *testing.T) (io.Reader, string) {
+				return multipartBody(t, "file", "memo.txt", []byte("memo"))
+			},
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportAttachmentUseCase) {
+				mockUseCase.EXPECT().UploadAttachment(gomock.Any(), 1, "memo.txt", int64(4), gomock.Any()).
+					Return(nil, &myerrors.APIError{
+						Code:    myerrors.UnsupportedAttachmentTypeError,
+						Message: myerrors.UnsupportedAttachmentTypeErrorMessage,
+					})
+			},
+			wantStatus: http.StatusUnsupportedMediaType,
+			wantBody: func() string {
+				return `{"code":"E100012","message":"添付できるのは写真（JPEG・PNG・WebP）とPDFのみです"}`
+			},
+		},
+		{
+			name: "Damage Report Not Found",
+			id:   "99",
+			body: func(t *testing.T) (io.Reader, string) {
+				return multipartBody(t, "file", "被災状況.jpg", content)
+			},
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportAttachmentUseCase) {
+				mockUseCase.EXPECT().UploadAttachment(gomock.Any(), 99, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, &myerrors.APIError{
+						Code:    myerrors.DamageReportNotFoundError,
+						Message: myerrors.DamageReportNotFoundErrorMessage,
+					})
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			body, contentType := tt.body(t)
+			req := httptest.NewRequest(http.MethodPost, "/damage-reports/"+tt.id+"/attachments", body)
+			req.Header.Set("Content-Type", contentType)
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+			c.Params = []gin.Param{{Key: "id", Value: tt.id}}
+
+			uc := mockusecase.NewMockDamageReportAttachmentUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			mockHandler := handler.NewDamageReportAttachmentHandler(appLogger, uc, testCursorCodec, attachmentMaxSize)
+			mockHandler.UploadDamageReportAttachment(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+
+			if tt.wantBody != nil {
+				wantBody := tt.wantBody()
+				resBody := rec.Body.String()
+				if !cmp.Equal(wantBody, resBody) {
+					t.Errorf("diff: %s", cmp.Diff(wantBody, resBody))
+				}
+			}
+
+			if tt.wantDetails != nil {
+				var res handler.ErrorResponseDetail
+				a.NoError(json.Unmarshal(rec.Body.Bytes(), &res))
+				a.Equal(myerrors.ValidationError, res.Code)
+				if !cmp.Equal(tt.wantDetails, res.Details) {
+					t.Errorf("diff: %s", cmp.Diff(tt.wantDetails, res.Details))
+				}
+			}
+		})
+	}
+}
+
+func TestDamageReportAttachmentHandler_DownloadDamageReportAttachment(t *testing.T) {
+	content := []byte("\xFF\xD8\xFF\xE0photo")
+
+	tests := []struct {
+		name         string
+		id           string
+		attachmentID string
+		mockSetup    func(mockUseCase *mockusecase.MockDamageReportAttachmentUseCase)
+		wantStatus   int
+		wantHeader   http.Header
+		wantBody     string
+	}{
+		{
+			name:         "Success",
+			id:           "1",
+			attachmentID: "1",
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportAttachmentUseCase) {
+				mockUseCase.EXPECT().DownloadAttachment(gomock.Any(), 1, 1).
+					Return(expectedAttachmentModel(), io.NopCloser(bytes.NewReader(content)), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantHeader: http.Header{
+				"Content-Type":           {"image/jpeg"},
+				"Content-Length":         {"9"},
+				"Content-Disposition":    {"attachment; filename*=utf-8''%E8%A2%AB%E7%81%BD%E7%8A%B6%E6%B3%81.jpg"},
+				"X-Content-Type-Options": {"nosniff"},
+			},
+			wantBody: string(content),
+		},
+		{
+			name:         "Invalid ID",
+			id:           "1",
+			attachmentID: "abc",
+			wantStatus:   http.StatusBadRequest,
+		},
+		{
+			name:         "Not Found",
+			id:           "1",
+			attachmentID: "99",
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportAttachmentUseCase) {
+				mockUseCase.EXPECT().DownloadAttachment(gomock.Any(), 1, 99).Return(nil, nil, &myerrors.APIError{
+					Code:    myerrors.AttachmentNotFoundError,
+					Message: myerrors.AttachmentNotFoundErrorMessage,
+				})
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"code":"E100010","message":"添付ファイルは存在しません"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodGet, "/damage-reports/"+tt.id+"/attachments/"+tt.attachmentID, nil)
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+			c.Params = []gin.Param{
+				{Key: "id", Value: tt.id},
+				{Key: "attachment_id", Value: tt.attachmentID},
+			}
+
+			uc := mockusecase.NewMockDamageReportAttachmentUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			mockHandler := handler.NewDamageReportAttachmentHandler(appLogger, uc, testCursorCodec, attachmentMaxSize)
+			mockHandler.DownloadDamageReportAttachment(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+
+			for key := range tt.wantHeader {
+				a.Equal(tt.wantHeader.Get(key), rec.Header().Get(key), key)
+			}
+
+			if tt.wantBody != "" {
+				a.Equal(tt.wantBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestDamageReportAttachmentHandler_DownloadDamageReportAttachmentThumbnail(t *testing.T) {
+	content := []byte("\xFF\xD8\xFF\xE0thumbnail")
+
+	tests := []struct {
+		name         string
+		id           string
+		attachmentID string
+		mockSetup    func(mockUseCase *mockusecase.MockDamageReportAttachmentUseCase)
+		wantStatus   int
+		wantHeader   http.Header
+		wantBody     string
+	}{
+		{
+			name:         "Success",
+			id:           "1",
+			attachmentID: "1",
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportAttachmentUseCase) {
+				mockUseCase.EXPECT().DownloadThumbnail(gomock.Any(), 1, 1).
+					Return(io.NopCloser(bytes.NewReader(content)), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantHeader: http.Header{
+				"Content-Type":           {"image/jpeg"},
+				"X-Content-Type-Options": {"nosniff"},
+			},
+			wantBody: string(content),
+		},
+		{
+			name:         "Invalid ID",
+			id:           "1",
+			attachmentID: "0",
+			wantStatus:   http.StatusBadRequest,
+		},
+		{
+			name:         "Thumbnail Not Found",
+			id:           "1",
+			attachmentID: "2",
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportAttachmentUseCase) {
+				mockUseCase.EXPECT().DownloadThumbnail(gomock.Any(), 1, 2).Return(nil, &myerrors.APIError{
+					Code:    myerrors.ThumbnailNotFoundError,
+					Message: myerrors.ThumbnailNotFoundErrorMessage,
+				})
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"code":"E100013","message":"サムネイルは存在しません"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodGet, "/damage-reports/"+tt.id+"/attachments/"+tt.attachmentID+"/thumbnail", nil)
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+			c.Params = []gin.Param{
+				{Key: "id", Value: tt.id},
+				{Key: "attachment_id", Value: tt.attachmentID},
+			}
+
+			uc := mockusecase.NewMockDamageReportAttachmentUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			mockHandler := handler.NewDamageReportAttachmentHandler(appLogger, uc, testCursorCodec, attachmentMaxSize)
+			mockHandler.DownloadDamageReportAttachmentThumbnail(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+
+			for key := range tt.wantHeader {
+				a.Equal(tt.wantHeader.Get(key), rec.Header().Get(key), key)
+			}
+
+			if tt.wantBody != "" {
+				a.Equal(tt.wantBody, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,329 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"g_gen/internal/domain/model"
+	"g_gen/internal/infra/logger"
+	"g_gen/internal/pagination"
+	"g_gen/internal/usecase"
+)
+
+type damageReportHandler struct {
+	appLogger           *logger.Logger
+	damageReportUseCase usecase.DamageReportUseCase
+	cursorCodec         *pagination.CursorCodec
+}
+
+func NewDamageReportHandler(
+	l *logger.Logger,
+	damageReportUseCase usecase.DamageReportUseCase,
+	cursorCodec *pagination.CursorCodec,
+) DamageReportHandler {
+	return &damageReportHandler{
+		appLogger:           l,
+		damageReportUseCase: damageReportUseCase,
+		cursorCodec:         cursorCodec,
+	}
+}
+
+// ListDamageReports @title 被害報告一覧取得
+// @id ListDamageReports
+// @tags damage-reports
+// @accept json
+// @produce json
+// @Param page query int false "ページ番号（既定1）"
+// @Param per_page query int false "1ページあたりの件数（既定20、最大100）"
+// @Param sort query string false "ソート条件（id, damage_date, estimated_amount。降順は先頭に-）"
+// @Param cursor query string false "前のレスポンスのnext_cursor。指定した場合はpageを無視してカーソルの位置から取得"
+// @Param filter[organization_code] query string false "団体コードで絞り込み"
+// @Param filter[work_category_id] query int false "工種区分IDで絞り込み"
+// @Param filter[status] query string false "状態で絞り込み" Enums(draft, reported, confirmed, withdrawn)
+// @Param filter[damage_date_from] query string false "被災日がこの日以降のものに絞り込み（YYYY-MM-DD）"
+// @Param filter[damage_date_to] query string false "被災日がこの日以前のものに絞り込み（YYYY-MM-DD）"
+// @Summary 被害報告一覧取得
+// @Success 200 {object} DamageReportListResponse
+// @Header 200 {string} Link "前後のページへのリンク"
+// @Header 200 {integer} X-Total-Count "総件数"
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 被害報告の一覧をページ単位で取得します。並び順の指定がない場合は被害報告ID順です。
+// @Router /damage-reports [get]
+func (h *damageReportHandler) ListDamageReports(c *gin.Context) {
+	ctx := c.Request.Context()
+	var query ListDamageReportsQueryParams
+	if err := c.ShouldBindQuery(&query); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid damage report list request")
+
+		return
+	}
+
+	params, err := toPaginationParams(c, &PaginationRequest{
+		Page:    query.Page,
+		PerPage: query.PerPage,
+		Sort:    query.Sort,
+		Cursor:  query.Cursor,
+	}, h.cursorCodec)
+	if err != nil {
+		handleError(c, err, h.appLogger, "invalid damage report list cursor")
+
+		return
+	}
+
+	damageReports, meta, err := h.damageReportUseCase.ListDamageReports(ctx, params)
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to list damage reports")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, &DamageReportListResponse{
+		Items:      toDamageReportResponses(damageReports),
+		Pagination: writePaginationHeaders(c, meta, h.cursorCodec),
+	})
+}
+
+// GetDamageReport @title 被害報告詳細取得
+// @id GetDamageReport
+// @tags damage-reports
+// @accept json
+// @produce json
+// @Param id path int true "被害報告ID"
+// @Summary 被害報告詳細取得
+// @Success 200 {object} DamageReportResponse
+// @Header 200 {string} Last-Modified "更新日時"
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 被害報告IDを指定して、被害報告を取得します。
+// @Router /damage-reports/{id} [get]
+func (h *damageReportHandler) GetDamageReport(c *gin.Context) {
+	var uri GetDamageReportPathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid damage report id")
+
+		return
+	}
+
+	damageReport, err := h.damageReportUseCase.GetDamageReport(c.Request.Context(), uri.ID)
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to get damage report")
+
+		return
+	}
+
+	setLastModified(c, damageReport.UpdatedAt)
+	c.JSON(http.StatusOK, toDamageReportResponse(damageReport))
+}
+
+// CreateDamageReport @title 被害報告登録
+// @id CreateDamageReport
+// @tags damage-reports
+// @accept json
+// @produce json
+// @Param request body CreateDamageReportRequest true "被害報告"
+// @Summary 被害報告登録
+// @Success 201 {object} DamageReportResponse
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 被害報告を作成中の状態で登録します。合併前の団体コードは承継先の団体コードに置き換えて保存します。市区町村・工種区分は有効なものである必要があります。
+// @Router /damage-reports [post]
+func (h *damageReportHandler) CreateDamageReport(c *gin.Context) {
+	var req CreateDamageReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid damage report request")
+
+		return
+	}
+
+	damageReport, err := h.damageReportUseCase.CreateDamageReport(c.Request.Context(), &model.DamageReport{
+		OrganizationCode: req.OrganizationCode,
+		WorkCategoryID:   *req.WorkCategoryID,
+		DamageDate:       parseDate(req.DamageDate),
+		Location:         req.Location,
+		EstimatedAmount:  *req.EstimatedAmount,
+		Area:             req.Area,
+	})
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to create damage report")
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, toDamageReportResponse(damageReport))
+}
+
+// UpdateDamageReport @title 被害報告更新
+// @id UpdateDamageReport
+// @tags damage-reports
+// @accept json
+// @produce json
+// @Param id path int true "被害報告ID"
+// @Param request body UpdateDamageReportRequest true "被害報告"
+// @Summary 被害報告更新
+// @Success 200 {object} DamageReportResponse
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 被害報告の内容を更新します。合併前の団体コードは承継先の団体コードに置き換えて保存します。市区町村・工種区分は有効なものである必要があります。状態は報告・確定・取り下げの各エンドポイントで変更します。
+// @Router /damage-reports/{id} [put]
+func (h *damageReportHandler) UpdateDamageReport(c *gin.Context) {
+	var uri UpdateDamageReportPathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid damage report id")
+
+		return
+	}
+
+	var req UpdateDamageReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid damage report request")
+
+		return
+	}
+
+	damageReport, err := h.damageReportUseCase.UpdateDamageReport(c.Request.Context(), &model.DamageReport{
+		ID:               int64(uri.ID),
+		OrganizationCode: req.OrganizationCode,
+		WorkCategoryID:   *req.WorkCategoryID,
+		DamageDate:       parseDate(req.DamageDate),
+		Location:         req.Location,
+		EstimatedAmount:  *req.EstimatedAmount,
+		Area:             req.Area,
+	})
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to update damage report")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, toDamageReportResponse(damageReport))
+}
+
+// ReportDamageReport @title 被害報告の報告
+// @id ReportDamageReport
+// @tags damage-reports
+// @accept json
+// @produce json
+// @Param id path int true "被害報告ID"
+// @Summary 被害報告の報告
+// @Success 200 {object} DamageReportResponse
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 作成中の被害報告を報告し、報告済みにします。
+// @Router /damage-reports/{id}/report [post]
+func (h *damageReportHandler) ReportDamageReport(c *gin.Context) {
+	var uri ReportDamageReportPathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid damage report id")
+
+		return
+	}
+
+	h.transition(c, uri.ID, usecase.DamageReportActionReport)
+}
+
+// ConfirmDamageReport @title 被害報告の確定
+// @id ConfirmDamageReport
+// @tags damage-reports
+// @accept json
+// @produce json
+// @Param id path int true "被害報告ID"
+// @Summary 被害報告の確定
+// @Success 200 {object} DamageReportResponse
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 報告済みの被害報告を確定します。確定した被害報告の状態は変更できません。
+// @Router /damage-reports/{id}/confirm [post]
+func (h *damageReportHandler) ConfirmDamageReport(c *gin.Context) {
+	var uri ConfirmDamageReportPathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid damage report id")
+
+		return
+	}
+
+	h.transition(c, uri.ID, usecase.DamageReportActionConfirm)
+}
+
+// WithdrawDamageReport @title 被害報告の取り下げ
+// @id WithdrawDamageReport
+// @tags damage-reports
+// @accept json
+// @produce json
+// @Param id path int true "被害報告ID"
+// @Summary 被害報告の取り下げ
+// @Success 200 {object} DamageReportResponse
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 作成中または報告済みの被害報告を取り下げます。取り下げた被害報告の状態は変更できません。
+// @Router /damage-reports/{id}/withdraw [post]
+func (h *damageReportHandler) WithdrawDamageReport(c *gin.Context) {
+	var uri WithdrawDamageReportPathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid damage report id")
+
+		return
+	}
+
+	h.transition(c, uri.ID, usecase.DamageReportActionWithdraw)
+}
+
+// transition 状態遷移の各エンドポイントに共通する処理。遷移できるかはユースケースで判定する
+func (h *damageReportHandler) transition(c *gin.Context, id int, action usecase.DamageReportAction) {
+	damageReport, err := h.damageReportUseCase.TransitionDamageReport(c.Request.Context(), id, action)
+	if err != nil {
+		handleError(c, err, h.appLogger, fmt.Sprintf("failed to %s damage report", action))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, toDamageReportResponse(damageReport))
+}
+
+// parseDate YYYY-MM-DD形式の日付をUTCの0時として解釈する。書式はバインド時に検証済みであること
+func parseDate(value string) time.Time {
+	date, _ := time.Parse(time.DateOnly, value)
+	return date
+}
+
+func toDamageReportResponse(d *model.DamageReport) *DamageReportResponse {
+	return &DamageReportResponse{
+		ID:               d.ID,
+		OrganizationCode: d.OrganizationCode,
+		MunicipalityName: d.Municipality.MunicipalityNameKanji,
+		WorkCategoryID:   d.WorkCategoryID,
+		WorkCategoryName: d.WorkCategory.CategoryName,
+		DamageDate:       d.DamageDate.Format(time.DateOnly),
+		Location:         d.Location,
+		EstimatedAmount:  d.EstimatedAmount,
+		Area:             d.Area,
+		Latitude:         d.Latitude,
+		Longitude:        d.Longitude,
+		Status:           d.Status,
+		CreatedAt:        d.CreatedAt,
+		UpdatedAt:        d.UpdatedAt,
+	}
+}
+
+func toDamageReportResponses(damageReports []*model.DamageReport) []*DamageReportResponse {
+	response := make([]*DamageReportResponse, len(damageReports))
+	for i, d := range damageReports {
+		response[i] = toDamageReportResponse(d)
+	}
+
+	return response
+}
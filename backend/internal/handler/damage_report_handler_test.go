@@ -0,0 +1,613 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"g_gen/internal/domain/model"
+	domain "g_gen/internal/domain/repository"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/handler"
+	"g_gen/internal/infra/logger"
+	"g_gen/internal/pagination"
+	"g_gen/internal/usecase"
+	mockusecase "g_gen/tests/mock/usecase"
+)
+
+var damageReportUpdatedAt = time.Date(2026, 7, 2, 9, 30, 0, 0, time.UTC)
+
+func expectedDamageReportModel() *model.DamageReport {
+	area := 12.5
+	latitude := 35.685175
+	longitude := 139.752799
+
+	return &model.DamageReport{
+		ID:               1,
+		OrganizationCode: "131016",
+		WorkCategoryID:   2,
+		DamageDate:       time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+		Location:         "千代田区千代田1番地先",
+		EstimatedAmount:  500000,
+		Area:             &area,
+		Latitude:         &latitude,
+		Longitude:        &longitude,
+		Status:           domain.DamageReportStatusDraft,
+		CreatedAt:        damageReportUpdatedAt,
+		UpdatedAt:        damageReportUpdatedAt,
+		Municipality:     model.Municipality{OrganizationCode: "131016", MunicipalityNameKanji: "千代田区"},
+		WorkCategory:     model.WorkCategory{ID: 2, CategoryName: "水路"},
+	}
+}
+
+func expectedDamageReportResponse() *handler.DamageReportResponse {
+	area := 12.5
+	latitude := 35.685175
+	longitude := 139.752799
+
+	return &handler.DamageReportResponse{
+		ID:               1,
+		OrganizationCode: "131016",
+		MunicipalityName: "千代田区",
+		WorkCategoryID:   2,
+		WorkCategoryName: "水路",
+		DamageDate:       "2026-07-01",
+		Location:         "千代田区千代田1番地先",
+		EstimatedAmount:  500000,
+		Area:             &area,
+		Latitude:         &latitude,
+		Longitude:        &longitude,
+		Status:           domain.DamageReportStatusDraft,
+		CreatedAt:        damageReportUpdatedAt,
+		UpdatedAt:        damageReportUpdatedAt,
+	}
+}
+
+func TestDamageReportHandler_ListDamageReports(t *testing.T) {
+	tests := []struct {
+		name        string
+		query       string
+		mockSetup   func(mockUseCase *mockusecase.MockDamageReportUseCase)
+		wantStatus  int
+		wantBody    func() string
+		wantDetails []handler.ValidationError
+	}{
+		{
+			name: "Success",
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportUseCase) {
+				params := pagination.NewParams(1, 20, nil, map[string]string{})
+				mockUseCase.EXPECT().
+					ListDamageReports(gomock.Any(), params).
+					Return([]*model.DamageReport{expectedDamageReportModel()}, pagination.NewMeta(params, 1, nil), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				responseJSON, _ := json.Marshal(&handler.DamageReportListResponse{
+					Items: []*handler.DamageReportResponse{expectedDamageReportResponse()},
+					Pagination: &handler.PaginationResponse{
+						Page:       1,
+						PerPage:    20,
+						TotalCount: 1,
+						TotalPages: 1,
+					},
+				})
+				return string(responseJSON)
+			},
+		},
+		{
+			name:  "Success/ソート・絞り込み",
+			query: "?sort=-damage_date&filter[organization_code]=131016&filter[status]=draft&filter[damage_date_from]=2026-07-01",
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportUseCase) {
+				params := pagination.NewParams(
+					1,
+					20,
+					[]pagination.Sort{{Field: "damage_date", Desc: true}},
+					map[string]string{"organization_code": "131016", "status": "draft", "damage_date_from": "2026-07-01"},
+				)
+				mockUseCase.EXPECT().
+					ListDamageReports(gomock.Any(), params).
+					Return([]*model.DamageReport{expectedDamageReportModel()}, pagination.NewMeta(params, 1, nil), nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "Validation Error/絞り込み条件",
+			query:      "?filter[organization_code]=131017&filter[status]=deleted&filter[damage_date_to]=2026/07/01",
+			wantStatus: http.StatusBadRequest,
+			wantDetails: []handler.ValidationError{
+				{Attribute: "団体コード", Tag: "organization_code", Message: "団体コードは正しい団体コードである必要があります"},
+				{Attribute: "状態", Tag: "oneof", Message: "状態は[draft reported confirmed withdrawn]のうちのいずれかでなければなりません"},
+				{Attribute: "被災日（終了）", Tag: "date", Message: "被災日（終了）は日付（YYYY-MM-DD）形式である必要があります"},
+			},
+		},
+		{
+			name:       "Invalid PerPage",
+			query:      "?per_page=101",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error",
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportUseCase) {
+				mockUseCase.EXPECT().ListDamageReports(gomock.Any(), gomock.Any()).
+					Return(nil, pagination.Meta{}, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodGet, "/damage-reports"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+
+			uc := mockusecase.NewMockDamageReportUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			mockHandler := handler.NewDamageReportHandler(appLogger, uc, testCursorCodec)
+			mockHandler.ListDamageReports(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+
+			if tt.wantBody != nil {
+				wantBody := tt.wantBody()
+				resBody := rec.Body.String()
+				if !cmp.Equal(wantBody, resBody) {
+					t.Errorf("diff: %s", cmp.Diff(wantBody, resBody))
+				}
+			}
+
+			if tt.wantDetails != nil {
+				var res handler.ErrorResponseDetail
+				a.NoError(json.Unmarshal(rec.Body.Bytes(), &res))
+				if !cmp.Equal(tt.wantDetails, res.Details) {
+					t.Errorf("diff: %s", cmp.Diff(tt.wantDetails, res.Details))
+				}
+			}
+		})
+	}
+}
+
+func TestDamageReportHandler_GetDamageReport(t *testing.T) {
+	tests := []struct {
+		name             string
+		id               string
+		mockSetup        func(mockUseCase *mockusecase.MockDamageReportUseCase)
+		wantStatus       int
+		wantBody         func() string
+		wantLastModified string
+	}{
+		{
+			name: "Success",
+			id:   "1",
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportUseCase) {
+				mockUseCase.EXPECT().GetDamageReport(gomock.Any(), 1).Return(expectedDamageReportModel(), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				responseJSON, _ := json.Marshal(expectedDamageReportResponse())
+				return string(responseJSON)
+			},
+			wantLastModified: "Thu, 02 Jul 2026 09:30:00 GMT",
+		},
+		{
+			name:       "Invalid ID",
+			id:         "abc",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Not Found",
+			id:   "99",
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportUseCase) {
+				mockUseCase.EXPECT().GetDamageReport(gomock.Any(), 99).Return(nil, &myerrors.APIError{
+					Code:    myerrors.DamageReportNotFoundError,
+					Message: myerrors.DamageReportNotFoundErrorMessage,
+				})
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody: func() string {
+				return `{"code":"E100006","message":"被害報告は存在しません"}`
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodGet, "/damage-reports/"+tt.id, nil)
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+			c.Params = []gin.Param{
+				{
+					Key:   "id",
+					Value: tt.id,
+				},
+			}
+
+			uc := mockusecase.NewMockDamageReportUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			mockHandler := handler.NewDamageReportHandler(appLogger, uc, testCursorCodec)
+			mockHandler.GetDamageReport(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+			a.Equal(tt.wantLastModified, rec.Header().Get("Last-Modified"))
+
+			if tt.wantBody != nil {
+				wantBody := tt.wantBody()
+				resBody := rec.Body.String()
+				if !cmp.Equal(wantBody, resBody) {
+					t.Errorf("diff: %s", cmp.Diff(wantBody, resBody))
+				}
+			}
+		})
+	}
+}
+
+func TestDamageReportHandler_CreateDamageReport(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		mockSetup   func(mockUseCase *mockusecase.MockDamageReportUseCase)
+		wantStatus  int
+		wantDetails []handler.ValidationError
+	}{
+		{
+			name: "Success",
+			body: `{"organization_code":"131016","work_category_id":2,"damage_date":"2026-07-01",` +
+				`"location":"千代田区千代田1番地先","estimated_amount":500000,"area":12.5}`,
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportUseCase) {
+				area := 12.5
+				mockUseCase.EXPECT().CreateDamageReport(gomock.Any(), &model.DamageReport{
+					OrganizationCode: "131016",
+					WorkCategoryID:   2,
+					DamageDate:       time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+					Location:         "千代田区千代田1番地先",
+					EstimatedAmount:  500000,
+					Area:             &area,
+				}).Return(expectedDamageReportModel(), nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "Success/被害見込額0円・被害面積なし",
+			body: `{"organization_code":"131016","work_category_id":2,"damage_date":"2026-07-01",` +
+				`"location":"千代田区千代田1番地先","estimated_amount":0}`,
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportUseCase) {
+				mockUseCase.EXPECT().CreateDamageReport(gomock.Any(), &model.DamageReport{
+					OrganizationCode: "131016",
+					WorkCategoryID:   2,
+					DamageDate:       time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+					Location:         "千代田区千代田1番地先",
+				}).Return(expectedDamageReportModel(), nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "Validation Error/必須項目なし",
+			body:       `{"location":"千代田区千代田1番地先"}`,
+			wantStatus: http.StatusBadRequest,
+			wantDetails: []handler.ValidationError{
+				{Attribute: "団体コード", Tag: "required", Message: "団体コードは必須フィールドです"},
+				{Attribute: "工種区分ID", Tag: "required", Message: "工種区分IDは必須フィールドです"},
+				{Attribute: "被災日", Tag: "required", Message: "被災日は必須フィールドです"},
+				{Attribute: "被害見込額", Tag: "required", Message: "被害見込額は必須フィールドです"},
+			},
+		},
+		{
+			name: "Validation Error/書式・範囲",
+			body: `{"organization_code":"131016","work_category_id":2,"damage_date":"2026-07-01T00:00:00Z",` +
+				`"location":"千代田区千代田1番地先","estimated_amount":-1,"area":-0.5}`,
+			wantStatus: http.StatusBadRequest,
+			wantDetails: []handler.ValidationError{
+				{Attribute: "被災日", Tag: "date", Message: "被災日は日付（YYYY-MM-DD）形式である必要があります"},
+				{Attribute: "被害見込額", Tag: "min", Message: "被害見込額は0以上でなければなりません"},
+				{Attribute: "被害面積", Tag: "min", Message: "被害面積は0以上でなければなりません"},
+			},
+		},
+		{
+			name: "Work Category Not Found",
+			body: `{"organization_code":"131016","work_category_id":99,"damage_date":"2026-07-01",` +
+				`"location":"千代田区千代田1番地先","estimated_amount":500000}`,
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportUseCase) {
+				mockUseCase.EXPECT().CreateDamageReport(gomock.Any(), gomock.Any()).Return(nil, &myerrors.APIError{
+					Code:    myerrors.WorkCategoryNotFoundError,
+					Message: myerrors.WorkCategoryNotFoundErrorMessage,
+				})
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodPost, "/damage-reports", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+
+			uc := mockusecase.NewMockDamageReportUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			mockHandler := handler.NewDamageReportHandler(appLogger, uc, testCursorCodec)
+			mockHandler.CreateDamageReport(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+
+			if tt.wantDetails != nil {
+				var res handler.ErrorResponseDetail
+				a.NoError(json.Unmarshal(rec.Body.Bytes(), &res))
+				a.Equal(myerrors.ValidationError, res.Code)
+				if !cmp.Equal(tt.wantDetails, res.Details) {
+					t.Errorf("diff: %s", cmp.Diff(tt.wantDetails, res.Details))
+				}
+			}
+		})
+	}
+}
+
+func TestDamageReportHandler_UpdateDamageReport(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		body       string
+		mockSetup  func(mockUseCase *mockusecase.MockDamageReportUseCase)
+		wantStatus int
+	}{
+		{
+			name: "Success",
+			id:   "1",
+			body: `{"organization_code":"131016","work_category_id":2,"damage_date":"2026-07-01",` +
+				`"location":"千代田区千代田1番地先","estimated_amount":500000,"area":null}`,
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportUseCase) {
+				mockUseCase.EXPECT().UpdateDamageReport(gomock.Any(), &model.DamageReport{
+					ID:               1,
+					OrganizationCode: "131016",
+					WorkCategoryID:   2,
+					DamageDate:       time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+					Location:         "千代田区千代田1番地先",
+					EstimatedAmount:  500000,
+				}).Return(expectedDamageReportModel(), nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "Success/状態は更新しない",
+			id:   "1",
+			body: `{"organization_code":"131016","work_category_id":2,"damage_date":"2026-07-01",` +
+				`"location":"千代田区千代田1番地先","estimated_amount":500000,"status":"draft"}`,
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportUseCase) {
+				mockUseCase.EXPECT().UpdateDamageReport(gomock.Any(), &model.DamageReport{
+					ID:               1,
+					OrganizationCode: "131016",
+					WorkCategoryID:   2,
+					DamageDate:       time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+					Location:         "千代田区千代田1番地先",
+					EstimatedAmount:  500000,
+				}).Return(expectedDamageReportModel(), nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "Invalid ID",
+			id:         "0",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Validation Error",
+			id:   "1",
+			body: `{"organization_code":"131016","work_category_id":2,"damage_date":"2026-07-01",` +
+				`"location":"千代田区千代田1番地先","estimated_amount":-1}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Not Found",
+			id:   "99",
+			body: `{"organization_code":"131016","work_category_id":2,"damage_date":"2026-07-01",` +
+				`"location":"千代田区千代田1番地先","estimated_amount":500000}`,
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportUseCase) {
+				mockUseCase.EXPECT().UpdateDamageReport(gomock.Any(), gomock.Any()).Return(nil, &myerrors.APIError{
+					Code:    myerrors.DamageReportNotFoundError,
+					Message: myerrors.DamageReportNotFoundErrorMessage,
+				})
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodPut, "/damage-reports/"+tt.id, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+			c.Params = []gin.Param{
+				{
+					Key:   "id",
+					Value: tt.id,
+				},
+			}
+
+			uc := mockusecase.NewMockDamageReportUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			mockHandler := handler.NewDamageReportHandler(appLogger, uc, testCursorCodec)
+			mockHandler.UpdateDamageReport(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestDamageReportHandler_Transitions(t *testing.T) {
+	reported := expectedDamageReportModel()
+	reported.Status = domain.DamageReportStatusReported
+
+	tests := []struct {
+		name       string
+		call       func(h handler.DamageReportHandler, c *gin.Context)
+		id         string
+		mockSetup  func(mockUseCase *mockusecase.MockDamageReportUseCase)
+		wantStatus int
+		wantBody   func() string
+	}{
+		{
+			name: "Success/報告",
+			call: handler.DamageReportHandler.ReportDamageReport,
+			id:   "1",
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportUseCase) {
+				mockUseCase.EXPECT().TransitionDamageReport(gomock.Any(), 1, usecase.DamageReportActionReport).Return(reported, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				response := expectedDamageReportResponse()
+				response.Status = domain.DamageReportStatusReported
+				responseJSON, _ := json.Marshal(response)
+				return string(responseJSON)
+			},
+		},
+		{
+			name: "Success/確定",
+			call: handler.DamageReportHandler.ConfirmDamageReport,
+			id:   "1",
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportUseCase) {
+				mockUseCase.EXPECT().TransitionDamageReport(gomock.Any(), 1, usecase.DamageReportActionConfirm).
+					Return(expectedDamageReportModel(), nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "Success/取り下げ",
+			call: handler.DamageReportHandler.WithdrawDamageReport,
+			id:   "1",
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportUseCase) {
+				mockUseCase.EXPECT().TransitionDamageReport(gomock.Any(), 1, usecase.DamageReportActionWithdraw).
+					Return(expectedDamageReportModel(), nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "Invalid ID",
+			call:       handler.DamageReportHandler.ReportDamageReport,
+			id:         "abc",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Not Found",
+			call: handler.DamageReportHandler.ConfirmDamageReport,
+			id:   "99",
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportUseCase) {
+				mockUseCase.EXPECT().TransitionDamageReport(gomock.Any(), 99, usecase.DamageReportActionConfirm).
+					Return(nil, &myerrors.APIError{
+						Code:    myerrors.DamageReportNotFoundError,
+						Message: myerrors.DamageReportNotFoundErrorMessage,
+					})
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "Invalid State Transition",
+			call: handler.DamageReportHandler.WithdrawDamageReport,
+			id:   "1",
+			mockSetup: func(mockUseCase *mockusecase.MockDamageReportUseCase) {
+				mockUseCase.EXPECT().TransitionDamageReport(gomock.Any(), 1, usecase.DamageReportActionWithdraw).
+					Return(nil, &myerrors.APIError{
+						Code:    myerrors.InvalidStateTransitionError,
+						Message: myerrors.InvalidStateTransitionErrorMessage,
+					})
+			},
+			wantStatus: http.StatusConflict,
+			wantBody: func() string {
+				return `{"code":"E100008","message":"現在の状態ではこの操作はできません"}`
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodPost, "/damage-reports/"+tt.id, nil)
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+			c.Params = []gin.Param{
+				{
+					Key:   "id",
+					Value: tt.id,
+				},
+			}
+
+			uc := mockusecase.NewMockDamageReportUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			tt.call(handler.NewDamageReportHandler(appLogger, uc, testCursorCodec), c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+
+			if tt.wantBody != nil {
+				wantBody := tt.wantBody()
+				resBody := rec.Body.String()
+				if !cmp.Equal(wantBody, resBody) {
+					t.Errorf("diff: %s", cmp.Diff(wantBody, resBody))
+				}
+			}
+		})
+	}
+}
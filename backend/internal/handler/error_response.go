@@ -2,10 +2,9 @@ package handler
 
 import (
 	"fmt"
+	"log/slog"
 	"net/http"
 
-	"go.uber.org/zap"
-
 	myerrors "g_gen/internal/errors"
 	"g_gen/internal/infra/logger"
 )
@@ -23,9 +22,9 @@ type ErrorResponse struct {
 func (r *ErrorResponse) outputErrorLog(appLogger *logger.Logger, message, traceID string) {
 	msg := fmt.Sprintf("%s: %s", message, r.err.Error())
 	if r.status == http.StatusInternalServerError {
-		appLogger.Error(msg, zap.Error(r.err), zap.String("trace_id", traceID))
+		appLogger.Error(msg, slog.Any("error", r.err), slog.String("trace_id", traceID))
 	} else {
-		appLogger.Debug(msg, zap.Error(r.err), zap.String("trace_id", traceID))
+		appLogger.Debug(msg, slog.Any("error", r.err), slog.String("trace_id", traceID))
 	}
 }
 
@@ -46,9 +45,9 @@ type ErrorResponseDetail struct {
 func (r *ErrorResponseDetail) outputErrorLog(appLogger *logger.Logger, message, traceID string) {
 	msg := message
 	if r.status == http.StatusInternalServerError {
-		appLogger.Error(msg, zap.Error(r.err), zap.String("trace_id", traceID))
+		appLogger.Error(msg, slog.Any("error", r.err), slog.String("trace_id", traceID))
 	} else {
-		appLogger.Debug(msg, zap.Error(r.err), zap.String("trace_id", traceID))
+		appLogger.Debug(msg, slog.Any("error", r.err), slog.String("trace_id", traceID))
 	}
 }
 
@@ -70,13 +69,57 @@ func CreateErrResponse(err error) *ErrorResponse {
 				err:     cErr,
 				status:  http.StatusBadRequest,
 			}
-		case myerrors.PrefectureNotFoundError:
+		case myerrors.PrefectureNotFoundError,
+			myerrors.MunicipalityNotFoundError,
+			myerrors.WorkCategoryNotFoundError,
+			myerrors.RegionNotFoundError,
+			myerrors.DamageReportNotFoundError,
+			myerrors.SupportApplicationNotFoundError,
+			myerrors.AttachmentNotFoundError,
+			myerrors.ThumbnailNotFoundError:
 			return &ErrorResponse{
 				Code:    cErr.Code,
 				Message: cErr.Message,
 				err:     cErr,
 				status:  http.StatusNotFound,
 			}
+		case myerrors.UnauthorizedError:
+			return &ErrorResponse{
+				Code:    cErr.Code,
+				Message: cErr.Message,
+				err:     cErr,
+				status:  http.StatusUnauthorized,
+			}
+		case myerrors.TransitionForbiddenError,
+			myerrors.ForbiddenError:
+			return &ErrorResponse{
+				Code:    cErr.Code,
+				Message: cErr.Message,
+				err:     cErr,
+				status:  http.StatusForbidden,
+			}
+		case myerrors.InvalidStateTransitionError,
+			myerrors.SuccessionCycleError:
+			return &ErrorResponse{
+				Code:    cErr.Code,
+				Message: cErr.Message,
+				err:     cErr,
+				status:  http.StatusConflict,
+			}
+		case myerrors.AttachmentTooLargeError:
+			return &ErrorResponse{
+				Code:    cErr.Code,
+				Message: cErr.Message,
+				err:     cErr,
+				status:  http.StatusRequestEntityTooLarge,
+			}
+		case myerrors.UnsupportedAttachmentTypeError:
+			return &ErrorResponse{
+				Code:    cErr.Code,
+				Message: cErr.Message,
+				err:     cErr,
+				status:  http.StatusUnsupportedMediaType,
+			}
 		default:
 			return &ErrorResponse{
 				Code:    cErr.Code,
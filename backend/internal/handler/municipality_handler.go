@@ -0,0 +1,304 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"g_gen/internal/domain/model"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/infra/logger"
+	"g_gen/internal/pagination"
+	"g_gen/internal/usecase"
+)
+
+type municipalityHandler struct {
+	appLogger           *logger.Logger
+	municipalityUseCase usecase.MunicipalityUseCase
+	cursorCodec         *pagination.CursorCodec
+}
+
+func NewMunicipalityHandler(
+	l *logger.Logger,
+	municipalityUseCase usecase.MunicipalityUseCase,
+	cursorCodec *pagination.CursorCodec,
+) MunicipalityHandler {
+	return &municipalityHandler{
+		appLogger:           l,
+		municipalityUseCase: municipalityUseCase,
+		cursorCodec:         cursorCodec,
+	}
+}
+
+// ListMunicipalities @title 市区町村一覧取得
+// @id ListMunicipalities
+// @tags municipalities
+// @accept json
+// @produce json
+// @Param page query int false "ページ番号（既定1）"
+// @Param per_page query int false "1ページあたりの件数（既定20、最大100）"
+// @Param sort query string false "ソート条件（id, organization_code, prefecture_code, municipality_name_kana。降順は先頭に-）"
+// @Param cursor query string false "前のレスポンスのnext_cursor。指定した場合はpageを無視してカーソルの位置から取得"
+// @Param filter[prefecture_code] query string false "都道府県コードで絞り込み"
+// @Param filter[municipality_name_kanji] query string false "市区町村名（漢字）の部分一致で絞り込み"
+// @Summary 市区町村一覧取得
+// @Success 200 {object} MunicipalityListResponse
+// @Header 200 {string} Link "前後のページへのリンク"
+// @Header 200 {integer} X-Total-Count "総件数"
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 有効な市区町村の一覧をページ単位で取得します。並び順の指定がない場合は団体コード順です。
+// @Router /municipalities [get]
+func (h *municipalityHandler) ListMunicipalities(c *gin.Context) {
+	ctx := c.Request.Context()
+	var query ListMunicipalitiesQueryParams
+	if err := c.ShouldBindQuery(&query); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid municipality list request")
+
+		return
+	}
+
+	params, err := toPaginationParams(c, &PaginationRequest{
+		Page:    query.Page,
+		PerPage: query.PerPage,
+		Sort:    query.Sort,
+		Cursor:  query.Cursor,
+	}, h.cursorCodec)
+	if err != nil {
+		handleError(c, err, h.appLogger, "invalid municipality list cursor")
+
+		return
+	}
+
+	municipalities, meta, err := h.municipalityUseCase.ListMunicipalities(ctx, params)
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to list municipalities")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, &MunicipalityListResponse{
+		Items:      toMunicipalityResponses(municipalities),
+		Pagination: writePaginationHeaders(c, meta, h.cursorCodec),
+	})
+}
+
+// GetMunicipality @title 市区町村詳細取得
+// @id GetMunicipality
+// @tags municipalities
+// @accept json
+// @produce json
+// @Param id path int true "市区町村ID"
+// @Description 市区町村IDを指定して、市区町村の詳細情報を取得します。
+// @Summary 市区町村詳細取得
+// @Success 200 {object} Municipality
+// @Header 200 {string} ETag "レスポンスの強いETag（If-None-Matchに一致する場合は304を返す）"
+// @Header 200 {string} Last-Modified "更新日時（If-Modified-Sinceより後に更新されていない場合は304を返す）"
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /municipalities/{id} [get]
+func (h *municipalityHandler) GetMunicipality(c *gin.Context) {
+	ctx := c.Request.Context()
+	var uri GetMunicipalityPathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid municipality id")
+
+		return
+	}
+
+	municipality, err := h.municipalityUseCase.GetMunicipalityByID(ctx, uri.ID)
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to get municipality")
+
+		return
+	}
+
+	setLastModified(c, municipality.UpdatedAt)
+	c.JSON(http.StatusOK, toMunicipalityResponse(municipality))
+}
+
+// ResolveMunicipality @title 団体コード解決
+// @id ResolveMunicipality
+// @tags municipalities
+// @accept json
+// @produce json
+// @Param organization_code path string true "団体コード（合併前の旧団体コードも可）"
+// @Description 合併前の旧団体コードを含む団体コードを、承継をたどって現在の市区町村に解決します。
+// @Summary 団体コード解決
+// @Success 200 {object} MunicipalityResolution
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /municipalities/resolve/{organization_code} [get]
+func (h *municipalityHandler) ResolveMunicipality(c *gin.Context) {
+	ctx := c.Request.Context()
+	var uri ResolveMunicipalityPathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid organization code")
+
+		return
+	}
+
+	resolution, err := h.municipalityUseCase.ResolveOrganizationCode(ctx, uri.OrganizationCode)
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to resolve organization code")
+
+		return
+	}
+
+	successions := make([]*MunicipalitySuccession, len(resolution.Successions))
+	for i, s := range resolution.Successions {
+		successions[i] = &MunicipalitySuccession{
+			PredecessorCode: s.PredecessorCode,
+			SuccessorCode:   s.SuccessorCode,
+			EffectiveDate:   s.EffectiveDate.Format(time.DateOnly),
+		}
+	}
+
+	c.JSON(http.StatusOK, &MunicipalityResolution{
+		OrganizationCode: uri.OrganizationCode,
+		Municipality:     toMunicipalityResponse(resolution.Municipality),
+		Successions:      successions,
+	})
+}
+
+// ListMunicipalitiesByPrefecture @title 都道府県別市区町村一覧取得
+// @id ListMunicipalitiesByPrefecture
+// @tags municipalities
+// @accept json
+// @produce json
+// @Param code path string true "都道府県コード"
+// @Param page query int false "ページ番号（既定1）"
+// @Param per_page query int false "1ページあたりの件数（既定20、最大100）"
+// @Param sort query string false "ソート条件（id, organization_code, prefecture_code, municipality_name_kana。降順は先頭に-）"
+// @Param cursor query string false "前のレスポンスのnext_cursor。指定した場合はpageを無視してカーソルの位置から取得"
+// @Param filter[municipality_name_kanji] query string false "市区町村名（漢字）の部分一致で絞り込み"
+// @Description 都道府県コードを指定して、その都道府県に属する有効な市区町村の一覧をページ単位で取得します。
+// @Summary 都道府県別市区町村一覧取得
+// @Success 200 {object} MunicipalityListResponse
+// @Header 200 {string} Link "前後のページへのリンク"
+// @Header 200 {integer} X-Total-Count "総件数"
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /prefectures/{code}/municipalities [get]
+func (h *municipalityHandler) ListMunicipalitiesByPrefecture(c *gin.Context) {
+	ctx := c.Request.Context()
+	var uri ListMunicipalitiesByPrefecturePathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid prefecture code")
+
+		return
+	}
+
+	var query ListMunicipalitiesByPrefectureQueryParams
+	if err := c.ShouldBindQuery(&query); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid municipality list request")
+
+		return
+	}
+
+	params, err := toPaginationParams(c, &PaginationRequest{
+		Page:    query.Page,
+		PerPage: query.PerPage,
+		Sort:    query.Sort,
+		Cursor:  query.Cursor,
+	}, h.cursorCodec)
+	if err != nil {
+		handleError(c, err, h.appLogger, "invalid municipality list cursor")
+
+		return
+	}
+
+	municipalities, meta, err := h.municipalityUseCase.ListMunicipalitiesByPrefectureCode(ctx, uri.Code, params)
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to list municipalities by prefecture")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, &MunicipalityListResponse{
+		Items:      toMunicipalityResponses(municipalities),
+		Pagination: writePaginationHeaders(c, meta, h.cursorCodec),
+	})
+}
+
+// SearchMunicipalities @title 市区町村検索
+// @id SearchMunicipalities
+// @tags municipalities
+// @accept json
+// @produce json
+// @Param q query string true "検索キーワード（漢字・ひらがな・カタカナ・半角カナ）"
+// @Param prefecture_code query string false "都道府県コード"
+// @Param limit query int false "取得件数（既定20、最大100）"
+// @Description 市区町村名を漢字またはかなで検索します。完全一致、前方一致、部分一致の順に返します。一致の度合いの順で返すため続きをカーソルで取得することはできず、cursorを指定した場合は400を返します。件数はlimitで指定します。
+// @Summary 市区町村検索
+// @Success 200 {array} Municipality
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /municipalities/search [get]
+func (h *municipalityHandler) SearchMunicipalities(c *gin.Context) {
+	ctx := c.Request.Context()
+	// 一致の度合いの順はカーソルで続きを指せないため、黙って無視せずエラーにする
+	if _, ok := c.GetQuery("cursor"); ok {
+		handleError(c, myerrors.NewAPIError(
+			myerrors.ValidationError,
+			myerrors.ValidationErrorMessage,
+			errors.New("cursor is not supported"),
+			"cursor is not supported for municipality search",
+		), h.appLogger, "invalid municipality search request")
+
+		return
+	}
+
+	var query SearchMunicipalitiesQueryParams
+	if err := c.ShouldBindQuery(&query); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid municipality search request")
+
+		return
+	}
+
+	municipalities, err := h.municipalityUseCase.SearchMunicipalities(ctx, query.Q, query.PrefectureCode, query.Limit)
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to search municipalities")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, toMunicipalityResponses(municipalities))
+}
+
+func toMunicipalityResponse(m *model.Municipality) *Municipality {
+	return &Municipality{
+		ID:                    m.ID,
+		PrefectureCode:        m.PrefectureCode,
+		OrganizationCode:      m.OrganizationCode,
+		PrefectureNameKanji:   m.PrefectureNameKanji,
+		MunicipalityNameKanji: m.MunicipalityNameKanji,
+		PrefectureNameKana:    m.PrefectureNameKana,
+		MunicipalityNameKana:  m.MunicipalityNameKana,
+		IsActive:              m.IsActive,
+	}
+}
+
+func toMunicipalityResponses(municipalities []*model.Municipality) []*Municipality {
+	response := make([]*Municipality, len(municipalities))
+	for i, m := range municipalities {
+		response[i] = toMunicipalityResponse(m)
+	}
+
+	return response
+}
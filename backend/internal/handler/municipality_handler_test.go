@@ -0,0 +1,665 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"g_gen/internal/domain/model"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/handler"
+	"g_gen/internal/infra/logger"
+	"g_gen/internal/pagination"
+	"g_gen/internal/usecase"
+	mockusecase "g_gen/tests/mock/usecase"
+)
+
+var testCursorCodec = pagination.NewCursorCodec([]byte("test-secret"))
+
+func TestMunicipalityHandler_ListMunicipalities(t *testing.T) {
+	nextCursor := &pagination.Cursor{Sort: "organization_code", Keys: []string{"011002"}}
+	encodedNextCursor := testCursorCodec.Encode(nextCursor)
+	cursorParams := pagination.NewParams(1, 1, nil, map[string]string{})
+	cursorParams.Cursor = nextCursor
+
+	tests := []struct {
+		name       string
+		query      string
+		mockSetup  func(mockUseCase *mockusecase.MockMunicipalityUseCase)
+		wantStatus int
+		wantBody   func() string
+		wantLink   string
+	}{
+		{
+			name: "Success",
+			mockSetup: func(mockUseCase *mockusecase.MockMunicipalityUseCase) {
+				params := pagination.NewParams(1, 20, nil, map[string]string{})
+				mockUseCase.EXPECT().
+					ListMunicipalities(gomock.Any(), params).
+					Return(expectedMunicipalityListModel(), pagination.NewMeta(params, 2, nil), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				responseJSON, _ := json.Marshal(&handler.MunicipalityListResponse{
+					Items: expectedMunicipalityListResponse(),
+					Pagination: &handler.PaginationResponse{
+						Page:       1,
+						PerPage:    20,
+						TotalCount: 2,
+						TotalPages: 1,
+					},
+				})
+				return string(responseJSON)
+			},
+			wantLink: `</municipalities?page=1>; rel="first", </municipalities?page=1>; rel="last"`,
+		},
+		{
+			name:  "Success/ページング・ソート・絞り込み",
+			query: "?page=2&per_page=1&sort=-prefecture_code,organization_code&filter[prefecture_code]=01",
+			mockSetup: func(mockUseCase *mockusecase.MockMunicipalityUseCase) {
+				params := pagination.NewParams(
+					2,
+					1,
+					[]pagination.Sort{
+						{Field: "prefecture_code", Desc: true},
+						{Field: "organization_code"},
+					},
+					map[string]string{"prefecture_code": "01"},
+				)
+				mockUseCase.EXPECT().
+					ListMunicipalities(gomock.Any(), params).
+					Return(expectedMunicipalityListModel()[1:], pagination.NewMeta(params, 3, nextCursor), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				responseJSON, _ := json.Marshal(&handler.MunicipalityListResponse{
+					Items: expectedMunicipalityListResponse()[1:],
+					Pagination: &handler.PaginationResponse{
+						Page:       2,
+						PerPage:    1,
+						TotalCount: 3,
+						TotalPages: 3,
+						NextCursor: &encodedNextCursor,
+					},
+				})
+				return string(responseJSON)
+			},
+			wantLink: `</municipalities?filter%5Bprefecture_code%5D=01&page=1&per_page=1&sort=-prefecture_code%2Corganization_code>; rel="first", ` +
+				`</municipalities?filter%5Bprefecture_code%5D=01&page=1&per_page=1&sort=-prefecture_code%2Corganization_code>; rel="prev", ` +
+				`</municipalities?filter%5Bprefecture_code%5D=01&page=3&per_page=1&sort=-prefecture_code%2Corganization_code>; rel="next", ` +
+				`</municipalities?filter%5Bprefecture_code%5D=01&page=3&per_page=1&sort=-prefecture_code%2Corganization_code>; rel="last"`,
+		},
+		{
+			name:  "Success/カーソル",
+			query: "?per_page=1&cursor=" + encodedNextCursor,
+			mockSetup: func(mockUseCase *mockusecase.MockMunicipalityUseCase) {
+				mockUseCase.EXPECT().
+					ListMunicipalities(gomock.Any(), cursorParams).
+					Return(expectedMunicipalityListModel()[1:], pagination.NewMeta(cursorParams, 3, nextCursor), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				responseJSON, _ := json.Marshal(&handler.MunicipalityListResponse{
+					Items: expectedMunicipalityListResponse()[1:],
+					Pagination: &handler.PaginationResponse{
+						PerPage:    1,
+						TotalCount: 3,
+						TotalPages: 3,
+						NextCursor: &encodedNextCursor,
+					},
+				})
+				return string(responseJSON)
+			},
+			wantLink: `</municipalities?page=1&per_page=1>; rel="first", ` +
+				`</municipalities?cursor=` + encodedNextCursor + `&per_page=1>; rel="next"`,
+		},
+		{
+			name: "Success/Empty",
+			mockSetup: func(mockUseCase *mockusecase.MockMunicipalityUseCase) {
+				mockUseCase.EXPECT().ListMunicipalities(gomock.Any(), gomock.Any()).
+					Return([]*model.Municipality{}, pagination.NewMeta(pagination.NewParams(1, 20, nil, nil), 0, nil), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				return `{"items":[],"pagination":{"page":1,"per_page":20,"total_count":0,"total_pages":0,"next_cursor":null}}`
+			},
+			wantLink: `</municipalities?page=1>; rel="first"`,
+		},
+		{
+			name:       "Invalid PerPage",
+			query:      "?per_page=101",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Invalid Sort",
+			query:      "?sort=organization_code,",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Tampered Cursor",
+			query:      "?cursor=" + encodedNextCursor[:len(encodedNextCursor)-2] + "AA",
+			wantStatus: http.StatusBadRequest,
+			wantBody: func() string {
+				return `{"code":"E100001","message":"入力値に誤りがあります"}`
+			},
+		},
+		{
+			name:  "Unknown Sort Field",
+			query: "?sort=unknown",
+			mockSetup: func(mockUseCase *mockusecase.MockMunicipalityUseCase) {
+				mockUseCase.EXPECT().ListMunicipalities(gomock.Any(), gomock.Any()).
+					Return(nil, pagination.Meta{}, myerrors.NewAPIError(
+						myerrors.ValidationError,
+						myerrors.ValidationErrorMessage,
+						errors.New("unknown sort field: unknown"),
+						"invalid list query",
+					))
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error",
+			mockSetup: func(mockUseCase *mockusecase.MockMunicipalityUseCase) {
+				mockUseCase.EXPECT().ListMunicipalities(gomock.Any(), gomock.Any()).
+					Return(nil, pagination.Meta{}, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodGet, "/municipalities"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+
+			uc := mockusecase.NewMockMunicipalityUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			mockHandler := handler.NewMunicipalityHandler(appLogger, uc, testCursorCodec)
+			mockHandler.ListMunicipalities(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+
+			if tt.wantBody != nil {
+				wantBody := tt.wantBody()
+				resBody := rec.Body.String()
+				if !cmp.Equal(wantBody, resBody) {
+					t.Errorf("diff: %s", cmp.Diff(wantBody, resBody))
+				}
+			}
+
+			if tt.wantLink != "" {
+				a.Equal(tt.wantLink, rec.Header().Get("Link"))
+			}
+		})
+	}
+}
+
+func TestMunicipalityHandler_GetMunicipality(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		mockSetup  func(mockUseCase *mockusecase.MockMunicipalityUseCase)
+		wantStatus int
+		wantBody   func() string
+	}{
+		{
+			name: "Success",
+			id:   "1",
+			mockSetup: func(mockUseCase *mockusecase.MockMunicipalityUseCase) {
+				mockUseCase.EXPECT().GetMunicipalityByID(gomock.Any(), 1).Return(expectedMunicipalityListModel()[0], nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				responseJSON, _ := json.Marshal(expectedMunicipalityListResponse()[0])
+				return string(responseJSON)
+			},
+		},
+		{
+			name:       "Invalid ID",
+			id:         "abc",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   nil,
+		},
+		{
+			name:       "Zero ID",
+			id:         "0",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   nil,
+		},
+		{
+			name: "Not Found",
+			id:   "999",
+			mockSetup: func(mockUseCase *mockusecase.MockMunicipalityUseCase) {
+				mockUseCase.EXPECT().GetMunicipalityByID(gomock.Any(), 999).Return(nil, &myerrors.APIError{
+					Code:    myerrors.MunicipalityNotFoundError,
+					Message: myerrors.MunicipalityNotFoundErrorMessage,
+				})
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodGet, "/municipalities/"+tt.id, nil)
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+			c.Params = []gin.Param{
+				{
+					Key:   "id",
+					Value: tt.id,
+				},
+			}
+
+			uc := mockusecase.NewMockMunicipalityUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			mockHandler := handler.NewMunicipalityHandler(appLogger, uc, testCursorCodec)
+			mockHandler.GetMunicipality(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+
+			if tt.wantBody != nil {
+				wantBody := tt.wantBody()
+				resBody := rec.Body.String()
+				if !cmp.Equal(wantBody, resBody) {
+					t.Errorf("diff: %s", cmp.Diff(wantBody, resBody))
+				}
+			}
+		})
+	}
+}
+
+func TestMunicipalityHandler_ResolveMunicipality(t *testing.T) {
+	tests := []struct {
+		name       string
+		code       string
+		mockSetup  func(mockUseCase *mockusecase.MockMunicipalityUseCase)
+		wantStatus int
+		wantBody   func() string
+	}{
+		{
+			name: "Success",
+			code: "011991",
+			mockSetup: func(mockUseCase *mockusecase.MockMunicipalityUseCase) {
+				mockUseCase.EXPECT().ResolveOrganizationCode(gomock.Any(), "011991").Return(&usecase.MunicipalityResolution{
+					Municipality: expectedMunicipalityListModel()[0],
+					Successions: []*model.MunicipalitySuccession{
+						{
+							PredecessorCode: "011991",
+							SuccessorCode:   "011002",
+							EffectiveDate:   time.Date(2005, 4, 1, 0, 0, 0, 0, time.UTC),
+						},
+					},
+				}, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				responseJSON, _ := json.Marshal(&handler.MunicipalityResolution{
+					OrganizationCode: "011991",
+					Municipality:     expectedMunicipalityListResponse()[0],
+					Successions: []*handler.MunicipalitySuccession{
+						{PredecessorCode: "011991", SuccessorCode: "011002", EffectiveDate: "2005-04-01"},
+					},
+				})
+				return string(responseJSON)
+			},
+		},
+		{
+			name:       "Invalid Code",
+			code:       "01100",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   nil,
+		},
+		{
+			name:       "Invalid Check Digit",
+			code:       "011992",
+			wantStatus: http.StatusBadRequest,
+			wantBody: func() string {
+				return `{"code":"E100001","message":"入力値に誤りがあります","details":[{"attribute":"団体コード","tag":"organization_code","message":"団体コードは正しい団体コードである必要があります"}]}`
+			},
+		},
+		{
+			name: "Not Found",
+			code: "999997",
+			mockSetup: func(mockUseCase *mockusecase.MockMunicipalityUseCase) {
+				mockUseCase.EXPECT().ResolveOrganizationCode(gomock.Any(), "999997").Return(nil, &myerrors.APIError{
+					Code:    myerrors.MunicipalityNotFoundError,
+					Message: myerrors.MunicipalityNotFoundErrorMessage,
+				})
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody:   nil,
+		},
+		{
+			name: "Conflict/承継が循環している",
+			code: "011991",
+			mockSetup: func(mockUseCase *mockusecase.MockMunicipalityUseCase) {
+				mockUseCase.EXPECT().ResolveOrganizationCode(gomock.Any(), "011991").Return(nil, myerrors.NewAPIError(
+					myerrors.SuccessionCycleError,
+					myerrors.SuccessionCycleErrorMessage,
+					errors.New("011002 -> 011991"),
+					"municipality succession cycle detected",
+				))
+			},
+			wantStatus: http.StatusConflict,
+			wantBody: func() string {
+				return `{"code":"E100014","message":"市区町村の承継が循環しているため、団体コードを解決できません"}`
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodGet, "/municipalities/resolve/"+tt.code, nil)
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+			c.Params = []gin.Param{
+				{
+					Key:   "organization_code",
+					Value: tt.code,
+				},
+			}
+
+			uc := mockusecase.NewMockMunicipalityUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			mockHandler := handler.NewMunicipalityHandler(appLogger, uc, testCursorCodec)
+			mockHandler.ResolveMunicipality(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+
+			if tt.wantBody != nil {
+				wantBody := tt.wantBody()
+				resBody := rec.Body.String()
+				if !cmp.Equal(wantBody, resBody) {
+					t.Errorf("diff: %s", cmp.Diff(wantBody, resBody))
+				}
+			}
+		})
+	}
+}
+
+func TestMunicipalityHandler_ListMunicipalitiesByPrefecture(t *testing.T) {
+	tests := []struct {
+		name       string
+		code       string
+		query      string
+		mockSetup  func(mockUseCase *mockusecase.MockMunicipalityUseCase)
+		wantStatus int
+		wantBody   func() string
+	}{
+		{
+			name: "Success",
+			code: "01",
+			mockSetup: func(mockUseCase *mockusecase.MockMunicipalityUseCase) {
+				params := pagination.NewParams(1, 20, nil, map[string]string{})
+				mockUseCase.EXPECT().
+					ListMunicipalitiesByPrefectureCode(gomock.Any(), "01", params).
+					Return(expectedMunicipalityListModel()[:1], pagination.NewMeta(params, 1, nil), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				responseJSON, _ := json.Marshal(&handler.MunicipalityListResponse{
+					Items: expectedMunicipalityListResponse()[:1],
+					Pagination: &handler.PaginationResponse{
+						Page:       1,
+						PerPage:    20,
+						TotalCount: 1,
+						TotalPages: 1,
+					},
+				})
+				return string(responseJSON)
+			},
+		},
+		{
+			name:       "Invalid Page",
+			code:       "01",
+			query:      "?page=-1",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   nil,
+		},
+		{
+			name:       "Invalid Code",
+			code:       "1a",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   nil,
+		},
+		{
+			name: "Error",
+			code: "13",
+			mockSetup: func(mockUseCase *mockusecase.MockMunicipalityUseCase) {
+				mockUseCase.EXPECT().ListMunicipalitiesByPrefectureCode(gomock.Any(), "13", gomock.Any()).
+					Return(nil, pagination.Meta{}, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodGet, "/prefectures/"+tt.code+"/municipalities"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+			c.Params = []gin.Param{
+				{
+					Key:   "code",
+					Value: tt.code,
+				},
+			}
+
+			uc := mockusecase.NewMockMunicipalityUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			mockHandler := handler.NewMunicipalityHandler(appLogger, uc, testCursorCodec)
+			mockHandler.ListMunicipalitiesByPrefecture(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+
+			if tt.wantBody != nil {
+				wantBody := tt.wantBody()
+				resBody := rec.Body.String()
+				if !cmp.Equal(wantBody, resBody) {
+					t.Errorf("diff: %s", cmp.Diff(wantBody, resBody))
+				}
+			}
+		})
+	}
+}
+
+func TestMunicipalityHandler_SearchMunicipalities(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		mockSetup  func(mockUseCase *mockusecase.MockMunicipalityUseCase)
+		wantStatus int
+		wantBody   func() string
+	}{
+		{
+			name:  "Success",
+			query: "q=%E3%81%95%E3%81%A3%E3%81%BD%E3%82%8D&prefecture_code=01&limit=10",
+			mockSetup: func(mockUseCase *mockusecase.MockMunicipalityUseCase) {
+				mockUseCase.EXPECT().SearchMunicipalities(gomock.Any(), "さっぽろ", "01", 10).
+					Return(expectedMunicipalityListModel()[:1], nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				responseJSON, _ := json.Marshal(expectedMunicipalityListResponse()[:1])
+				return string(responseJSON)
+			},
+		},
+		{
+			name:       "Missing Keyword",
+			query:      "prefecture_code=01",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   nil,
+		},
+		{
+			name:       "Invalid Prefecture Code",
+			query:      "q=abc&prefecture_code=1",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   nil,
+		},
+		{
+			name:       "Limit Too Large",
+			query:      "q=abc&limit=101",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   nil,
+		},
+		{
+			name:       "Cursor Not Supported",
+			query:      "q=abc&cursor=" + testCursorCodec.Encode(&pagination.Cursor{Sort: "organization_code", Keys: []string{"011002"}}),
+			wantStatus: http.StatusBadRequest,
+			wantBody: func() string {
+				return `{"code":"E100001","message":"入力値に誤りがあります"}`
+			},
+		},
+		{
+			name:  "Error",
+			query: "q=abc",
+			mockSetup: func(mockUseCase *mockusecase.MockMunicipalityUseCase) {
+				mockUseCase.EXPECT().SearchMunicipalities(gomock.Any(), "abc", "", 0).
+					Return(nil, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodGet, "/municipalities/search?"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+
+			uc := mockusecase.NewMockMunicipalityUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			mockHandler := handler.NewMunicipalityHandler(appLogger, uc, testCursorCodec)
+			mockHandler.SearchMunicipalities(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+
+			if tt.wantBody != nil {
+				wantBody := tt.wantBody()
+				resBody := rec.Body.String()
+				if !cmp.Equal(wantBody, resBody) {
+					t.Errorf("diff: %s", cmp.Diff(wantBody, resBody))
+				}
+			}
+		})
+	}
+}
+
+func expectedMunicipalityListModel() []*model.Municipality {
+	return []*model.Municipality{
+		{
+			ID:                    1,
+			PrefectureCode:        "01",
+			OrganizationCode:      "011002",
+			PrefectureNameKanji:   "北海道",
+			MunicipalityNameKanji: "札幌市",
+			PrefectureNameKana:    "ﾎｯｶｲﾄﾞｳ",
+			MunicipalityNameKana:  "ｻｯﾎﾟﾛｼ",
+			IsActive:              true,
+		},
+		{
+			ID:                    2,
+			PrefectureCode:        "13",
+			OrganizationCode:      "131016",
+			PrefectureNameKanji:   "東京都",
+			MunicipalityNameKanji: "千代田区",
+			PrefectureNameKana:    "ﾄｳｷｮｳﾄ",
+			MunicipalityNameKana:  "ﾁﾖﾀﾞｸ",
+			IsActive:              true,
+		},
+	}
+}
+
+func expectedMunicipalityListResponse() []*handler.Municipality {
+	return []*handler.Municipality{
+		{
+			ID:                    1,
+			PrefectureCode:        "01",
+			OrganizationCode:      "011002",
+			PrefectureNameKanji:   "北海道",
+			MunicipalityNameKanji: "札幌市",
+			PrefectureNameKana:    "ﾎｯｶｲﾄﾞｳ",
+			MunicipalityNameKana:  "ｻｯﾎﾟﾛｼ",
+			IsActive:              true,
+		},
+		{
+			ID:                    2,
+			PrefectureCode:        "13",
+			OrganizationCode:      "131016",
+			PrefectureNameKanji:   "東京都",
+			MunicipalityNameKanji: "千代田区",
+			PrefectureNameKana:    "ﾄｳｷｮｳﾄ",
+			MunicipalityNameKana:  "ﾁﾖﾀﾞｸ",
+			IsActive:              true,
+		},
+	}
+}
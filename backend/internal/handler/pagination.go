@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/pagination"
+)
+
+const (
+	// filterQueryKey 絞り込み条件のクエリパラメータ名（filter[field]=value）
+	filterQueryKey = "filter"
+	// totalCountHeader 総件数を返すレスポンスヘッダ
+	totalCountHeader = "X-Total-Count"
+)
+
+// PaginationRequest 一覧取得APIで共通のページング・ソート条件
+// cursorを指定した場合はpageを無視し、カーソルの位置から取得する
+type PaginationRequest struct {
+	Page    int    `form:"page" binding:"omitempty,min=1" ja:"ページ番号"`
+	PerPage int    `form:"per_page" binding:"omitempty,min=1,max=100" ja:"1ページあたりの件数"`
+	Sort    string `form:"sort" binding:"omitempty,sort" ja:"ソート条件"`
+	Cursor  string `form:"cursor" binding:"omitempty,max=1024" ja:"カーソル"`
+}
+
+// PaginationResponse 一覧取得結果のページ情報
+type PaginationResponse struct {
+	// カーソルで取得した場合は省略する
+	Page       int   `json:"page,omitempty" example:"1"`
+	PerPage    int   `json:"per_page" example:"20"`
+	TotalCount int64 `json:"total_count" example:"1741"`
+	TotalPages int   `json:"total_pages" example:"88"`
+	// 次のページを取得するためのカーソル。次のページがない場合はnull
+	NextCursor *string `json:"next_cursor" extensions:"x-nullable"`
+}
+
+// toPaginationParams リクエストのページング・ソート条件と filter[field]=value 形式の絞り込み条件から一覧取得の条件を組み立てる
+// ソート条件の書式はバインド時に検証済みであること。カーソルが改ざんされている場合はバリデーションエラーを返す
+func toPaginationParams(
+	c *gin.Context,
+	req *PaginationRequest,
+	cursorCodec *pagination.CursorCodec,
+) (pagination.Params, error) {
+	sorts, _ := pagination.ParseSort(req.Sort)
+	params := pagination.NewParams(req.Page, req.PerPage, sorts, c.QueryMap(filterQueryKey))
+
+	if req.Cursor != "" {
+		cursor, err := cursorCodec.Decode(req.Cursor)
+		if err != nil {
+			return pagination.Params{}, myerrors.NewAPIError(
+				myerrors.ValidationError,
+				myerrors.ValidationErrorMessage,
+				err,
+				"invalid cursor",
+			)
+		}
+
+		params.Cursor = cursor
+	}
+
+	return params, nil
+}
+
+// writePaginationHeaders 総件数と前後のページへのLinkヘッダ（RFC 8288）を設定し、ページ情報のレスポンスを返す
+// カーソルで取得した場合、前のページと最後のページへのリンクは付与しない
+func writePaginationHeaders(
+	c *gin.Context,
+	meta pagination.Meta,
+	cursorCodec *pagination.CursorCodec,
+) *PaginationResponse {
+	totalPages := meta.TotalPages()
+	res := &PaginationResponse{
+		Page:       meta.Page,
+		PerPage:    meta.PerPage,
+		TotalCount: meta.TotalCount,
+		TotalPages: totalPages,
+	}
+
+	c.Header(totalCountHeader, strconv.FormatInt(meta.TotalCount, 10))
+
+	links := []string{pageLink(c.Request.URL, 1, "first")}
+	if meta.HasPrev() {
+		links = append(links, pageLink(c.Request.URL, meta.Page-1, "prev"))
+	}
+
+	if meta.HasNext() {
+		nextCursor := cursorCodec.Encode(meta.NextCursor)
+		res.NextCursor = &nextCursor
+
+		if meta.Page > 0 {
+			links = append(links, pageLink(c.Request.URL, meta.Page+1, "next"))
+		} else {
+			links = append(links, cursorLink(c.Request.URL, nextCursor, "next"))
+		}
+	}
+
+	if meta.Page > 0 && totalPages > 0 {
+		links = append(links, pageLink(c.Request.URL, totalPages, "last"))
+	}
+
+	c.Header("Link", strings.Join(links, ", "))
+
+	return res
+}
+
+// pageLink 現在のリクエストのクエリを引き継いでページ番号だけを差し替えたLinkヘッダの要素を組み立てる
+func pageLink(base *url.URL, page int, rel string) string {
+	u := *base
+	q := u.Query()
+	q.Del("cursor")
+	q.Set("page", strconv.Itoa(page))
+	u.RawQuery = q.Encode()
+
+	return fmt.Sprintf(`<%s>; rel="%s"`, u.RequestURI(), rel)
+}
+
+// cursorLink 現在のリクエストのクエリを引き継いでカーソルだけを差し替えたLinkヘッダの要素を組み立てる
+func cursorLink(base *url.URL, cursor string, rel string) string {
+	u := *base
+	q := u.Query()
+	q.Del("page")
+	q.Set("cursor", cursor)
+	u.RawQuery = q.Encode()
+
+	return fmt.Sprintf(`<%s>; rel="%s"`, u.RequestURI(), rel)
+}
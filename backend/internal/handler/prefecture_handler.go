@@ -5,35 +5,32 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"g_gen/internal/domain/model"
 	"g_gen/internal/infra/logger"
+	"g_gen/internal/pagination"
 	"g_gen/internal/usecase"
 )
 
-type PrefectureHandler interface {
-	ListPrefectures(c *gin.Context)
-	GetPrefecture(c *gin.Context)
-}
-
 type prefectureHandler struct {
 	appLogger         *logger.Logger
 	prefectureUseCase usecase.PrefectureUseCase
+	cursorCodec       *pagination.CursorCodec
 }
 
 func NewPrefectureHandler(
 	l *logger.Logger,
 	prefectureUseCase usecase.PrefectureUseCase,
+	cursorCodec *pagination.CursorCodec,
 ) PrefectureHandler {
 	return &prefectureHandler{
 		appLogger:         l,
 		prefectureUseCase: prefectureUseCase,
+		cursorCodec:       cursorCodec,
 	}
 }
 
-type PrefectureResponse struct {
-	ID   int32  `json:"id"`
-	Code string `json:"code"`
-	Name string `json:"name"`
-}
+// prefectureGroupByRegion 都道府県一覧を地方区分ごとにまとめる場合のgroup_byの値
+const prefectureGroupByRegion = "region"
 
 // ListPrefectures @title 都道府県一覧取得
 // @id ListPrefectures
@@ -41,53 +38,81 @@ type PrefectureResponse struct {
 // @accept json
 // @produce json
 // @Summary 都道府県一覧取得
-// @Success 200 {array} PrefectureResponse
+// @Param region_id query int false "地方区分ID（指定した地方区分の都道府県のみ取得）"
+// @Param group_by query string false "region を指定すると地方区分ごとにまとめて返します（RegionPrefecturesListResponse）" Enums(region)
+// @Param page query int false "ページ番号（既定1）"
+// @Param per_page query int false "1ページあたりの件数（既定20、最大100）"
+// @Param sort query string false "ソート条件（id, code。group_by=regionの場合は地方区分のid。降順は先頭に-）"
+// @Param cursor query string false "前のレスポンスのnext_cursor。指定した場合はpageを無視してカーソルの位置から取得"
+// @Success 200 {object} PrefectureListResponse
+// @Header 200 {string} Link "前後のページへのリンク"
+// @Header 200 {integer} X-Total-Count "総件数"
+// @Failure 400 {object} ErrorResponseDetail
 // @Failure 401 {object} ErrorResponse
 // @Failure 403 {object} ErrorResponse
-// @Failure 500 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Security ApiKeyAuth
 // @Security BearerAuth
-// @Description 都道府県の一覧を取得します。
+// @Description 都道府県の一覧をページ単位で取得します。地方区分による絞り込み、地方区分ごとのグループ化ができます。並び順の指定がない場合は都道府県コード順です。
+// @Description 地方区分ごとにまとめる場合は地方区分の単位でページングし、並び順には地方区分のフィールドを指定します。
 // @Router /prefectures [get]
 func (h *prefectureHandler) ListPrefectures(c *gin.Context) {
-	prefectures, err := h.prefectureUseCase.ListPrefectures(c.Request.Context())
+	ctx := c.Request.Context()
+	var req ListPrefecturesQueryParams
+	if err := c.ShouldBindQuery(&req); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid prefecture list request")
+
+		return
+	}
+
+	params, err := toPaginationParams(c, &PaginationRequest{
+		Page:    req.Page,
+		PerPage: req.PerPage,
+		Sort:    req.Sort,
+		Cursor:  req.Cursor,
+	}, h.cursorCodec)
 	if err != nil {
-		handleError(c, err, h.appLogger, "Failed to list prefectures")
+		handleError(c, err, h.appLogger, "invalid prefecture list cursor")
 
 		return
 	}
 
-	var response []*PrefectureResponse
-	for _, p := range prefectures {
-		response = append(response, &PrefectureResponse{
-			Code: p.Code,
-			Name: p.Name,
+	if req.GroupBy == prefectureGroupByRegion {
+		groups, meta, err := h.prefectureUseCase.ListPrefecturesGroupedByRegion(ctx, params)
+		if err != nil {
+			handleError(c, err, h.appLogger, "failed to list prefectures grouped by region")
+
+			return
+		}
+
+		c.JSON(http.StatusOK, &RegionPrefecturesListResponse{
+			Items:      toRegionPrefecturesResponses(groups),
+			Pagination: writePaginationHeaders(c, meta, h.cursorCodec),
 		})
-	}
 
-	c.JSON(http.StatusOK, response)
-}
+		return
+	}
 
-type GetPrefectureResponse struct {
-	ID             int32           `json:"id"`
-	Name           string          `json:"name"`
-	Municipalities []*Municipality `json:"municipalities"`
-}
+	var (
+		prefectures []*model.Prefecture
+		meta        pagination.Meta
+	)
+	if req.RegionID != 0 {
+		prefectures, meta, err = h.prefectureUseCase.ListPrefecturesByRegion(ctx, req.RegionID, params)
+	} else {
+		prefectures, meta, err = h.prefectureUseCase.ListPrefectures(ctx, params)
+	}
+	if err != nil {
+		handleError(c, err, h.appLogger, "Failed to list prefectures")
 
-type Municipality struct {
-	ID                    int32  `json:"id"`
-	PrefectureCode        string `json:"prefecture_code"`
-	OrganizationCode      string `json:"organization_code"`
-	PrefectureNameKanji   string `json:"prefecture_name_kanji"`
-	MunicipalityNameKanji string `json:"municipality_name_kanji"`
-	PrefectureNameKana    string `json:"prefecture_name_kana"`
-	MunicipalityNameKana  string `json:"municipality_name_kana"`
-	IsActive              bool   `json:"is_active"`
-}
+		return
+	}
 
-type GetPrefectureRequest struct {
-	Code string `form:"code" binding:"required,numeric"`
+	c.JSON(http.StatusOK, &PrefectureListResponse{
+		Items:      toPrefectureResponses(prefectures),
+		Pagination: writePaginationHeaders(c, meta, h.cursorCodec),
+	})
 }
 
 // GetPrefecture @title 都道府県詳細取得
@@ -99,6 +124,8 @@ type GetPrefectureRequest struct {
 // @Description 都道府県コードを指定して、都道府県の詳細情報を取得します。
 // @Summary 都道府県詳細取得
 // @Success 200 {object} GetPrefectureResponse
+// @Header 200 {string} ETag "レスポンスの強いETag（If-None-Matchに一致する場合は304を返す）"
+// @Header 200 {string} Last-Modified "更新日時（If-Modified-Sinceより後に更新されていない場合は304を返す）"
 // @Failure 400 {object} ErrorResponseDetail
 // @Failure 401 {object} ErrorResponse
 // @Failure 403 {object} ErrorResponse
@@ -106,16 +133,14 @@ type GetPrefectureRequest struct {
 // @Router /prefectures/{code} [get]
 func (h *prefectureHandler) GetPrefecture(c *gin.Context) {
 	ctx := c.Request.Context()
-	code := c.Param("code")
-	var req GetPrefectureRequest
-	req.Code = code
-	if err := c.ShouldBindUri(&req); err != nil {
+	var uri GetPrefecturePathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
 		handleValidationError(c, err, h.appLogger, "invalid prefecture code")
 
 		return
 	}
 
-	prefecture, err := h.prefectureUseCase.GetPrefectureByCode(ctx, code)
+	prefecture, err := h.prefectureUseCase.GetPrefectureByCode(ctx, uri.Code)
 	if err != nil {
 		handleError(c, err, h.appLogger, "failed to get prefecture")
 
@@ -141,5 +166,33 @@ func (h *prefectureHandler) GetPrefecture(c *gin.Context) {
 		}
 	}
 
+	setLastModified(c, prefecture.UpdatedAt)
 	c.JSON(http.StatusOK, response)
 }
+
+func toPrefectureResponses(prefectures []*model.Prefecture) []*PrefectureResponse {
+	response := make([]*PrefectureResponse, 0, len(prefectures))
+	for _, p := range prefectures {
+		response = append(response, &PrefectureResponse{
+			ID:       p.ID,
+			Code:     p.Code,
+			Name:     p.Name,
+			RegionID: p.RegionID,
+		})
+	}
+
+	return response
+}
+
+func toRegionPrefecturesResponses(groups []*usecase.RegionPrefectures) []*RegionPrefecturesResponse {
+	response := make([]*RegionPrefecturesResponse, len(groups))
+	for i, g := range groups {
+		response[i] = &RegionPrefecturesResponse{
+			ID:          g.Region.ID,
+			Name:        g.Region.Name,
+			Prefectures: toPrefectureResponses(g.Prefectures),
+		}
+	}
+
+	return response
+}
@@ -13,14 +13,24 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"g_gen/internal/domain/model"
+	myerrors "g_gen/internal/errors"
 	"g_gen/internal/handler"
 	"g_gen/internal/infra/logger"
+	"g_gen/internal/pagination"
+	"g_gen/internal/usecase"
 	mockusecase "g_gen/tests/mock/usecase"
 )
 
 func TestPrefectureHandler_ListPrefectures(t *testing.T) {
+	params := pagination.NewParams(1, 20, nil, map[string]string{})
+	nextCursor := &pagination.Cursor{Sort: "code", Keys: []string{"02"}}
+	encodedNextCursor := testCursorCodec.Encode(nextCursor)
+	cursorParams := pagination.NewParams(1, 1, nil, map[string]string{})
+	cursorParams.Cursor = &pagination.Cursor{Sort: "code", Keys: []string{"01"}}
+
 	tests := []struct {
 		name       string
+		query      string
 		mockSetup  func(mockUseCase *mockusecase.MockPrefectureUseCase)
 		wantStatus int
 		wantBody   func() string
@@ -29,23 +39,159 @@ func TestPrefectureHandler_ListPrefectures(t *testing.T) {
 			name: "Success",
 			mockSetup: func(mockUseCase *mockusecase.MockPrefectureUseCase) {
 				prefectures := expectedPrefectureListModel()
-				mockUseCase.EXPECT().ListPrefectures(gomock.Any()).Return(prefectures, nil)
+				mockUseCase.EXPECT().ListPrefectures(gomock.Any(), params).Return(prefectures, pagination.NewMeta(params, 2, nil), nil)
 			},
 			wantStatus: http.StatusOK,
 			wantBody: func() string {
-				response := expectedListPrefecturesResponse()
-				responseJSON, _ := json.Marshal(response)
+				responseJSON, _ := json.Marshal(&handler.PrefectureListResponse{
+					Items: expectedListPrefecturesResponse(),
+					Pagination: &handler.PaginationResponse{
+						Page:       1,
+						PerPage:    20,
+						TotalCount: 2,
+						TotalPages: 1,
+					},
+				})
 				return string(responseJSON)
 			},
 		},
 		{
 			name: "Error",
 			mockSetup: func(mockUseCase *mockusecase.MockPrefectureUseCase) {
-				mockUseCase.EXPECT().ListPrefectures(gomock.Any()).Return(nil, errors.New("database error"))
+				mockUseCase.EXPECT().ListPrefectures(gomock.Any(), params).Return(nil, pagination.Meta{}, errors.New("database error"))
 			},
 			wantStatus: http.StatusInternalServerError,
 			wantBody:   nil,
 		},
+		{
+			name:  "Success/カーソル",
+			query: "?per_page=1&cursor=" + testCursorCodec.Encode(cursorParams.Cursor),
+			mockSetup: func(mockUseCase *mockusecase.MockPrefectureUseCase) {
+				mockUseCase.EXPECT().ListPrefectures(gomock.Any(), cursorParams).Return([]*model.Prefecture{
+					{ID: 2, Code: "02", Name: "青森県", RegionID: 1},
+				}, pagination.NewMeta(cursorParams, 47, nextCursor), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				responseJSON, _ := json.Marshal(&handler.PrefectureListResponse{
+					Items: []*handler.PrefectureResponse{{ID: 2, Code: "02", Name: "青森県", RegionID: 1}},
+					Pagination: &handler.PaginationResponse{
+						PerPage:    1,
+						TotalCount: 47,
+						TotalPages: 47,
+						NextCursor: &encodedNextCursor,
+					},
+				})
+				return string(responseJSON)
+			},
+		},
+		{
+			name:       "failure/改ざんされたカーソル",
+			query:      "?cursor=invalid",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   nil,
+		},
+		{
+			name:  "Success/地方区分で絞り込み",
+			query: "?region_id=1",
+			mockSetup: func(mockUseCase *mockusecase.MockPrefectureUseCase) {
+				mockUseCase.EXPECT().ListPrefecturesByRegion(gomock.Any(), 1, params).Return([]*model.Prefecture{
+					{ID: 1, Code: "01", Name: "北海道", RegionID: 1},
+				}, pagination.NewMeta(params, 1, nil), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				responseJSON, _ := json.Marshal(&handler.PrefectureListResponse{
+					Items: []*handler.PrefectureResponse{
+						{ID: 1, Code: "01", Name: "北海道", RegionID: 1},
+					},
+					Pagination: &handler.PaginationResponse{
+						Page:       1,
+						PerPage:    20,
+						TotalCount: 1,
+						TotalPages: 1,
+					},
+				})
+				return string(responseJSON)
+			},
+		},
+		{
+			name:  "Success/都道府県のない地方区分は空の配列",
+			query: "?region_id=2",
+			mockSetup: func(mockUseCase *mockusecase.MockPrefectureUseCase) {
+				mockUseCase.EXPECT().ListPrefecturesByRegion(gomock.Any(), 2, params).
+					Return([]*model.Prefecture{}, pagination.NewMeta(params, 0, nil), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				responseJSON, _ := json.Marshal(&handler.PrefectureListResponse{
+					Items: []*handler.PrefectureResponse{},
+					Pagination: &handler.PaginationResponse{
+						Page:    1,
+						PerPage: 20,
+					},
+				})
+				return string(responseJSON)
+			},
+		},
+		{
+			name:  "Success/地方区分でグループ化",
+			query: "?group_by=region",
+			mockSetup: func(mockUseCase *mockusecase.MockPrefectureUseCase) {
+				mockUseCase.EXPECT().ListPrefecturesGroupedByRegion(gomock.Any(), params).Return([]*usecase.RegionPrefectures{
+					{
+						Region:      &model.Region{ID: 1, Name: "北海道・東北"},
+						Prefectures: []*model.Prefecture{{ID: 1, Code: "01", Name: "北海道", RegionID: 1}},
+					},
+					{
+						Region:      &model.Region{ID: 2, Name: "関東"},
+						Prefectures: []*model.Prefecture{},
+					},
+				}, pagination.NewMeta(params, 2, nil), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				responseJSON, _ := json.Marshal(&handler.RegionPrefecturesListResponse{
+					Items: []*handler.RegionPrefecturesResponse{
+						{
+							ID:          1,
+							Name:        "北海道・東北",
+							Prefectures: []*handler.PrefectureResponse{{ID: 1, Code: "01", Name: "北海道", RegionID: 1}},
+						},
+						{
+							ID:          2,
+							Name:        "関東",
+							Prefectures: []*handler.PrefectureResponse{},
+						},
+					},
+					Pagination: &handler.PaginationResponse{
+						Page:       1,
+						PerPage:    20,
+						TotalCount: 2,
+						TotalPages: 1,
+					},
+				})
+				return string(responseJSON)
+			},
+		},
+		{
+			name:  "Not Found/存在しない地方区分",
+			query: "?region_id=99",
+			mockSetup: func(mockUseCase *mockusecase.MockPrefectureUseCase) {
+				mockUseCase.EXPECT().ListPrefecturesByRegion(gomock.Any(), 99, params).Return(nil, pagination.Meta{}, &myerrors.APIError{
+					Code:    myerrors.RegionNotFoundError,
+					Message: myerrors.RegionNotFoundErrorMessage,
+				})
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody:   nil,
+		},
+		{
+			name:       "Invalid Group By",
+			query:      "?group_by=prefecture",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -57,7 +203,7 @@ func TestPrefectureHandler_ListPrefectures(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			req := httptest.NewRequest(http.MethodGet, "/prefectures", nil)
+			req := httptest.NewRequest(http.MethodGet, "/prefectures"+tt.query, nil)
 			rec := httptest.NewRecorder()
 			c, _ := gin.CreateTestContext(rec)
 			c.Request = req
@@ -67,7 +213,7 @@ func TestPrefectureHandler_ListPrefectures(t *testing.T) {
 				tt.mockSetup(uc)
 			}
 
-			mockHandler := handler.NewPrefectureHandler(appLogger, uc)
+			mockHandler := handler.NewPrefectureHandler(appLogger, uc, testCursorCodec)
 			mockHandler.ListPrefectures(c)
 
 			a.Equal(tt.wantStatus, rec.Code)
@@ -125,10 +271,12 @@ func expectedPrefectureListModel() []*model.Prefecture {
 func expectedListPrefecturesResponse() []*handler.PrefectureResponse {
 	return []*handler.PrefectureResponse{
 		{
+			ID:   1,
 			Code: "01",
 			Name: "北海道",
 		},
 		{
+			ID:   13,
 			Code: "13",
 			Name: "東京都",
 		},
@@ -161,7 +309,10 @@ func TestPrefectureHandler_GetPrefecture(t *testing.T) {
 			name: "Not Found",
 			code: "999",
 			mockSetup: func(mockUseCase *mockusecase.MockPrefectureUseCase) {
-				mockUseCase.EXPECT().GetPrefectureByCode(gomock.Any(), "999").Return(nil, errors.New("not found"))
+				mockUseCase.EXPECT().GetPrefectureByCode(gomock.Any(), "999").Return(nil, &myerrors.APIError{
+					Code:    myerrors.PrefectureNotFoundError,
+					Message: myerrors.PrefectureNotFoundErrorMessage,
+				})
 			},
 			wantStatus: http.StatusNotFound,
 			wantBody:   nil,
@@ -194,7 +345,7 @@ func TestPrefectureHandler_GetPrefecture(t *testing.T) {
 				tt.mockSetup(uc)
 			}
 
-			mockHandler := handler.NewPrefectureHandler(appLogger, uc)
+			mockHandler := handler.NewPrefectureHandler(appLogger, uc, testCursorCodec)
 			mockHandler.GetPrefecture(c)
 
 			a.Equal(tt.wantStatus, rec.Code)
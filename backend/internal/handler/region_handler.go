@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"g_gen/internal/infra/logger"
+	"g_gen/internal/pagination"
+	"g_gen/internal/usecase"
+)
+
+type regionHandler struct {
+	appLogger         *logger.Logger
+	regionUseCase     usecase.RegionUseCase
+	prefectureUseCase usecase.PrefectureUseCase
+	cursorCodec       *pagination.CursorCodec
+}
+
+func NewRegionHandler(
+	l *logger.Logger,
+	regionUseCase usecase.RegionUseCase,
+	prefectureUseCase usecase.PrefectureUseCase,
+	cursorCodec *pagination.CursorCodec,
+) RegionHandler {
+	return &regionHandler{
+		appLogger:         l,
+		regionUseCase:     regionUseCase,
+		prefectureUseCase: prefectureUseCase,
+		cursorCodec:       cursorCodec,
+	}
+}
+
+// ListRegions @title 地方区分一覧取得
+// @id ListRegions
+// @tags regions
+// @accept json
+// @produce json
+// @Summary 地方区分一覧取得
+// @Param page query int false "ページ番号（既定1）"
+// @Param per_page query int false "1ページあたりの件数（既定20、最大100）"
+// @Param sort query string false "ソート条件（id。降順は先頭に-）"
+// @Param cursor query string false "前のレスポンスのnext_cursor。指定した場合はpageを無視してカーソルの位置から取得"
+// @Success 200 {object} RegionListResponse
+// @Header 200 {string} Link "前後のページへのリンク"
+// @Header 200 {integer} X-Total-Count "総件数"
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 地方区分（北海道・東北〜九州・沖縄）の一覧をページ単位で取得します。並び順の指定がない場合はID順です。
+// @Router /regions [get]
+func (h *regionHandler) ListRegions(c *gin.Context) {
+	var query ListRegionsQueryParams
+	if err := c.ShouldBindQuery(&query); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid region list request")
+
+		return
+	}
+
+	params, err := toPaginationParams(c, &PaginationRequest{
+		Page:    query.Page,
+		PerPage: query.PerPage,
+		Sort:    query.Sort,
+		Cursor:  query.Cursor,
+	}, h.cursorCodec)
+	if err != nil {
+		handleError(c, err, h.appLogger, "invalid region list cursor")
+
+		return
+	}
+
+	regions, meta, err := h.regionUseCase.ListRegions(c.Request.Context(), params)
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to list regions")
+
+		return
+	}
+
+	response := make([]*RegionResponse, len(regions))
+	for i, r := range regions {
+		response[i] = &RegionResponse{
+			ID:   r.ID,
+			Name: r.Name,
+		}
+	}
+
+	c.JSON(http.StatusOK, &RegionListResponse{
+		Items:      response,
+		Pagination: writePaginationHeaders(c, meta, h.cursorCodec),
+	})
+}
+
+// ListRegionPrefectures @title 地方区分別都道府県一覧取得
+// @id ListRegionPrefectures
+// @tags regions
+// @accept json
+// @produce json
+// @Param id path int true "地方区分ID"
+// @Summary 地方区分別都道府県一覧取得
+// @Param page query int false "ページ番号（既定1）"
+// @Param per_page query int false "1ページあたりの件数（既定20、最大100）"
+// @Param sort query string false "ソート条件（id, code。降順は先頭に-）"
+// @Param cursor query string false "前のレスポンスのnext_cursor。指定した場合はpageを無視してカーソルの位置から取得"
+// @Success 200 {object} PrefectureListResponse
+// @Header 200 {string} Link "前後のページへのリンク"
+// @Header 200 {integer} X-Total-Count "総件数"
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 地方区分IDを指定して、その地方区分に属する都道府県の一覧をページ単位で取得します。並び順の指定がない場合は都道府県コード順です。
+// @Router /regions/{id}/prefectures [get]
+func (h *regionHandler) ListRegionPrefectures(c *gin.Context) {
+	ctx := c.Request.Context()
+	var uri ListRegionPrefecturesPathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid region id")
+
+		return
+	}
+
+	var query ListRegionPrefecturesQueryParams
+	if err := c.ShouldBindQuery(&query); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid region prefecture list request")
+
+		return
+	}
+
+	params, err := toPaginationParams(c, &PaginationRequest{
+		Page:    query.Page,
+		PerPage: query.PerPage,
+		Sort:    query.Sort,
+		Cursor:  query.Cursor,
+	}, h.cursorCodec)
+	if err != nil {
+		handleError(c, err, h.appLogger, "invalid region prefecture list cursor")
+
+		return
+	}
+
+	prefectures, meta, err := h.prefectureUseCase.ListPrefecturesByRegion(ctx, uri.ID, params)
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to list prefectures by region")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, &PrefectureListResponse{
+		Items:      toPrefectureResponses(prefectures),
+		Pagination: writePaginationHeaders(c, meta, h.cursorCodec),
+	})
+}
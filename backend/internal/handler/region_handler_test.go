@@ -0,0 +1,216 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"g_gen/internal/domain/model"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/handler"
+	"g_gen/internal/infra/logger"
+	"g_gen/internal/pagination"
+	mockusecase "g_gen/tests/mock/usecase"
+)
+
+func TestRegionHandler_ListRegions(t *testing.T) {
+	params := pagination.NewParams(1, 20, nil, map[string]string{})
+	sortedParams := pagination.NewParams(1, 20, []pagination.Sort{{Field: "id", Desc: true}}, map[string]string{})
+
+	tests := []struct {
+		name       string
+		query      string
+		mockSetup  func(mockUseCase *mockusecase.MockRegionUseCase)
+		wantStatus int
+		wantBody   func() string
+	}{
+		{
+			name: "Success",
+			mockSetup: func(mockUseCase *mockusecase.MockRegionUseCase) {
+				mockUseCase.EXPECT().ListRegions(gomock.Any(), params).Return([]*model.Region{
+					{ID: 1, Name: "北海道・東北"},
+					{ID: 2, Name: "関東"},
+				}, pagination.NewMeta(params, 2, nil), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				responseJSON, _ := json.Marshal(&handler.RegionListResponse{
+					Items: []*handler.RegionResponse{
+						{ID: 1, Name: "北海道・東北"},
+						{ID: 2, Name: "関東"},
+					},
+					Pagination: &handler.PaginationResponse{
+						Page:       1,
+						PerPage:    20,
+						TotalCount: 2,
+						TotalPages: 1,
+					},
+				})
+				return string(responseJSON)
+			},
+		},
+		{
+			name:  "Success/ソート",
+			query: "?sort=-id",
+			mockSetup: func(mockUseCase *mockusecase.MockRegionUseCase) {
+				mockUseCase.EXPECT().ListRegions(gomock.Any(), sortedParams).Return([]*model.Region{
+					{ID: 7, Name: "九州・沖縄"},
+				}, pagination.NewMeta(sortedParams, 1, nil), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   nil,
+		},
+		{
+			name:       "Invalid Cursor",
+			query:      "?cursor=invalid",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   nil,
+		},
+		{
+			name: "Error",
+			mockSetup: func(mockUseCase *mockusecase.MockRegionUseCase) {
+				mockUseCase.EXPECT().ListRegions(gomock.Any(), params).Return(nil, pagination.Meta{}, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodGet, "/regions"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+
+			uc := mockusecase.NewMockRegionUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			mockHandler := handler.NewRegionHandler(appLogger, uc, mockusecase.NewMockPrefectureUseCase(ctrl), testCursorCodec)
+			mockHandler.ListRegions(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+
+			if tt.wantBody != nil {
+				wantBody := tt.wantBody()
+				resBody := rec.Body.String()
+				if !cmp.Equal(wantBody, resBody) {
+					t.Errorf("diff: %s", cmp.Diff(wantBody, resBody))
+				}
+			}
+		})
+	}
+}
+
+func TestRegionHandler_ListRegionPrefectures(t *testing.T) {
+	params := pagination.NewParams(1, 20, nil, map[string]string{})
+
+	tests := []struct {
+		name       string
+		id         string
+		mockSetup  func(mockUseCase *mockusecase.MockPrefectureUseCase)
+		wantStatus int
+		wantBody   func() string
+	}{
+		{
+			name: "Success",
+			id:   "6",
+			mockSetup: func(mockUseCase *mockusecase.MockPrefectureUseCase) {
+				mockUseCase.EXPECT().ListPrefecturesByRegion(gomock.Any(), 6, params).Return([]*model.Prefecture{
+					{ID: 36, Code: "36", Name: "徳島県", RegionID: 6},
+					{ID: 37, Code: "37", Name: "香川県", RegionID: 6},
+				}, pagination.NewMeta(params, 2, nil), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				responseJSON, _ := json.Marshal(&handler.PrefectureListResponse{
+					Items: []*handler.PrefectureResponse{
+						{ID: 36, Code: "36", Name: "徳島県", RegionID: 6},
+						{ID: 37, Code: "37", Name: "香川県", RegionID: 6},
+					},
+					Pagination: &handler.PaginationResponse{
+						Page:       1,
+						PerPage:    20,
+						TotalCount: 2,
+						TotalPages: 1,
+					},
+				})
+				return string(responseJSON)
+			},
+		},
+		{
+			name:       "Invalid ID",
+			id:         "abc",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   nil,
+		},
+		{
+			name: "Not Found",
+			id:   "99",
+			mockSetup: func(mockUseCase *mockusecase.MockPrefectureUseCase) {
+				mockUseCase.EXPECT().ListPrefecturesByRegion(gomock.Any(), 99, params).Return(nil, pagination.Meta{}, &myerrors.APIError{
+					Code:    myerrors.RegionNotFoundError,
+					Message: myerrors.RegionNotFoundErrorMessage,
+				})
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodGet, "/regions/"+tt.id+"/prefectures", nil)
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+			c.Params = []gin.Param{
+				{
+					Key:   "id",
+					Value: tt.id,
+				},
+			}
+
+			uc := mockusecase.NewMockPrefectureUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			mockHandler := handler.NewRegionHandler(appLogger, mockusecase.NewMockRegionUseCase(ctrl), uc, testCursorCodec)
+			mockHandler.ListRegionPrefectures(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+
+			if tt.wantBody != nil {
+				wantBody := tt.wantBody()
+				resBody := rec.Body.String()
+				if !cmp.Equal(wantBody, resBody) {
+					t.Errorf("diff: %s", cmp.Diff(wantBody, resBody))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,403 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	"g_gen/internal/domain/model"
+	"g_gen/internal/infra/logger"
+	"g_gen/internal/pagination"
+	"g_gen/internal/usecase"
+)
+
+// userRole 認証のミドルウェアが設定した、状態を遷移させる利用者の役割
+type userRole struct {
+	Role string `binding:"required,oneof=applicant municipality prefecture" ja:"役割"`
+}
+
+type supportApplicationHandler struct {
+	appLogger                 *logger.Logger
+	supportApplicationUseCase usecase.SupportApplicationUseCase
+	cursorCodec               *pagination.CursorCodec
+}
+
+func NewSupportApplicationHandler(
+	l *logger.Logger,
+	supportApplicationUseCase usecase.SupportApplicationUseCase,
+	cursorCodec *pagination.CursorCodec,
+) SupportApplicationHandler {
+	return &supportApplicationHandler{
+		appLogger:                 l,
+		supportApplicationUseCase: supportApplicationUseCase,
+		cursorCodec:               cursorCodec,
+	}
+}
+
+// CreateSupportApplication @title 支援申請登録
+// @id CreateSupportApplication
+// @tags support-applications
+// @accept json
+// @produce json
+// @Param request body CreateSupportApplicationRequest true "支援申請"
+// @Summary 支援申請登録
+// @Success 201 {object} SupportApplicationResponse
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 被害報告に基づく支援申請を作成中の状態で登録します。
+// @Router /support-applications [post]
+func (h *supportApplicationHandler) CreateSupportApplication(c *gin.Context) {
+	var req CreateSupportApplicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid support application request")
+
+		return
+	}
+
+	application, err := h.supportApplicationUseCase.CreateSupportApplication(c.Request.Context(), &model.SupportApplication{
+		DamageReportID:  *req.DamageReportID,
+		ApplicantName:   req.ApplicantName,
+		RequestedAmount: *req.RequestedAmount,
+	})
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to create support application")
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, toSupportApplicationResponse(application))
+}
+
+// GetSupportApplication @title 支援申請詳細取得
+// @id GetSupportApplication
+// @tags support-applications
+// @accept json
+// @produce json
+// @Param id path int true "支援申請ID"
+// @Summary 支援申請詳細取得
+// @Success 200 {object} SupportApplicationResponse
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 支援申請IDを指定して、支援申請を取得します。
+// @Router /support-applications/{id} [get]
+func (h *supportApplicationHandler) GetSupportApplication(c *gin.Context) {
+	var uri GetSupportApplicationPathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid support application id")
+
+		return
+	}
+
+	application, err := h.supportApplicationUseCase.GetSupportApplication(c.Request.Context(), uri.ID)
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to get support application")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, toSupportApplicationResponse(application))
+}
+
+// ListSupportApplicationTransitions @title 支援申請遷移履歴取得
+// @id ListSupportApplicationTransitions
+// @tags support-applications
+// @accept json
+// @produce json
+// @Param id path int true "支援申請ID"
+// @Param page query int false "ページ番号（既定1）"
+// @Param per_page query int false "1ページあたりの件数（既定20、最大100）"
+// @Param sort query string false "ソート条件（id。降順は先頭に-）"
+// @Param cursor query string false "前のレスポンスのnext_cursor。指定した場合はpageを無視してカーソルの位置から取得"
+// @Summary 支援申請遷移履歴取得
+// @Success 200 {object} SupportApplicationTransitionListResponse
+// @Header 200 {string} Link "前後のページへのリンク"
+// @Header 200 {integer} X-Total-Count "総件数"
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 支援申請IDを指定して、状態の遷移履歴をページ単位で取得します。並び順の指定がない場合は遷移した順です。
+// @Router /support-applications/{id}/transitions [get]
+func (h *supportApplicationHandler) ListSupportApplicationTransitions(c *gin.Context) {
+	var uri ListSupportApplicationTransitionsPathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid support application id")
+
+		return
+	}
+
+	var query ListSupportApplicationTransitionsQueryParams
+	if err := c.ShouldBindQuery(&query); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid support application transition list request")
+
+		return
+	}
+
+	params, err := toPaginationParams(c, &PaginationRequest{
+		Page:    query.Page,
+		PerPage: query.PerPage,
+		Sort:    query.Sort,
+		Cursor:  query.Cursor,
+	}, h.cursorCodec)
+	if err != nil {
+		handleError(c, err, h.appLogger, "invalid support application transition list cursor")
+
+		return
+	}
+
+	transitions, meta, err := h.supportApplicationUseCase.ListSupportApplicationTransitions(c.Request.Context(), uri.ID, params)
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to list support application transitions")
+
+		return
+	}
+
+	response := make([]*SupportApplicationTransitionResponse, len(transitions))
+	for i, t := range transitions {
+		response[i] = &SupportApplicationTransitionResponse{
+			ID:         t.ID,
+			Action:     t.Action,
+			FromStatus: t.FromStatus,
+			ToStatus:   t.ToStatus,
+			ActorRole:  t.ActorRole,
+			Comment:    t.Comment,
+			CreatedAt:  t.CreatedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, &SupportApplicationTransitionListResponse{
+		Items:      response,
+		Pagination: writePaginationHeaders(c, meta, h.cursorCodec),
+	})
+}
+
+// SubmitSupportApplication @title 支援申請提出
+// @id SubmitSupportApplication
+// @tags support-applications
+// @accept json
+// @produce json
+// @Param id path int true "支援申請ID"
+// @Security BearerAuth
+// @Param X-User-Role header string false "操作する利用者の役割。ローカル環境で TRUST_USER_ROLE_HEADER を有効にした場合のみ使う" Enums(applicant, municipality, prefecture)
+// @Param request body TransitionSupportApplicationRequest false "コメント"
+// @Summary 支援申請提出
+// @Success 200 {object} SupportApplicationResponse
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 作成中または差戻しの支援申請を提出します。申請者（applicant）のみ操作できます。
+// @Router /support-applications/{id}/submit [post]
+func (h *supportApplicationHandler) SubmitSupportApplication(c *gin.Context) {
+	var uri SubmitSupportApplicationPathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid support application id")
+
+		return
+	}
+
+	h.transition(c, uri.ID, usecase.SupportApplicationActionSubmit)
+}
+
+// AcceptSupportApplication @title 支援申請受付
+// @id AcceptSupportApplication
+// @tags support-applications
+// @accept json
+// @produce json
+// @Param id path int true "支援申請ID"
+// @Security BearerAuth
+// @Param X-User-Role header string false "操作する利用者の役割。ローカル環境で TRUST_USER_ROLE_HEADER を有効にした場合のみ使う" Enums(applicant, municipality, prefecture)
+// @Param request body TransitionSupportApplicationRequest false "コメント"
+// @Summary 支援申請受付
+// @Success 200 {object} SupportApplicationResponse
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 提出済みの支援申請を受け付け、市区町村審査中にします。市区町村（municipality）のみ操作できます。
+// @Router /support-applications/{id}/accept [post]
+func (h *supportApplicationHandler) AcceptSupportApplication(c *gin.Context) {
+	var uri AcceptSupportApplicationPathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid support application id")
+
+		return
+	}
+
+	h.transition(c, uri.ID, usecase.SupportApplicationActionAccept)
+}
+
+// ForwardSupportApplication @title 支援申請進達
+// @id ForwardSupportApplication
+// @tags support-applications
+// @accept json
+// @produce json
+// @Param id path int true "支援申請ID"
+// @Security BearerAuth
+// @Param X-User-Role header string false "操作する利用者の役割。ローカル環境で TRUST_USER_ROLE_HEADER を有効にした場合のみ使う" Enums(applicant, municipality, prefecture)
+// @Param request body TransitionSupportApplicationRequest false "コメント"
+// @Summary 支援申請進達
+// @Success 200 {object} SupportApplicationResponse
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 市区町村審査中の支援申請を都道府県に進達し、都道府県審査中にします。市区町村（municipality）のみ操作できます。
+// @Router /support-applications/{id}/forward [post]
+func (h *supportApplicationHandler) ForwardSupportApplication(c *gin.Context) {
+	var uri ForwardSupportApplicationPathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid support application id")
+
+		return
+	}
+
+	h.transition(c, uri.ID, usecase.SupportApplicationActionForward)
+}
+
+// ApproveSupportApplication @title 支援申請承認
+// @id ApproveSupportApplication
+// @tags support-applications
+// @accept json
+// @produce json
+// @Param id path int true "支援申請ID"
+// @Security BearerAuth
+// @Param X-User-Role header string false "操作する利用者の役割。ローカル環境で TRUST_USER_ROLE_HEADER を有効にした場合のみ使う" Enums(applicant, municipality, prefecture)
+// @Param request body TransitionSupportApplicationRequest false "コメント"
+// @Summary 支援申請承認
+// @Success 200 {object} SupportApplicationResponse
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 都道府県審査中の支援申請を承認します。都道府県（prefecture）のみ操作できます。
+// @Router /support-applications/{id}/approve [post]
+func (h *supportApplicationHandler) ApproveSupportApplication(c *gin.Context) {
+	var uri ApproveSupportApplicationPathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid support application id")
+
+		return
+	}
+
+	h.transition(c, uri.ID, usecase.SupportApplicationActionApprove)
+}
+
+// RejectSupportApplication @title 支援申請却下
+// @id RejectSupportApplication
+// @tags support-applications
+// @accept json
+// @produce json
+// @Param id path int true "支援申請ID"
+// @Security BearerAuth
+// @Param X-User-Role header string false "操作する利用者の役割。ローカル環境で TRUST_USER_ROLE_HEADER を有効にした場合のみ使う" Enums(applicant, municipality, prefecture)
+// @Param request body TransitionSupportApplicationRequest false "コメント"
+// @Summary 支援申請却下
+// @Success 200 {object} SupportApplicationResponse
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 審査中の支援申請を却下します。市区町村審査中は市区町村（municipality）、都道府県審査中は都道府県（prefecture）のみ操作できます。
+// @Router /support-applications/{id}/reject [post]
+func (h *supportApplicationHandler) RejectSupportApplication(c *gin.Context) {
+	var uri RejectSupportApplicationPathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid support application id")
+
+		return
+	}
+
+	h.transition(c, uri.ID, usecase.SupportApplicationActionReject)
+}
+
+// ReturnSupportApplication @title 支援申請差戻し
+// @id ReturnSupportApplication
+// @tags support-applications
+// @accept json
+// @produce json
+// @Param id path int true "支援申請ID"
+// @Security BearerAuth
+// @Param X-User-Role header string false "操作する利用者の役割。ローカル環境で TRUST_USER_ROLE_HEADER を有効にした場合のみ使う" Enums(applicant, municipality, prefecture)
+// @Param request body TransitionSupportApplicationRequest false "コメント"
+// @Summary 支援申請差戻し
+// @Success 200 {object} SupportApplicationResponse
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 審査中の支援申請を申請者に差し戻します。市区町村審査中は市区町村（municipality）、都道府県審査中は都道府県（prefecture）のみ操作できます。
+// @Router /support-applications/{id}/return [post]
+func (h *supportApplicationHandler) ReturnSupportApplication(c *gin.Context) {
+	var uri ReturnSupportApplicationPathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid support application id")
+
+		return
+	}
+
+	h.transition(c, uri.ID, usecase.SupportApplicationActionReturn)
+}
+
+// transition 状態遷移の各エンドポイントに共通する処理
+// 役割は認証のミドルウェアが設定したものを使い、役割が操作できるかはユースケースで判定する
+func (h *supportApplicationHandler) transition(c *gin.Context, id int, action usecase.SupportApplicationAction) {
+	role := userRole{Role: c.GetString(UserRoleKey)}
+	if err := binding.Validator.ValidateStruct(&role); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid support application user role")
+
+		return
+	}
+
+	// コメントは省略できるため、ボディがない場合はコメントなしとする
+	var req TransitionSupportApplicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		handleValidationError(c, err, h.appLogger, "invalid support application transition request")
+
+		return
+	}
+
+	application, err := h.supportApplicationUseCase.TransitionSupportApplication(
+		c.Request.Context(),
+		id,
+		action,
+		role.Role,
+		req.Comment,
+	)
+	if err != nil {
+		handleError(c, err, h.appLogger, fmt.Sprintf("failed to %s support application", action))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, toSupportApplicationResponse(application))
+}
+
+func toSupportApplicationResponse(a *model.SupportApplication) *SupportApplicationResponse {
+	return &SupportApplicationResponse{
+		ID:              a.ID,
+		DamageReportID:  a.DamageReportID,
+		ApplicantName:   a.ApplicantName,
+		RequestedAmount: a.RequestedAmount,
+		Status:          a.Status,
+		CreatedAt:       a.CreatedAt,
+		UpdatedAt:       a.UpdatedAt,
+	}
+}
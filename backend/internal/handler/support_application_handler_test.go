@@ -0,0 +1,608 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"g_gen/internal/domain/model"
+	domain "g_gen/internal/domain/repository"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/handler"
+	"g_gen/internal/infra/logger"
+	"g_gen/internal/pagination"
+	"g_gen/internal/usecase"
+	mockusecase "g_gen/tests/mock/usecase"
+)
+
+var supportApplicationUpdatedAt = time.Date(2026, 7, 5, 10, 0, 0, 0, time.UTC)
+
+func expectedSupportApplicationModel(status string) *model.SupportApplication {
+	return &model.SupportApplication{
+		ID:              1,
+		DamageReportID:  3,
+		ApplicantName:   "丸の内 花子",
+		RequestedAmount: 250000,
+		Status:          status,
+		CreatedAt:       supportApplicationUpdatedAt,
+		UpdatedAt:       supportApplicationUpdatedAt,
+	}
+}
+
+func expectedSupportApplicationResponse(status string) *handler.SupportApplicationResponse {
+	return &handler.SupportApplicationResponse{
+		ID:              1,
+		DamageReportID:  3,
+		ApplicantName:   "丸の内 花子",
+		RequestedAmount: 250000,
+		Status:          status,
+		CreatedAt:       supportApplicationUpdatedAt,
+		UpdatedAt:       supportApplicationUpdatedAt,
+	}
+}
+
+func TestSupportApplicationHandler_CreateSupportApplication(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		mockSetup   func(mockUseCase *mockusecase.MockSupportApplicationUseCase)
+		wantStatus  int
+		wantDetails []handler.ValidationError
+	}{
+		{
+			name: "Success",
+			body: `{"damage_report_id":3,"applicant_name":"丸の内 花子","requested_amount":250000}`,
+			mockSetup: func(mockUseCase *mockusecase.MockSupportApplicationUseCase) {
+				mockUseCase.EXPECT().CreateSupportApplication(gomock.Any(), &model.SupportApplication{
+					DamageReportID:  3,
+					ApplicantName:   "丸の内 花子",
+					RequestedAmount: 250000,
+				}).Return(expectedSupportApplicationModel(domain.SupportApplicationStatusDraft), nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "Validation Error/必須項目なし",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+			wantDetails: []handler.ValidationError{
+				{Attribute: "被害報告ID", Tag: "required", Message: "被害報告IDは必須フィールドです"},
+				{Attribute: "申請者名", Tag: "required", Message: "申請者名は必須フィールドです"},
+				{Attribute: "申請額", Tag: "required", Message: "申請額は必須フィールドです"},
+			},
+		},
+		{
+			name:       "Validation Error/範囲",
+			body:       `{"damage_report_id":0,"applicant_name":"丸の内 花子","requested_amount":-1}`,
+			wantStatus: http.StatusBadRequest,
+			wantDetails: []handler.ValidationError{
+				{Attribute: "被害報告ID", Tag: "min", Message: "被害報告IDは1以上でなければなりません"},
+				{Attribute: "申請額", Tag: "min", Message: "申請額は0以上でなければなりません"},
+			},
+		},
+		{
+			name: "Damage Report Not Found",
+			body: `{"damage_report_id":99,"applicant_name":"丸の内 花子","requested_amount":250000}`,
+			mockSetup: func(mockUseCase *mockusecase.MockSupportApplicationUseCase) {
+				mockUseCase.EXPECT().CreateSupportApplication(gomock.Any(), gomock.Any()).Return(nil, &myerrors.APIError{
+					Code:    myerrors.DamageReportNotFoundError,
+					Message: myerrors.DamageReportNotFoundErrorMessage,
+				})
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodPost, "/support-applications", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+
+			uc := mockusecase.NewMockSupportApplicationUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			mockHandler := handler.NewSupportApplicationHandler(appLogger, uc, testCursorCodec)
+			mockHandler.CreateSupportApplication(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+
+			if tt.wantDetails != nil {
+				var res handler.ErrorResponseDetail
+				a.NoError(json.Unmarshal(rec.Body.Bytes(), &res))
+				a.Equal(myerrors.ValidationError, res.Code)
+				if !cmp.Equal(tt.wantDetails, res.Details) {
+					t.Errorf("diff: %s", cmp.Diff(tt.wantDetails, res.Details))
+				}
+			}
+		})
+	}
+}
+
+func TestSupportApplicationHandler_GetSupportApplication(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		mockSetup  func(mockUseCase *mockusecase.MockSupportApplicationUseCase)
+		wantStatus int
+		wantBody   func() string
+	}{
+		{
+			name: "Success",
+			id:   "1",
+			mockSetup: func(mockUseCase *mockusecase.MockSupportApplicationUseCase) {
+				mockUseCase.EXPECT().GetSupportApplication(gomock.Any(), 1).
+					Return(expectedSupportApplicationModel(domain.SupportApplicationStatusDraft), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				responseJSON, _ := json.Marshal(expectedSupportApplicationResponse(domain.SupportApplicationStatusDraft))
+				return string(responseJSON)
+			},
+		},
+		{
+			name:       "Invalid ID",
+			id:         "abc",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Not Found",
+			id:   "99",
+			mockSetup: func(mockUseCase *mockusecase.MockSupportApplicationUseCase) {
+				mockUseCase.EXPECT().GetSupportApplication(gomock.Any(), 99).Return(nil, &myerrors.APIError{
+					Code:    myerrors.SupportApplicationNotFoundError,
+					Message: myerrors.SupportApplicationNotFoundErrorMessage,
+				})
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody: func() string {
+				return `{"code":"E100007","message":"支援申請は存在しません"}`
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodGet, "/support-applications/"+tt.id, nil)
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+			c.Params = []gin.Param{
+				{
+					Key:   "id",
+					Value: tt.id,
+				},
+			}
+
+			uc := mockusecase.NewMockSupportApplicationUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			mockHandler := handler.NewSupportApplicationHandler(appLogger, uc, testCursorCodec)
+			mockHandler.GetSupportApplication(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+
+			if tt.wantBody != nil {
+				wantBody := tt.wantBody()
+				resBody := rec.Body.String()
+				if !cmp.Equal(wantBody, resBody) {
+					t.Errorf("diff: %s", cmp.Diff(wantBody, resBody))
+				}
+			}
+		})
+	}
+}
+
+func TestSupportApplicationHandler_ListSupportApplicationTransitions(t *testing.T) {
+	comment := "書類を受け付けました"
+	transitionedAt := time.Date(2026, 7, 3, 15, 0, 0, 0, time.UTC)
+	params := pagination.NewParams(1, 20, nil, map[string]string{})
+	pagedParams := pagination.NewParams(1, 1, []pagination.Sort{{Field: "id", Desc: true}}, map[string]string{})
+	nextCursor := &pagination.Cursor{Sort: "-id", Keys: []string{"2"}}
+	encodedNextCursor := testCursorCodec.Encode(nextCursor)
+
+	tests := []struct {
+		name       string
+		id         string
+		query      string
+		mockSetup  func(mockUseCase *mockusecase.MockSupportApplicationUseCase)
+		wantStatus int
+		wantBody   func() string
+	}{
+		{
+			name: "Success",
+			id:   "1",
+			mockSetup: func(mockUseCase *mockusecase.MockSupportApplicationUseCase) {
+				mockUseCase.EXPECT().ListSupportApplicationTransitions(gomock.Any(), 1, params).Return([]*model.SupportApplicationTransition{
+					{
+						ID:                   1,
+						SupportApplicationID: 1,
+						Action:               "submit",
+						FromStatus:           domain.SupportApplicationStatusDraft,
+						ToStatus:             domain.SupportApplicationStatusSubmitted,
+						ActorRole:            domain.RoleApplicant,
+						CreatedAt:            transitionedAt,
+					},
+					{
+						ID:                   2,
+						SupportApplicationID: 1,
+						Action:               "accept",
+						FromStatus:           domain.SupportApplicationStatusSubmitted,
+						ToStatus:             domain.SupportApplicationStatusMunicipalityReview,
+						ActorRole:            domain.RoleMunicipality,
+						Comment:              &comment,
+						CreatedAt:            transitionedAt,
+					},
+				}, pagination.NewMeta(params, 2, nil), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				return `{"items":[{"id":1,"action":"submit","from_status":"draft","to_status":"submitted","actor_role":"applicant",` +
+					`"comment":null,"created_at":"2026-07-03T15:00:00Z"},` +
+					`{"id":2,"action":"accept","from_status":"submitted","to_status":"municipality_review","actor_role":"municipality",` +
+					`"comment":"書類を受け付けました","created_at":"2026-07-03T15:00:00Z"}],` +
+					`"pagination":{"page":1,"per_page":20,"total_count":2,"total_pages":1,"next_cursor":null}}`
+			},
+		},
+		{
+			name:  "Success/新しい順に1件ずつ",
+			id:    "1",
+			query: "?per_page=1&sort=-id",
+			mockSetup: func(mockUseCase *mockusecase.MockSupportApplicationUseCase) {
+				mockUseCase.EXPECT().ListSupportApplicationTransitions(gomock.Any(), 1, pagedParams).Return([]*model.SupportApplicationTransition{
+					{
+						ID:                   2,
+						SupportApplicationID: 1,
+						Action:               "accept",
+						FromStatus:           domain.SupportApplicationStatusSubmitted,
+						ToStatus:             domain.SupportApplicationStatusMunicipalityReview,
+						ActorRole:            domain.RoleMunicipality,
+						Comment:              &comment,
+						CreatedAt:            transitionedAt,
+					},
+				}, pagination.NewMeta(pagedParams, 2, nextCursor), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				return `{"items":[{"id":2,"action":"accept","from_status":"submitted","to_status":"municipality_review","actor_role":"municipality",` +
+					`"comment":"書類を受け付けました","created_at":"2026-07-03T15:00:00Z"}],` +
+					`"pagination":{"page":1,"per_page":1,"total_count":2,"total_pages":2,"next_cursor":"` + encodedNextCursor + `"}}`
+			},
+		},
+		{
+			name:       "Invalid Cursor",
+			id:         "1",
+			query:      "?cursor=invalid",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Not Found",
+			id:   "99",
+			mockSetup: func(mockUseCase *mockusecase.MockSupportApplicationUseCase) {
+				mockUseCase.EXPECT().ListSupportApplicationTransitions(gomock.Any(), 99, params).Return(nil, pagination.Meta{}, &myerrors.APIError{
+					Code:    myerrors.SupportApplicationNotFoundError,
+					Message: myerrors.SupportApplicationNotFoundErrorMessage,
+				})
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodGet, "/support-applications/"+tt.id+"/transitions"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+			c.Params = []gin.Param{
+				{
+					Key:   "id",
+					Value: tt.id,
+				},
+			}
+
+			uc := mockusecase.NewMockSupportApplicationUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			mockHandler := handler.NewSupportApplicationHandler(appLogger, uc, testCursorCodec)
+			mockHandler.ListSupportApplicationTransitions(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+
+			if tt.wantBody != nil {
+				wantBody := tt.wantBody()
+				resBody := rec.Body.String()
+				if !cmp.Equal(wantBody, resBody) {
+					t.Errorf("diff: %s", cmp.Diff(wantBody, resBody))
+				}
+			}
+		})
+	}
+}
+
+func TestSupportApplicationHandler_Transitions(t *testing.T) {
+	comment := "書類に不備があります"
+
+	tests := []struct {
+		name        string
+		call        func(h handler.SupportApplicationHandler, c *gin.Context)
+		id          string
+		role        string
+		body        string
+		mockSetup   func(mockUseCase *mockusecase.MockSupportApplicationUseCase)
+		wantStatus  int
+		wantBody    func() string
+		wantDetails []handler.ValidationError
+	}{
+		{
+			name: "Success/提出",
+			call: handler.SupportApplicationHandler.SubmitSupportApplication,
+			id:   "1",
+			role: domain.RoleApplicant,
+			body: `{}`,
+			mockSetup: func(mockUseCase *mockusecase.MockSupportApplicationUseCase) {
+				mockUseCase.EXPECT().
+					TransitionSupportApplication(gomock.Any(), 1, usecase.SupportApplicationActionSubmit, domain.RoleApplicant, nil).
+					Return(expectedSupportApplicationModel(domain.SupportApplicationStatusSubmitted), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				responseJSON, _ := json.Marshal(expectedSupportApplicationResponse(domain.SupportApplicationStatusSubmitted))
+				return string(responseJSON)
+			},
+		},
+		{
+			name: "Success/受付",
+			call: handler.SupportApplicationHandler.AcceptSupportApplication,
+			id:   "1",
+			role: domain.RoleMunicipality,
+			body: `{}`,
+			mockSetup: func(mockUseCase *mockusecase.MockSupportApplicationUseCase) {
+				mockUseCase.EXPECT().
+					TransitionSupportApplication(gomock.Any(), 1, usecase.SupportApplicationActionAccept, domain.RoleMunicipality, nil).
+					Return(expectedSupportApplicationModel(domain.SupportApplicationStatusMunicipalityReview), nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "Success/進達",
+			call: handler.SupportApplicationHandler.ForwardSupportApplication,
+			id:   "1",
+			role: domain.RoleMunicipality,
+			body: `{}`,
+			mockSetup: func(mockUseCase *mockusecase.MockSupportApplicationUseCase) {
+				mockUseCase.EXPECT().
+					TransitionSupportApplication(gomock.Any(), 1, usecase.SupportApplicationActionForward, domain.RoleMunicipality, nil).
+					Return(expectedSupportApplicationModel(domain.SupportApplicationStatusPrefectureReview), nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "Success/承認",
+			call: handler.SupportApplicationHandler.ApproveSupportApplication,
+			id:   "1",
+			role: domain.RolePrefecture,
+			body: `{}`,
+			mockSetup: func(mockUseCase *mockusecase.MockSupportApplicationUseCase) {
+				mockUseCase.EXPECT().
+					TransitionSupportApplication(gomock.Any(), 1, usecase.SupportApplicationActionApprove, domain.RolePrefecture, nil).
+					Return(expectedSupportApplicationModel(domain.SupportApplicationStatusApproved), nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "Success/却下",
+			call: handler.SupportApplicationHandler.RejectSupportApplication,
+			id:   "1",
+			role: domain.RolePrefecture,
+			body: `{"comment":"書類に不備があります"}`,
+			mockSetup: func(mockUseCase *mockusecase.MockSupportApplicationUseCase) {
+				mockUseCase.EXPECT().
+					TransitionSupportApplication(gomock.Any(), 1, usecase.SupportApplicationActionReject, domain.RolePrefecture, &comment).
+					Return(expectedSupportApplicationModel(domain.SupportApplicationStatusRejected), nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "Success/差戻し",
+			call: handler.SupportApplicationHandler.ReturnSupportApplication,
+			id:   "1",
+			role: domain.RoleMunicipality,
+			body: `{"comment":"書類に不備があります"}`,
+			mockSetup: func(mockUseCase *mockusecase.MockSupportApplicationUseCase) {
+				mockUseCase.EXPECT().
+					TransitionSupportApplication(gomock.Any(), 1, usecase.SupportApplicationActionReturn, domain.RoleMunicipality, &comment).
+					Return(expectedSupportApplicationModel(domain.SupportApplicationStatusReturned), nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "Success/ボディがない場合はコメントなし",
+			call: handler.SupportApplicationHandler.SubmitSupportApplication,
+			id:   "1",
+			role: domain.RoleApplicant,
+			body: "",
+			mockSetup: func(mockUseCase *mockusecase.MockSupportApplicationUseCase) {
+				mockUseCase.EXPECT().
+					TransitionSupportApplication(gomock.Any(), 1, usecase.SupportApplicationActionSubmit, domain.RoleApplicant, nil).
+					Return(expectedSupportApplicationModel(domain.SupportApplicationStatusSubmitted), nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "Validation Error/役割がない",
+			call:       handler.SupportApplicationHandler.SubmitSupportApplication,
+			id:         "1",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+			wantDetails: []handler.ValidationError{
+				{Attribute: "役割", Tag: "required", Message: "役割は必須フィールドです"},
+			},
+		},
+		{
+			name:       "Validation Error/未知の役割",
+			call:       handler.SupportApplicationHandler.AcceptSupportApplication,
+			id:         "1",
+			role:       "admin",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+			wantDetails: []handler.ValidationError{
+				{Attribute: "役割", Tag: "oneof", Message: "役割は[applicant municipality prefecture]のうちのいずれかでなければなりません"},
+			},
+		},
+		{
+			name:       "Validation Error/ボディが壊れている",
+			call:       handler.SupportApplicationHandler.SubmitSupportApplication,
+			id:         "1",
+			role:       domain.RoleApplicant,
+			body:       `{"comment":`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Invalid ID",
+			call:       handler.SupportApplicationHandler.SubmitSupportApplication,
+			id:         "abc",
+			role:       domain.RoleApplicant,
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Validation Error/コメントが長すぎる",
+			call:       handler.SupportApplicationHandler.ReturnSupportApplication,
+			id:         "1",
+			role:       domain.RoleMunicipality,
+			body:       `{"comment":"` + strings.Repeat("あ", 501) + `"}`,
+			wantStatus: http.StatusBadRequest,
+			wantDetails: []handler.ValidationError{
+				{Attribute: "コメント", Tag: "max", Message: "コメントの長さは最大でも500文字でなければなりません"},
+			},
+		},
+		{
+			name: "Invalid State Transition",
+			call: handler.SupportApplicationHandler.ApproveSupportApplication,
+			id:   "1",
+			role: domain.RolePrefecture,
+			body: `{}`,
+			mockSetup: func(mockUseCase *mockusecase.MockSupportApplicationUseCase) {
+				mockUseCase.EXPECT().
+					TransitionSupportApplication(gomock.Any(), 1, usecase.SupportApplicationActionApprove, domain.RolePrefecture, nil).
+					Return(nil, &myerrors.APIError{
+						Code:    myerrors.InvalidStateTransitionError,
+						Message: myerrors.InvalidStateTransitionErrorMessage,
+					})
+			},
+			wantStatus: http.StatusConflict,
+			wantBody: func() string {
+				return `{"code":"E100008","message":"現在の状態ではこの操作はできません"}`
+			},
+		},
+		{
+			name: "Transition Forbidden",
+			call: handler.SupportApplicationHandler.AcceptSupportApplication,
+			id:   "1",
+			role: domain.RoleApplicant,
+			body: `{}`,
+			mockSetup: func(mockUseCase *mockusecase.MockSupportApplicationUseCase) {
+				mockUseCase.EXPECT().
+					TransitionSupportApplication(gomock.Any(), 1, usecase.SupportApplicationActionAccept, domain.RoleApplicant, nil).
+					Return(nil, &myerrors.APIError{
+						Code:    myerrors.TransitionForbiddenError,
+						Message: myerrors.TransitionForbiddenErrorMessage,
+					})
+			},
+			wantStatus: http.StatusForbidden,
+			wantBody: func() string {
+				return `{"code":"E100009","message":"この操作を行う権限がありません"}`
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodPost, "/support-applications/"+tt.id, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+			if tt.role != "" {
+				c.Set(handler.UserRoleKey, tt.role)
+			}
+			c.Params = []gin.Param{
+				{
+					Key:   "id",
+					Value: tt.id,
+				},
+			}
+
+			uc := mockusecase.NewMockSupportApplicationUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			tt.call(handler.NewSupportApplicationHandler(appLogger, uc, testCursorCodec), c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+
+			if tt.wantBody != nil {
+				wantBody := tt.wantBody()
+				resBody := rec.Body.String()
+				if !cmp.Equal(wantBody, resBody) {
+					t.Errorf("diff: %s", cmp.Diff(wantBody, resBody))
+				}
+			}
+
+			if tt.wantDetails != nil {
+				var res handler.ErrorResponseDetail
+				a.NoError(json.Unmarshal(rec.Body.Bytes(), &res))
+				a.Equal(myerrors.ValidationError, res.Code)
+				if !cmp.Equal(tt.wantDetails, res.Details) {
+					t.Errorf("diff: %s", cmp.Diff(tt.wantDetails, res.Details))
+				}
+			}
+		})
+	}
+}
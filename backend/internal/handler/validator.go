@@ -15,6 +15,8 @@ import (
 	jatranslations "github.com/go-playground/validator/v10/translations/ja"
 
 	myerrors "g_gen/internal/errors"
+	"g_gen/internal/orgcode"
+	"g_gen/internal/pagination"
 )
 
 const (
@@ -23,7 +25,10 @@ const (
 	maximumLength         = "20"
 	passwordTag           = "password"
 	datetimeTag           = "datetime"
+	dateTag               = "date"
 	alphaNumUnderscoreTag = "alphanum_underscore"
+	organizationCodeTag   = "organization_code"
+	sortTag               = "sort"
 )
 
 var (
@@ -77,6 +82,14 @@ func init() {
 		return t
 	})
 
+	_ = validate.RegisterValidation(dateTag, isDateString)
+	_ = validate.RegisterTranslation(dateTag, jatrans, func(ut ut.Translator) error {
+		return ut.Add(dateTag, "{0}は日付（YYYY-MM-DD）形式である必要があります", true)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		t, _ := ut.T(dateTag, fe.Field())
+		return t
+	})
+
 	// 半角英数と_のみを許容するバリデーションを登録
 	_ = validate.RegisterValidation(alphaNumUnderscoreTag, validateAlphaNumUnderscore)
 	_ = validate.RegisterTranslation(alphaNumUnderscoreTag, jatrans, func(ut ut.Translator) error {
@@ -85,6 +98,24 @@ func init() {
 		t, _ := ut.T(alphaNumUnderscoreTag, fe.Field())
 		return t
 	})
+
+	// 団体コード（チェックディジット、都道府県コードとの整合性）のバリデーションを登録
+	_ = validate.RegisterValidation(organizationCodeTag, validateOrganizationCode)
+	_ = validate.RegisterTranslation(organizationCodeTag, jatrans, func(ut ut.Translator) error {
+		return ut.Add(organizationCodeTag, "{0}は正しい団体コードである必要があります", true)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		t, _ := ut.T(organizationCodeTag, fe.Field())
+		return t
+	})
+
+	// 一覧取得のソート条件（field,-field 形式）のバリデーションを登録
+	_ = validate.RegisterValidation(sortTag, validateSort)
+	_ = validate.RegisterTranslation(sortTag, jatrans, func(ut ut.Translator) error {
+		return ut.Add(sortTag, "{0}はカンマ区切りのフィールド名（降順は先頭に-）である必要があります", true)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		t, _ := ut.T(sortTag, fe.Field())
+		return t
+	})
 }
 
 // 日付時刻のバリデーション
@@ -93,6 +124,12 @@ func isDateTimeString(fl validator.FieldLevel) bool {
 	return err == nil
 }
 
+// 日付のバリデーション
+func isDateString(fl validator.FieldLevel) bool {
+	_, err := time.Parse(time.DateOnly, fl.Field().String())
+	return err == nil
+}
+
 func createValidateErrorResponse(err error) *ErrorResponseDetail {
 	var verr validator.ValidationErrors
 	errors.As(err, &verr)
@@ -152,6 +189,22 @@ func validateVcsFormat(fl validator.FieldLevel) bool {
 	return ok
 }
 
+// validateOrganizationCode 団体コードのチェックディジットを検証する
+func validateOrganizationCode(fl validator.FieldLevel) bool {
+	code := fl.Field().String()
+	if code == "" {
+		return true // 空文字は他のバリデーション（required等）に任せる
+	}
+
+	return orgcode.Validate(code) == nil
+}
+
+// validateSort 一覧取得のソート条件の書式を検証する。フィールド名が利用可能かどうかはデータストア層で検証する
+func validateSort(fl validator.FieldLevel) bool {
+	_, err := pagination.ParseSort(fl.Field().String())
+	return err == nil
+}
+
 func validateAlphaNumUnderscore(fl validator.FieldLevel) bool {
 	value := fl.Field().String()
 	if value == "" {
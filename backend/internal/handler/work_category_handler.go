@@ -0,0 +1,273 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"g_gen/internal/domain/model"
+	"g_gen/internal/infra/logger"
+	"g_gen/internal/pagination"
+	"g_gen/internal/usecase"
+)
+
+type workCategoryHandler struct {
+	appLogger           *logger.Logger
+	workCategoryUseCase usecase.WorkCategoryUseCase
+	cursorCodec         *pagination.CursorCodec
+}
+
+func NewWorkCategoryHandler(
+	l *logger.Logger,
+	workCategoryUseCase usecase.WorkCategoryUseCase,
+	cursorCodec *pagination.CursorCodec,
+) WorkCategoryHandler {
+	return &workCategoryHandler{
+		appLogger:           l,
+		workCategoryUseCase: workCategoryUseCase,
+		cursorCodec:         cursorCodec,
+	}
+}
+
+// ListWorkCategories @title 工種区分一覧取得
+// @id ListWorkCategories
+// @tags work-categories
+// @accept json
+// @produce json
+// @Summary 工種区分一覧取得
+// @Param page query int false "ページ番号（既定1）"
+// @Param per_page query int false "1ページあたりの件数（既定20、最大100）"
+// @Param sort query string false "ソート条件（id, sort_order。降順は先頭に-）"
+// @Param cursor query string false "前のレスポンスのnext_cursor。指定した場合はpageを無視してカーソルの位置から取得"
+// @Success 200 {object} WorkCategoryListResponse
+// @Header 200 {string} Link "前後のページへのリンク"
+// @Header 200 {integer} X-Total-Count "総件数"
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 有効な工種区分の一覧をページ単位で取得します。並び順の指定がない場合は表示順序順です。
+// @Router /work-categories [get]
+func (h *workCategoryHandler) ListWorkCategories(c *gin.Context) {
+	var query ListWorkCategoriesQueryParams
+	if err := c.ShouldBindQuery(&query); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid work category list request")
+
+		return
+	}
+
+	params, err := toPaginationParams(c, &PaginationRequest{
+		Page:    query.Page,
+		PerPage: query.PerPage,
+		Sort:    query.Sort,
+		Cursor:  query.Cursor,
+	}, h.cursorCodec)
+	if err != nil {
+		handleError(c, err, h.appLogger, "invalid work category list cursor")
+
+		return
+	}
+
+	workCategories, meta, err := h.workCategoryUseCase.ListWorkCategories(c.Request.Context(), params)
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to list work categories")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, &WorkCategoryListResponse{
+		Items:      toWorkCategoryResponses(workCategories),
+		Pagination: writePaginationHeaders(c, meta, h.cursorCodec),
+	})
+}
+
+// GetWorkCategory @title 工種区分詳細取得
+// @id GetWorkCategory
+// @tags work-categories
+// @accept json
+// @produce json
+// @Param id path int true "工種区分ID"
+// @Summary 工種区分詳細取得
+// @Success 200 {object} WorkCategoryResponse
+// @Header 200 {string} ETag "レスポンスの強いETag（If-None-Matchに一致する場合は304を返す）"
+// @Header 200 {string} Last-Modified "更新日時（If-Modified-Sinceより後に更新されていない場合は304を返す）"
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 工種区分IDを指定して、工種区分を取得します。
+// @Router /work-categories/{id} [get]
+func (h *workCategoryHandler) GetWorkCategory(c *gin.Context) {
+	var uri GetWorkCategoryPathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid work category id")
+
+		return
+	}
+
+	workCategory, err := h.workCategoryUseCase.GetWorkCategory(c.Request.Context(), uri.ID)
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to get work category")
+
+		return
+	}
+
+	setLastModified(c, workCategory.UpdatedAt)
+	c.JSON(http.StatusOK, toWorkCategoryResponse(workCategory))
+}
+
+// CreateWorkCategory @title 工種区分登録
+// @id CreateWorkCategory
+// @tags work-categories
+// @accept json
+// @produce json
+// @Param request body CreateWorkCategoryRequest true "工種区分"
+// @Summary 工種区分登録
+// @Success 201 {object} WorkCategoryResponse
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 500 {object} ErrorResponse
+// @Description 工種区分を登録します。
+// @Router /work-categories [post]
+func (h *workCategoryHandler) CreateWorkCategory(c *gin.Context) {
+	var req CreateWorkCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid work category request")
+
+		return
+	}
+
+	workCategory, err := h.workCategoryUseCase.CreateWorkCategory(c.Request.Context(), &model.WorkCategory{
+		CategoryName: req.CategoryName,
+		IconName:     req.IconName,
+		SortOrder:    *req.SortOrder,
+	})
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to create work category")
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, toWorkCategoryResponse(workCategory))
+}
+
+// UpdateWorkCategory @title 工種区分更新
+// @id UpdateWorkCategory
+// @tags work-categories
+// @accept json
+// @produce json
+// @Param id path int true "工種区分ID"
+// @Param request body UpdateWorkCategoryRequest true "工種区分"
+// @Summary 工種区分更新
+// @Success 200 {object} WorkCategoryResponse
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 工種区分の名称・アイコン・表示順序を更新します。
+// @Router /work-categories/{id} [put]
+func (h *workCategoryHandler) UpdateWorkCategory(c *gin.Context) {
+	var uri UpdateWorkCategoryPathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid work category id")
+
+		return
+	}
+
+	var req UpdateWorkCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid work category request")
+
+		return
+	}
+
+	workCategory, err := h.workCategoryUseCase.UpdateWorkCategory(c.Request.Context(), &model.WorkCategory{
+		ID:           int64(uri.ID),
+		CategoryName: req.CategoryName,
+		IconName:     req.IconName,
+		SortOrder:    *req.SortOrder,
+	})
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to update work category")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, toWorkCategoryResponse(workCategory))
+}
+
+// DeactivateWorkCategory @title 工種区分無効化
+// @id DeactivateWorkCategory
+// @tags work-categories
+// @accept json
+// @produce json
+// @Param id path int true "工種区分ID"
+// @Summary 工種区分無効化
+// @Success 200 {object} EmptyResponse
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Description 工種区分を無効化します。データは削除されず一覧に表示されなくなります。
+// @Router /work-categories/{id} [delete]
+func (h *workCategoryHandler) DeactivateWorkCategory(c *gin.Context) {
+	var uri DeactivateWorkCategoryPathParams
+	if err := c.ShouldBindUri(&uri); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid work category id")
+
+		return
+	}
+
+	if err := h.workCategoryUseCase.DeactivateWorkCategory(c.Request.Context(), uri.ID); err != nil {
+		handleError(c, err, h.appLogger, "failed to deactivate work category")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, EmptyResponse{})
+}
+
+// ReorderWorkCategories @title 工種区分並び替え
+// @id ReorderWorkCategories
+// @tags work-categories
+// @accept json
+// @produce json
+// @Param request body ReorderWorkCategoriesRequest true "表示順に並べた有効な工種区分IDの一覧"
+// @Summary 工種区分並び替え
+// @Success 200 {array} WorkCategoryResponse
+// @Failure 400 {object} ErrorResponseDetail
+// @Failure 500 {object} ErrorResponse
+// @Description 有効な工種区分全体の表示順序を、指定されたIDの順に一括で更新します。
+// @Router /work-categories/sort-order [put]
+func (h *workCategoryHandler) ReorderWorkCategories(c *gin.Context) {
+	var req ReorderWorkCategoriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		handleValidationError(c, err, h.appLogger, "invalid work category order request")
+
+		return
+	}
+
+	workCategories, err := h.workCategoryUseCase.ReorderWorkCategories(c.Request.Context(), req.IDs)
+	if err != nil {
+		handleError(c, err, h.appLogger, "failed to reorder work categories")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, toWorkCategoryResponses(workCategories))
+}
+
+func toWorkCategoryResponse(w *model.WorkCategory) *WorkCategoryResponse {
+	return &WorkCategoryResponse{
+		ID:           w.ID,
+		CategoryName: w.CategoryName,
+		IconName:     w.IconName,
+		SortOrder:    w.SortOrder,
+		IsActive:     w.IsActive,
+	}
+}
+
+func toWorkCategoryResponses(workCategories []*model.WorkCategory) []*WorkCategoryResponse {
+	response := make([]*WorkCategoryResponse, len(workCategories))
+	for i, w := range workCategories {
+		response[i] = toWorkCategoryResponse(w)
+	}
+
+	return response
+}
@@ -0,0 +1,422 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"g_gen/internal/domain/model"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/handler"
+	"g_gen/internal/infra/logger"
+	"g_gen/internal/pagination"
+	mockusecase "g_gen/tests/mock/usecase"
+)
+
+func TestWorkCategoryHandler_ListWorkCategories(t *testing.T) {
+	params := pagination.NewParams(1, 20, nil, map[string]string{})
+	pagedParams := pagination.NewParams(2, 1, []pagination.Sort{{Field: "sort_order"}}, map[string]string{})
+
+	tests := []struct {
+		name       string
+		query      string
+		mockSetup  func(mockUseCase *mockusecase.MockWorkCategoryUseCase)
+		wantStatus int
+		wantBody   func() string
+	}{
+		{
+			name: "Success",
+			mockSetup: func(mockUseCase *mockusecase.MockWorkCategoryUseCase) {
+				mockUseCase.EXPECT().ListWorkCategories(gomock.Any(), params).Return([]*model.WorkCategory{
+					{ID: 1, CategoryName: "農地", SortOrder: 10, IsActive: true},
+					{ID: 2, CategoryName: "水路", SortOrder: 20, IsActive: true},
+				}, pagination.NewMeta(params, 2, nil), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				responseJSON, _ := json.Marshal(&handler.WorkCategoryListResponse{
+					Items: []*handler.WorkCategoryResponse{
+						{ID: 1, CategoryName: "農地", SortOrder: 10, IsActive: true},
+						{ID: 2, CategoryName: "水路", SortOrder: 20, IsActive: true},
+					},
+					Pagination: &handler.PaginationResponse{
+						Page:       1,
+						PerPage:    20,
+						TotalCount: 2,
+						TotalPages: 1,
+					},
+				})
+				return string(responseJSON)
+			},
+		},
+		{
+			name:  "Success/ページング・ソート",
+			query: "?page=2&per_page=1&sort=sort_order",
+			mockSetup: func(mockUseCase *mockusecase.MockWorkCategoryUseCase) {
+				mockUseCase.EXPECT().ListWorkCategories(gomock.Any(), pagedParams).Return([]*model.WorkCategory{
+					{ID: 2, CategoryName: "水路", SortOrder: 20, IsActive: true},
+				}, pagination.NewMeta(pagedParams, 2, nil), nil)
+			},
+			wantStatus: http.StatusOK,
+			wantBody: func() string {
+				responseJSON, _ := json.Marshal(&handler.WorkCategoryListResponse{
+					Items: []*handler.WorkCategoryResponse{
+						{ID: 2, CategoryName: "水路", SortOrder: 20, IsActive: true},
+					},
+					Pagination: &handler.PaginationResponse{
+						Page:       2,
+						PerPage:    1,
+						TotalCount: 2,
+						TotalPages: 2,
+					},
+				})
+				return string(responseJSON)
+			},
+		},
+		{
+			name:       "Invalid Per Page",
+			query:      "?per_page=101",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   nil,
+		},
+		{
+			name: "Error",
+			mockSetup: func(mockUseCase *mockusecase.MockWorkCategoryUseCase) {
+				mockUseCase.EXPECT().ListWorkCategories(gomock.Any(), params).Return(nil, pagination.Meta{}, errors.New("database error"))
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodGet, "/work-categories"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+
+			uc := mockusecase.NewMockWorkCategoryUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			mockHandler := handler.NewWorkCategoryHandler(appLogger, uc, testCursorCodec)
+			mockHandler.ListWorkCategories(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+
+			if tt.wantBody != nil {
+				wantBody := tt.wantBody()
+				resBody := rec.Body.String()
+				if !cmp.Equal(wantBody, resBody) {
+					t.Errorf("diff: %s", cmp.Diff(wantBody, resBody))
+				}
+			}
+		})
+	}
+}
+
+func TestWorkCategoryHandler_CreateWorkCategory(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		mockSetup   func(mockUseCase *mockusecase.MockWorkCategoryUseCase)
+		wantStatus  int
+		wantDetails []handler.ValidationError
+	}{
+		{
+			name: "Success",
+			body: `{"category_name":"護岸","icon_name":"revetment.svg","sort_order":100}`,
+			mockSetup: func(mockUseCase *mockusecase.MockWorkCategoryUseCase) {
+				mockUseCase.EXPECT().CreateWorkCategory(gomock.Any(), &model.WorkCategory{
+					CategoryName: "護岸",
+					IconName:     "revetment.svg",
+					SortOrder:    100,
+				}).Return(&model.WorkCategory{
+					ID:           10,
+					CategoryName: "護岸",
+					IconName:     "revetment.svg",
+					SortOrder:    100,
+					IsActive:     true,
+				}, nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "Validation Error/必須項目なし",
+			body:       `{"icon_name":"revetment.svg"}`,
+			wantStatus: http.StatusBadRequest,
+			wantDetails: []handler.ValidationError{
+				{Attribute: "工種区分名", Tag: "required", Message: "工種区分名は必須フィールドです"},
+				{Attribute: "表示順序", Tag: "required", Message: "表示順序は必須フィールドです"},
+			},
+		},
+		{
+			name:       "Validation Error/文字数超過",
+			body:       `{"category_name":"あいうえおかきくけこさしすせそたちつてとな","sort_order":0}`,
+			wantStatus: http.StatusBadRequest,
+			wantDetails: []handler.ValidationError{
+				{Attribute: "工種区分名", Tag: "max", Message: "工種区分名の長さは最大でも20文字でなければなりません"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodPost, "/work-categories", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+
+			uc := mockusecase.NewMockWorkCategoryUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			mockHandler := handler.NewWorkCategoryHandler(appLogger, uc, testCursorCodec)
+			mockHandler.CreateWorkCategory(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+
+			if tt.wantDetails != nil {
+				var res handler.ErrorResponseDetail
+				a.NoError(json.Unmarshal(rec.Body.Bytes(), &res))
+				a.Equal(myerrors.ValidationError, res.Code)
+				if !cmp.Equal(tt.wantDetails, res.Details) {
+					t.Errorf("diff: %s", cmp.Diff(tt.wantDetails, res.Details))
+				}
+			}
+		})
+	}
+}
+
+func TestWorkCategoryHandler_UpdateWorkCategory(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		body       string
+		mockSetup  func(mockUseCase *mockusecase.MockWorkCategoryUseCase)
+		wantStatus int
+	}{
+		{
+			name: "Success",
+			id:   "1",
+			body: `{"category_name":"農地（更新）","icon_name":"","sort_order":15}`,
+			mockSetup: func(mockUseCase *mockusecase.MockWorkCategoryUseCase) {
+				mockUseCase.EXPECT().UpdateWorkCategory(gomock.Any(), &model.WorkCategory{
+					ID:           1,
+					CategoryName: "農地（更新）",
+					SortOrder:    15,
+				}).Return(&model.WorkCategory{ID: 1, CategoryName: "農地（更新）", SortOrder: 15, IsActive: true}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "Invalid ID",
+			id:         "abc",
+			body:       `{"category_name":"農地","sort_order":15}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Not Found",
+			id:   "999",
+			body: `{"category_name":"農地","sort_order":15}`,
+			mockSetup: func(mockUseCase *mockusecase.MockWorkCategoryUseCase) {
+				mockUseCase.EXPECT().UpdateWorkCategory(gomock.Any(), gomock.Any()).Return(nil, &myerrors.APIError{
+					Code:    myerrors.WorkCategoryNotFoundError,
+					Message: myerrors.WorkCategoryNotFoundErrorMessage,
+				})
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodPut, "/work-categories/"+tt.id, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+			c.Params = []gin.Param{
+				{
+					Key:   "id",
+					Value: tt.id,
+				},
+			}
+
+			uc := mockusecase.NewMockWorkCategoryUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			mockHandler := handler.NewWorkCategoryHandler(appLogger, uc, testCursorCodec)
+			mockHandler.UpdateWorkCategory(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestWorkCategoryHandler_DeactivateWorkCategory(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		mockSetup  func(mockUseCase *mockusecase.MockWorkCategoryUseCase)
+		wantStatus int
+	}{
+		{
+			name: "Success",
+			id:   "1",
+			mockSetup: func(mockUseCase *mockusecase.MockWorkCategoryUseCase) {
+				mockUseCase.EXPECT().DeactivateWorkCategory(gomock.Any(), 1).Return(nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "Not Found",
+			id:   "999",
+			mockSetup: func(mockUseCase *mockusecase.MockWorkCategoryUseCase) {
+				mockUseCase.EXPECT().DeactivateWorkCategory(gomock.Any(), 999).Return(&myerrors.APIError{
+					Code:    myerrors.WorkCategoryNotFoundError,
+					Message: myerrors.WorkCategoryNotFoundErrorMessage,
+				})
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodDelete, "/work-categories/"+tt.id, nil)
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+			c.Params = []gin.Param{
+				{
+					Key:   "id",
+					Value: tt.id,
+				},
+			}
+
+			uc := mockusecase.NewMockWorkCategoryUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			mockHandler := handler.NewWorkCategoryHandler(appLogger, uc, testCursorCodec)
+			mockHandler.DeactivateWorkCategory(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestWorkCategoryHandler_ReorderWorkCategories(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		mockSetup  func(mockUseCase *mockusecase.MockWorkCategoryUseCase)
+		wantStatus int
+	}{
+		{
+			name: "Success",
+			body: `{"ids":[2,1]}`,
+			mockSetup: func(mockUseCase *mockusecase.MockWorkCategoryUseCase) {
+				mockUseCase.EXPECT().ReorderWorkCategories(gomock.Any(), []int{2, 1}).Return([]*model.WorkCategory{
+					{ID: 2, CategoryName: "水路", SortOrder: 10, IsActive: true},
+					{ID: 1, CategoryName: "農地", SortOrder: 20, IsActive: true},
+				}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "Validation Error/ID重複",
+			body:       `{"ids":[1,1]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Validation Error/空配列",
+			body:       `{"ids":[]}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Validation Error/有効な工種区分と不一致",
+			body: `{"ids":[1]}`,
+			mockSetup: func(mockUseCase *mockusecase.MockWorkCategoryUseCase) {
+				mockUseCase.EXPECT().ReorderWorkCategories(gomock.Any(), []int{1}).Return(nil, myerrors.NewAPIError(
+					myerrors.ValidationError,
+					myerrors.ValidationErrorMessage,
+					errors.New("expected 2 work category ids, got 1"),
+					"invalid work category order",
+				))
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			appLogger := logger.New(logger.DefaultConfig())
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			req := httptest.NewRequest(http.MethodPut, "/work-categories/sort-order", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = req
+
+			uc := mockusecase.NewMockWorkCategoryUseCase(ctrl)
+			if tt.mockSetup != nil {
+				tt.mockSetup(uc)
+			}
+
+			mockHandler := handler.NewWorkCategoryHandler(appLogger, uc, testCursorCodec)
+			mockHandler.ReorderWorkCategories(c)
+
+			a.Equal(tt.wantStatus, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,285 @@
+package importer
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"g_gen/internal/domain/model"
+	"g_gen/internal/infra/cache"
+	"g_gen/internal/infra/datastore"
+	"g_gen/internal/infra/db"
+	"g_gen/internal/infra/logger"
+	"g_gen/internal/orgcode"
+)
+
+// CSVRecord CSVの1行を表現する構造体
+type CSVRecord struct {
+	OrganizationCode      string
+	PrefectureNameKanji   string
+	MunicipalityNameKanji string
+	PrefectureNameKana    string
+	MunicipalityNameKana  string
+}
+
+// MunicipalityImporter 市町村データインポーター
+type MunicipalityImporter struct {
+	db     *gorm.DB
+	logger *logger.Logger
+	// prefectureCodes 都道府県名（漢字）から都道府県コードへの対応
+	prefectureCodes map[string]string
+}
+
+// NewMunicipalityImporter コンストラクタ
+func NewMunicipalityImporter(ctx context.Context, db db.Client, l *logger.Logger) *MunicipalityImporter {
+	return &MunicipalityImporter{db: db.Conn(ctx), logger: l}
+}
+
+// Import ファイルから市町村データをインポート
+// 団体コードをキーに追加・更新し、ファイルに存在しない市区町村は削除せずに無効化する（何度実行しても同じ結果になる）
+// 検証に失敗した行の団体コードは、ファイルに存在しないとはみなさず無効化しない
+func (m *MunicipalityImporter) Import(source Source) (*ImportSummary, error) {
+	municipalities, skippedCodes, err := m.readSource(source)
+	if err != nil {
+		return nil, err
+	}
+
+	// 団体コードをキーにデータベースへ反映
+	return m.upsert(municipalities, skippedCodes)
+}
+
+// Diff ファイルをインポートした場合の現在のテーブルとの差分を返す
+// データベースは読み取りのみで、更新しない
+func (m *MunicipalityImporter) Diff(source Source) (*DiffReport, error) {
+	municipalities, skippedCodes, err := m.readSource(source)
+	if err != nil {
+		return nil, err
+	}
+
+	var existing []*model.Municipality
+	if err := m.db.Find(&existing).Error; err != nil {
+		return nil, fmt.Errorf("既存データの取得に失敗しました: %w", err)
+	}
+
+	return NewDiffReport(existing, municipalities, skippedCodes), nil
+}
+
+// readSource ファイルを読み込み、検証済みの市区町村と、検証に失敗してスキップした行の団体コードを返す
+// 都道府県レベルの行（市区町村名が漢字・カナとも空）と重複した行もスキップするが、団体コードは返さない
+func (m *MunicipalityImporter) readSource(source Source) ([]*model.Municipality, []string, error) {
+	if err := m.loadPrefectureCodes(); err != nil {
+		return nil, nil, err
+	}
+
+	rows, err := readSourceRows(source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var municipalities []*model.Municipality
+	var skippedCodes []string
+	skippedCount := 0
+	// seenCodes 団体コードごとの最初の行番号（同じ団体コードを1回のupsertに含めるとエラーになるため）
+	seenCodes := make(map[string]int)
+
+	for _, row := range rows {
+		lineNum := row.lineNum
+		csvRecord := row.record
+
+		// 都道府県レベルの行はスキップ。漢字・カナの一方だけが空の行は検証でスキップする
+		if csvRecord.MunicipalityNameKanji == "" && csvRecord.MunicipalityNameKana == "" {
+			m.logger.Info("市区町村名が空のためスキップしました", "line", lineNum, "organization_code", csvRecord.OrganizationCode)
+			skippedCount++
+			continue
+		}
+
+		// データの検証
+		if err := m.validateCSVRecord(csvRecord, lineNum); err != nil {
+			m.logger.Warn("検証に失敗したためスキップしました", "line", lineNum, "error", err)
+			skippedCount++
+			skippedCodes = appendSkippedCode(skippedCodes, csvRecord.OrganizationCode)
+			continue
+		}
+
+		if firstLine, ok := seenCodes[csvRecord.OrganizationCode]; ok {
+			m.logger.Warn("団体コードが重複しているためスキップしました", "line", lineNum, "first_line", firstLine, "organization_code", csvRecord.OrganizationCode)
+			skippedCount++
+			continue
+		}
+		seenCodes[csvRecord.OrganizationCode] = lineNum
+
+		municipality, err := m.convertToMunicipality(csvRecord)
+		if err != nil {
+			m.logger.Warn("データを変換できないためスキップしました", "line", lineNum, "error", err)
+			skippedCount++
+			skippedCodes = appendSkippedCode(skippedCodes, csvRecord.OrganizationCode)
+			continue
+		}
+
+		municipalities = append(municipalities, municipality)
+	}
+
+	if len(municipalities) == 0 {
+		return nil, nil, fmt.Errorf("インポート可能なデータがありませんでした")
+	}
+
+	m.logger.Info("ファイルを読み込みました", "valid", len(municipalities), "skipped", skippedCount)
+
+	return municipalities, skippedCodes, nil
+}
+
+// appendSkippedCode スキップした行の団体コードを重複なく追加する。団体コードが空の行は無効化の対象と突き合わせられないため除く
+func appendSkippedCode(codes []string, code string) []string {
+	if code == "" || slices.Contains(codes, code) {
+		return codes
+	}
+
+	return append(codes, code)
+}
+
+// validateCSVRecord CSVレコードのバリデーション
+func (m *MunicipalityImporter) validateCSVRecord(record CSVRecord, lineNum int) error {
+	// 団体コードの検証
+	if record.OrganizationCode == "" {
+		return fmt.Errorf("団体コードが空です")
+	}
+
+	if err := orgcode.Validate(record.OrganizationCode); err != nil {
+		return fmt.Errorf("%w (団体コード: %s)", err, record.OrganizationCode)
+	}
+
+	// 都道府県名の検証
+	if record.PrefectureNameKanji == "" {
+		return fmt.Errorf("都道府県名（漢字）が空です")
+	}
+
+	prefectureCode, ok := m.prefectureCodes[record.PrefectureNameKanji]
+	if !ok {
+		return fmt.Errorf("都道府県マスタに存在しない都道府県名です: %s", record.PrefectureNameKanji)
+	}
+
+	if err := orgcode.ValidateWithPrefecture(record.OrganizationCode, prefectureCode); err != nil {
+		return fmt.Errorf("%w (団体コード: %s, 都道府県コード: %s)", err, record.OrganizationCode, prefectureCode)
+	}
+
+	if record.PrefectureNameKana == "" {
+		return fmt.Errorf("都道府県名（カナ）が空です")
+	}
+
+	// 市区町村名の検証（都道府県レベルの場合は空でも可）
+	if record.MunicipalityNameKanji == "" && record.MunicipalityNameKana != "" {
+		return fmt.Errorf("市区町村名（漢字）が空なのに（カナ）が設定されています")
+	}
+
+	if record.MunicipalityNameKanji != "" && record.MunicipalityNameKana == "" {
+		return fmt.Errorf("市区町村名（漢字）が設定されているのに（カナ）が空です")
+	}
+
+	return nil
+}
+
+// loadPrefectureCodes 都道府県マスタから都道府県名と都道府県コードの対応を読み込む
+func (m *MunicipalityImporter) loadPrefectureCodes() error {
+	var prefectures []*model.Prefecture
+	if err := m.db.Find(&prefectures).Error; err != nil {
+		return fmt.Errorf("都道府県マスタの取得に失敗しました: %w", err)
+	}
+
+	m.prefectureCodes = make(map[string]string, len(prefectures))
+	for _, p := range prefectures {
+		m.prefectureCodes[p.Name] = p.Code
+	}
+
+	return nil
+}
+
+// convertToMunicipality CSVレコードをMunicipalityモデルに変換
+func (m *MunicipalityImporter) convertToMunicipality(record CSVRecord) (*model.Municipality, error) {
+	// 都道府県コードは団体コードの上2桁
+	prefectureCode := orgcode.PrefectureCode(record.OrganizationCode)
+
+	// 市区町村名が空の場合は都道府県レベルのデータ
+	municipalityNameKanji := record.MunicipalityNameKanji
+	municipalityNameKana := record.MunicipalityNameKana
+
+	// 都道府県レベルの場合は空文字列をセット
+	if municipalityNameKanji == "" {
+		municipalityNameKanji = ""
+	}
+	if municipalityNameKana == "" {
+		municipalityNameKana = ""
+	}
+
+	return &model.Municipality{
+		PrefectureCode:        prefectureCode,
+		OrganizationCode:      record.OrganizationCode,
+		PrefectureNameKanji:   record.PrefectureNameKanji,
+		MunicipalityNameKanji: municipalityNameKanji,
+		PrefectureNameKana:    record.PrefectureNameKana,
+		MunicipalityNameKana:  municipalityNameKana,
+		IsActive:              true,
+	}, nil
+}
+
+// upsert 団体コードをキーに追加・更新し、CSVに存在しない市区町村を無効化する
+// 市区町村のIDは変わらないため、市区町村を参照するテーブルがあっても実行できる
+func (m *MunicipalityImporter) upsert(municipalities []*model.Municipality, skippedCodes []string) (*ImportSummary, error) {
+	const batchSize = 100
+
+	var summary *ImportSummary
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		// 同時に実行された別のインポートと突き合わせ結果が食い違わないように、既存の行をロックして取得する
+		var existing []*model.Municipality
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Find(&existing).Error; err != nil {
+			return fmt.Errorf("既存データの取得に失敗しました: %w", err)
+		}
+
+		plan := planUpsert(existing, municipalities, skippedCodes)
+
+		upserts := make([]*model.Municipality, 0, len(plan.inserts)+len(plan.updates))
+		upserts = append(upserts, plan.inserts...)
+		upserts = append(upserts, plan.updates...)
+		if len(upserts) > 0 {
+			err := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "organization_code"}},
+				DoUpdates: clause.AssignmentColumns([]string{
+					"prefecture_code",
+					"prefecture_name_kanji",
+					"municipality_name_kanji",
+					"prefecture_name_kana",
+					"municipality_name_kana",
+					"is_active",
+				}),
+			}).CreateInBatches(&upserts, batchSize).Error
+			if err != nil {
+				return fmt.Errorf("追加・更新に失敗しました: %w", err)
+			}
+		}
+
+		if len(plan.deactivations) > 0 {
+			err := tx.Model(&model.Municipality{}).
+				Where("organization_code IN ?", plan.deactivations).
+				Update("is_active", false).Error
+			if err != nil {
+				return fmt.Errorf("無効化に失敗しました: %w", err)
+			}
+		}
+
+		// 都道府県詳細は市区町村を含むため、起動中のAPIサーバーに都道府県のキャッシュの破棄を通知する（コミット時に送られる）
+		if err := cache.Notify(context.Background(), tx, datastore.PrefectureCacheName); err != nil {
+			return fmt.Errorf("キャッシュの破棄の通知に失敗しました: %w", err)
+		}
+
+		summary = plan.summary()
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
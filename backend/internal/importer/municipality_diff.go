@@ -0,0 +1,159 @@
+package importer
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+
+	"g_gen/internal/domain/model"
+)
+
+// DiffType 差分の種類
+type DiffType string
+
+const (
+	// DiffAdded 新しい団体コード
+	DiffAdded DiffType = "added"
+	// DiffRenamed 市区町村名・都道府県名（漢字）の変更
+	DiffRenamed DiffType = "renamed"
+	// DiffKanaChanged 市区町村名・都道府県名（カナ）の変更
+	DiffKanaChanged DiffType = "kana_changed"
+	// DiffPrefectureChanged 都道府県コードの変更
+	DiffPrefectureChanged DiffType = "prefecture_changed"
+	// DiffReactivated 無効化されていた団体コードがCSVに再び現れた
+	DiffReactivated DiffType = "reactivated"
+	// DiffRemoved CSVから消える（無効化される）団体コード
+	DiffRemoved DiffType = "removed"
+	// DiffSkipped 検証に失敗したためスキップする（追加・更新も無効化もしない）団体コード
+	DiffSkipped DiffType = "skipped"
+)
+
+// DiffEntry 差分の1行。変更された項目ごとに1行とする
+type DiffEntry struct {
+	Type             DiffType `json:"type"`
+	OrganizationCode string   `json:"organization_code"`
+	// MunicipalityName 市区町村名（漢字）。消える・スキップする団体コードは現在の名称（テーブルにない場合は空）、それ以外はCSVの名称
+	MunicipalityName string `json:"municipality_name"`
+	// Field 変更された項目の列名（追加・削除の場合は空）
+	Field  string `json:"field,omitempty"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+// DiffReport CSVをインポートした場合の現在のテーブルとの差分
+type DiffReport struct {
+	Summary *ImportSummary `json:"summary"`
+	Entries []*DiffEntry   `json:"entries"`
+}
+
+// NewDiffReport 現在のテーブルの市区町村とCSVの市区町村の差分を作成する。差分は団体コード順に並べる
+// skippedCodes はCSVの検証に失敗した行の団体コード
+func NewDiffReport(existing, municipalities []*model.Municipality, skippedCodes []string) *DiffReport {
+	plan := planUpsert(existing, municipalities, skippedCodes)
+
+	existingByCode := make(map[string]*model.Municipality, len(existing))
+	for _, e := range existing {
+		existingByCode[e.OrganizationCode] = e
+	}
+
+	entries := make([]*DiffEntry, 0, len(plan.inserts)+len(plan.updates)+len(plan.deactivations)+len(plan.skipped))
+	for _, m := range plan.inserts {
+		entries = append(entries, &DiffEntry{
+			Type:             DiffAdded,
+			OrganizationCode: m.OrganizationCode,
+			MunicipalityName: m.MunicipalityNameKanji,
+		})
+	}
+
+	for _, m := range plan.updates {
+		entries = append(entries, diffFields(existingByCode[m.OrganizationCode], m)...)
+	}
+
+	for _, code := range plan.deactivations {
+		entries = append(entries, &DiffEntry{
+			Type:             DiffRemoved,
+			OrganizationCode: code,
+			MunicipalityName: existingByCode[code].MunicipalityNameKanji,
+		})
+	}
+
+	for _, code := range plan.skipped {
+		entry := &DiffEntry{Type: DiffSkipped, OrganizationCode: code}
+		if e, ok := existingByCode[code]; ok {
+			entry.MunicipalityName = e.MunicipalityNameKanji
+		}
+		entries = append(entries, entry)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].OrganizationCode < entries[j].OrganizationCode
+	})
+
+	return &DiffReport{
+		Summary: plan.summary(),
+		Entries: entries,
+	}
+}
+
+// diffFields 更新される市区町村の変更された項目ごとの差分
+func diffFields(current, imported *model.Municipality) []*DiffEntry {
+	fields := []struct {
+		diffType DiffType
+		field    string
+		before   string
+		after    string
+	}{
+		{DiffPrefectureChanged, "prefecture_code", current.PrefectureCode, imported.PrefectureCode},
+		{DiffRenamed, "prefecture_name_kanji", current.PrefectureNameKanji, imported.PrefectureNameKanji},
+		{DiffRenamed, "municipality_name_kanji", current.MunicipalityNameKanji, imported.MunicipalityNameKanji},
+		{DiffKanaChanged, "prefecture_name_kana", current.PrefectureNameKana, imported.PrefectureNameKana},
+		{DiffKanaChanged, "municipality_name_kana", current.MunicipalityNameKana, imported.MunicipalityNameKana},
+		{DiffReactivated, "is_active", strconv.FormatBool(current.IsActive), strconv.FormatBool(imported.IsActive)},
+	}
+
+	var entries []*DiffEntry
+	for _, f := range fields {
+		if f.before == f.after {
+			continue
+		}
+
+		entries = append(entries, &DiffEntry{
+			Type:             f.diffType,
+			OrganizationCode: imported.OrganizationCode,
+			MunicipalityName: imported.MunicipalityNameKanji,
+			Field:            f.field,
+			Before:           f.before,
+			After:            f.after,
+		})
+	}
+
+	return entries
+}
+
+// WriteJSON 差分を件数とあわせてJSONで書き出す
+func (r *DiffReport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(r)
+}
+
+// WriteCSV 差分の各行をCSVで書き出す（件数は含めない）
+func (r *DiffReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"type", "organization_code", "municipality_name", "field", "before", "after"}); err != nil {
+		return err
+	}
+
+	for _, e := range r.Entries {
+		record := []string{string(e.Type), e.OrganizationCode, e.MunicipalityName, e.Field, e.Before, e.After}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
@@ -0,0 +1,104 @@
+package importer_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/domain/model"
+	"g_gen/internal/importer"
+)
+
+func TestNewDiffReport(t *testing.T) {
+	existing := []*model.Municipality{
+		{ID: 1, PrefectureCode: "01", OrganizationCode: "011002", PrefectureNameKanji: "北海道", MunicipalityNameKanji: "札幌市", PrefectureNameKana: "ﾎｯｶｲﾄﾞｳ", MunicipalityNameKana: "ｻｯﾎﾟﾛｼ", IsActive: true},
+		{ID: 2, PrefectureCode: "13", OrganizationCode: "131016", PrefectureNameKanji: "東京都", MunicipalityNameKanji: "千代田区", PrefectureNameKana: "ﾄｳｷｮｳﾄ", MunicipalityNameKana: "ﾁﾖﾀﾞｸ", IsActive: true},
+		{ID: 3, PrefectureCode: "13", OrganizationCode: "131024", PrefectureNameKanji: "東京都", MunicipalityNameKanji: "中央区", PrefectureNameKana: "ﾄｳｷｮｳﾄ", MunicipalityNameKana: "ﾁｭｳｵｳｸ", IsActive: true},
+		{ID: 4, PrefectureCode: "13", OrganizationCode: "131032", PrefectureNameKanji: "東京都", MunicipalityNameKanji: "港区", PrefectureNameKana: "ﾄｳｷｮｳﾄ", MunicipalityNameKana: "ﾐﾅﾄｸ", IsActive: false},
+		{ID: 5, PrefectureCode: "13", OrganizationCode: "131041", PrefectureNameKanji: "東京都", MunicipalityNameKanji: "新宿区", PrefectureNameKana: "ﾄｳｷｮｳﾄ", MunicipalityNameKana: "ｼﾝｼﾞｭｸｸ", IsActive: false},
+	}
+	municipalities := []*model.Municipality{
+		// 変更なし
+		{PrefectureCode: "01", OrganizationCode: "011002", PrefectureNameKanji: "北海道", MunicipalityNameKanji: "札幌市", PrefectureNameKana: "ﾎｯｶｲﾄﾞｳ", MunicipalityNameKana: "ｻｯﾎﾟﾛｼ", IsActive: true},
+		// 名称とカナの変更
+		{PrefectureCode: "13", OrganizationCode: "131016", PrefectureNameKanji: "東京都", MunicipalityNameKanji: "千代田市", PrefectureNameKana: "ﾄｳｷｮｳﾄ", MunicipalityNameKana: "ﾁﾖﾀﾞｼ", IsActive: true},
+		// 無効から有効に戻る
+		{PrefectureCode: "13", OrganizationCode: "131032", PrefectureNameKanji: "東京都", MunicipalityNameKanji: "港区", PrefectureNameKana: "ﾄｳｷｮｳﾄ", MunicipalityNameKana: "ﾐﾅﾄｸ", IsActive: true},
+		// 新しい団体コード
+		{PrefectureCode: "13", OrganizationCode: "131059", PrefectureNameKanji: "東京都", MunicipalityNameKanji: "文京区", PrefectureNameKana: "ﾄｳｷｮｳﾄ", MunicipalityNameKana: "ﾌﾞﾝｷｮｳｸ", IsActive: true},
+	}
+
+	report := importer.NewDiffReport(existing, municipalities, nil)
+
+	want := &importer.DiffReport{
+		Summary: &importer.ImportSummary{Inserted: 1, Updated: 2, Deactivated: 1, Unchanged: 1},
+		Entries: []*importer.DiffEntry{
+			{Type: importer.DiffRenamed, OrganizationCode: "131016", MunicipalityName: "千代田市", Field: "municipality_name_kanji", Before: "千代田区", After: "千代田市"},
+			{Type: importer.DiffKanaChanged, OrganizationCode: "131016", MunicipalityName: "千代田市", Field: "municipality_name_kana", Before: "ﾁﾖﾀﾞｸ", After: "ﾁﾖﾀﾞｼ"},
+			// 中央区はCSVから消えるため無効化される。無効化済みの新宿区は差分に含めない
+			{Type: importer.DiffRemoved, OrganizationCode: "131024", MunicipalityName: "中央区"},
+			{Type: importer.DiffReactivated, OrganizationCode: "131032", MunicipalityName: "港区", Field: "is_active", Before: "false", After: "true"},
+			{Type: importer.DiffAdded, OrganizationCode: "131059", MunicipalityName: "文京区"},
+		},
+	}
+	if diff := cmp.Diff(want, report); diff != "" {
+		t.Errorf("NewDiffReport() mismatch (-want +got):\n%s", diff)
+	}
+
+	t.Run("Success/差分なし", func(t *testing.T) {
+		report := importer.NewDiffReport(existing[:1], municipalities[:1], nil)
+		assert.Equal(t, &importer.ImportSummary{Unchanged: 1}, report.Summary)
+		assert.Empty(t, report.Entries)
+	})
+
+	t.Run("Success/検証に失敗した団体コードは無効化しない", func(t *testing.T) {
+		// 中央区の行はCSVにあるが検証に失敗した。正しい行がある千代田区はスキップとして扱わない
+		report := importer.NewDiffReport(existing[:3], municipalities[:2], []string{"131016", "131024", "131067"})
+
+		want := &importer.DiffReport{
+			Summary: &importer.ImportSummary{Updated: 1, Unchanged: 1, Skipped: 2},
+			Entries: []*importer.DiffEntry{
+				{Type: importer.DiffRenamed, OrganizationCode: "131016", MunicipalityName: "千代田市", Field: "municipality_name_kanji", Before: "千代田区", After: "千代田市"},
+				{Type: importer.DiffKanaChanged, OrganizationCode: "131016", MunicipalityName: "千代田市", Field: "municipality_name_kana", Before: "ﾁﾖﾀﾞｸ", After: "ﾁﾖﾀﾞｼ"},
+				{Type: importer.DiffSkipped, OrganizationCode: "131024", MunicipalityName: "中央区"},
+				{Type: importer.DiffSkipped, OrganizationCode: "131067"},
+			},
+		}
+		if diff := cmp.Diff(want, report); diff != "" {
+			t.Errorf("NewDiffReport() mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func TestDiffReport_Write(t *testing.T) {
+	report := &importer.DiffReport{
+		Summary: &importer.ImportSummary{Inserted: 1, Updated: 1},
+		Entries: []*importer.DiffEntry{
+			{Type: importer.DiffRenamed, OrganizationCode: "131016", MunicipalityName: "千代田市", Field: "municipality_name_kanji", Before: "千代田区", After: "千代田市"},
+			{Type: importer.DiffAdded, OrganizationCode: "131059", MunicipalityName: "文京区"},
+		},
+	}
+
+	t.Run("Success/CSV", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, report.WriteCSV(&buf))
+		assert.Equal(t, "type,organization_code,municipality_name,field,before,after\n"+
+			"renamed,131016,千代田市,municipality_name_kanji,千代田区,千代田市\n"+
+			"added,131059,文京区,,,\n", buf.String())
+	})
+
+	t.Run("Success/JSON", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, report.WriteJSON(&buf))
+		assert.JSONEq(t, `{
+			"summary": {"inserted": 1, "updated": 1, "deactivated": 0, "unchanged": 0, "skipped": 0},
+			"entries": [
+				{"type": "renamed", "organization_code": "131016", "municipality_name": "千代田市", "field": "municipality_name_kanji", "before": "千代田区", "after": "千代田市"},
+				{"type": "added", "organization_code": "131059", "municipality_name": "文京区"}
+			]
+		}`, buf.String())
+	})
+}
@@ -0,0 +1,89 @@
+package importer
+
+import (
+	"slices"
+
+	"g_gen/internal/domain/model"
+)
+
+// ImportSummary インポート結果の件数
+type ImportSummary struct {
+	Inserted    int `json:"inserted"`
+	Updated     int `json:"updated"`
+	Deactivated int `json:"deactivated"`
+	Unchanged   int `json:"unchanged"`
+	// Skipped 検証に失敗した行の団体コードの数。追加・更新も無効化もしない
+	Skipped int `json:"skipped"`
+}
+
+// upsertPlan CSVと現在のテーブルを団体コードで突き合わせた結果
+type upsertPlan struct {
+	// inserts テーブルに存在しない団体コード
+	inserts []*model.Municipality
+	// updates 名称などが変わった、または無効から有効に戻る団体コード
+	updates []*model.Municipality
+	// deactivations CSVに存在しない有効な団体コード
+	deactivations []string
+	// skipped 検証に失敗した行の団体コード（同じ団体コードの正しい行がある場合を除く）
+	skipped   []string
+	unchanged int
+}
+
+func (p *upsertPlan) summary() *ImportSummary {
+	return &ImportSummary{
+		Inserted:    len(p.inserts),
+		Updated:     len(p.updates),
+		Deactivated: len(p.deactivations),
+		Unchanged:   p.unchanged,
+		Skipped:     len(p.skipped),
+	}
+}
+
+// planUpsert CSVの市区町村と現在のテーブルの市区町村を団体コードで突き合わせる
+// 検証に失敗した行の団体コード（skippedCodes）はCSVから消えたとはみなさず、無効化しない
+func planUpsert(existing, municipalities []*model.Municipality, skippedCodes []string) *upsertPlan {
+	existingByCode := make(map[string]*model.Municipality, len(existing))
+	for _, e := range existing {
+		existingByCode[e.OrganizationCode] = e
+	}
+
+	plan := &upsertPlan{}
+	importedCodes := make(map[string]struct{}, len(municipalities))
+	for _, municipality := range municipalities {
+		importedCodes[municipality.OrganizationCode] = struct{}{}
+
+		current, ok := existingByCode[municipality.OrganizationCode]
+		switch {
+		case !ok:
+			plan.inserts = append(plan.inserts, municipality)
+		case isSameMunicipality(current, municipality):
+			plan.unchanged++
+		default:
+			plan.updates = append(plan.updates, municipality)
+		}
+	}
+
+	for _, code := range skippedCodes {
+		if _, ok := importedCodes[code]; !ok {
+			plan.skipped = append(plan.skipped, code)
+		}
+	}
+
+	for _, e := range existing {
+		if _, ok := importedCodes[e.OrganizationCode]; !ok && e.IsActive && !slices.Contains(plan.skipped, e.OrganizationCode) {
+			plan.deactivations = append(plan.deactivations, e.OrganizationCode)
+		}
+	}
+
+	return plan
+}
+
+// isSameMunicipality CSVから取り込む項目がすべて一致するか
+func isSameMunicipality(current, imported *model.Municipality) bool {
+	return current.PrefectureCode == imported.PrefectureCode &&
+		current.PrefectureNameKanji == imported.PrefectureNameKanji &&
+		current.MunicipalityNameKanji == imported.MunicipalityNameKanji &&
+		current.PrefectureNameKana == imported.PrefectureNameKana &&
+		current.MunicipalityNameKana == imported.MunicipalityNameKana &&
+		current.IsActive == imported.IsActive
+}
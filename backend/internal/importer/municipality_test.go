@@ -0,0 +1,92 @@
+package importer_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/importer"
+	"g_gen/internal/infra/logger"
+	"g_gen/tests/testutils"
+)
+
+// writeCSV テスト用のCSVファイルを一時ディレクトリに作成する
+func writeCSV(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "municipalities.csv")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestMunicipalityImporter_Diff(t *testing.T) {
+	ctx := context.Background()
+	path := writeCSV(t, "団体コード,都道府県名（漢字）,市区町村名（漢字）,都道府県名（カナ）,市区町村名（カナ）\n"+
+		"130001,東京都,,ﾄｳｷｮｳﾄ,\n"+
+		"131016,東京都,千代田区,ﾄｳｷｮｳﾄ,ﾁﾖﾀﾞｸ\n"+
+		"131024,東京都,中央区,ﾄｳｷｮｳﾄ,ﾁｭｳｵｳｸ\n"+
+		"131024,東京都,中央区,ﾄｳｷｮｳﾄ,ﾁｭｳｵｳｸ\n"+
+		"131025,東京都,誤りのある区,ﾄｳｷｮｳﾄ,ｱﾔﾏﾘﾉｱﾙｸ\n"+
+		"131041,東京都,新宿区,ﾄｳｷｮｳﾄ,\n")
+	prefectureColumns := []string{"id", "code", "name", "region_id"}
+	municipalityColumns := []string{
+		"id", "prefecture_code", "organization_code", "prefecture_name_kanji", "municipality_name_kanji",
+		"prefecture_name_kana", "municipality_name_kana", "is_active",
+	}
+
+	t.Run("Success/データベースを更新せずに差分を返す", func(t *testing.T) {
+		client, mock := testutils.NewTestClient(t)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "prefectures"`)).
+			WillReturnRows(sqlmock.NewRows(prefectureColumns).AddRow(13, "13", "東京都", 3))
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "municipalities"`)).
+			WillReturnRows(sqlmock.NewRows(municipalityColumns).
+				AddRow(1, "13", "131016", "東京都", "千代田区", "ﾄｳｷｮｳﾄ", "ﾁﾖﾀﾞｸ", true).
+				AddRow(2, "13", "131032", "東京都", "港区", "ﾄｳｷｮｳﾄ", "ﾐﾅﾄｸ", true).
+				AddRow(3, "13", "131041", "東京都", "新宿区", "ﾄｳｷｮｳﾄ", "ｼﾝｼﾞｭｸｸ", true))
+
+		report, err := importer.NewMunicipalityImporter(ctx, client, logger.New(logger.DefaultConfig())).Diff(importer.Source{Path: path})
+		require.NoError(t, err)
+
+		// 都道府県レベル・重複・チェックディジット誤り・カナが空の行はスキップする
+		// 検証に失敗した新宿区はCSVから消えたとはみなさず、無効化しない
+		assert.Equal(t, &importer.ImportSummary{Inserted: 1, Deactivated: 1, Unchanged: 1, Skipped: 2}, report.Summary)
+		assert.Equal(t, []*importer.DiffEntry{
+			{Type: importer.DiffAdded, OrganizationCode: "131024", MunicipalityName: "中央区"},
+			{Type: importer.DiffSkipped, OrganizationCode: "131025"},
+			{Type: importer.DiffRemoved, OrganizationCode: "131032", MunicipalityName: "港区"},
+			{Type: importer.DiffSkipped, OrganizationCode: "131041", MunicipalityName: "新宿区"},
+		}, report.Entries)
+		// 想定外のINSERT・UPDATEがあればsqlmockがエラーにする
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("DBエラー", func(t *testing.T) {
+		t.Run("failure/都道府県マスタの取得エラー", func(t *testing.T) {
+			client, mock := testutils.NewTestClient(t)
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "prefectures"`)).
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, err := importer.NewMunicipalityImporter(ctx, client, logger.New(logger.DefaultConfig())).Diff(importer.Source{Path: path})
+			assert.EqualError(t, err, "都道府県マスタの取得に失敗しました: db error")
+		})
+
+		t.Run("failure/既存データの取得エラー", func(t *testing.T) {
+			client, mock := testutils.NewTestClient(t)
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "prefectures"`)).
+				WillReturnRows(sqlmock.NewRows(prefectureColumns).AddRow(13, "13", "東京都", 3))
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "municipalities"`)).
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, err := importer.NewMunicipalityImporter(ctx, client, logger.New(logger.DefaultConfig())).Diff(importer.Source{Path: path})
+			assert.EqualError(t, err, "既存データの取得に失敗しました: db error")
+		})
+	})
+}
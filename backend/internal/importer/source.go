@@ -0,0 +1,251 @@
+package importer
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// SourceFormat 取り込むファイルの形式
+type SourceFormat string
+
+const (
+	FormatCSV  SourceFormat = "csv"
+	FormatXLSX SourceFormat = "xlsx"
+)
+
+// SourceEncoding CSVファイルの文字コード
+type SourceEncoding string
+
+const (
+	EncodingUTF8     SourceEncoding = "utf-8"
+	EncodingShiftJIS SourceEncoding = "shift_jis"
+)
+
+// Source 取り込むファイル
+// 総務省が公開している一覧（Shift_JISのCSV、xlsx）を加工せずに取り込めるように、形式・文字コード・シートを指定できる
+type Source struct {
+	Path string
+	// Format ファイルの形式。空の場合は拡張子から判定する
+	Format SourceFormat
+	// Encoding CSVファイルの文字コード。空の場合はUTF-8（BOMは読み飛ばす）
+	Encoding SourceEncoding
+	// Sheet xlsxファイルのシート名。空の場合は先頭のシート
+	Sheet string
+}
+
+// sourceColumn 取り込む列と、列を特定するための見出し（正規化済み）
+type sourceColumn struct {
+	name    string
+	headers []string
+}
+
+// sourceColumns 取り込む列。総務省の一覧とこのリポジトリのCSVの見出しの表記揺れを許容する
+var sourceColumns = []sourceColumn{
+	{name: "団体コード", headers: []string{"団体コード", "全国地方公共団体コード"}},
+	{name: "都道府県名（漢字）", headers: []string{"都道府県名(漢字)", "都道府県名"}},
+	{name: "市区町村名（漢字）", headers: []string{"市区町村名(漢字)", "市区町村名"}},
+	{name: "都道府県名（カナ）", headers: []string{"都道府県名(カナ)"}},
+	{name: "市区町村名（カナ）", headers: []string{"市区町村名(カナ)"}},
+}
+
+// headerSearchRows 見出し行を探す先頭からの行数（表題などが見出しの上にある場合に備える）
+const headerSearchRows = 10
+
+// organizationCodeLength 団体コードの桁数
+const organizationCodeLength = 6
+
+// sourceRow 見出しの次の行からの1行
+type sourceRow struct {
+	// lineNum ファイル上の行番号（1始まり）
+	lineNum int
+	record  CSVRecord
+}
+
+// readSourceRows ファイルを読み込み、見出しから特定した列の値を行ごとに返す
+func readSourceRows(source Source) ([]sourceRow, error) {
+	rows, err := readRawRows(source)
+	if err != nil {
+		return nil, err
+	}
+
+	headerIndex, columns, err := findHeader(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	cell := func(row []string, column int) string {
+		if columns[column] >= len(row) {
+			return ""
+		}
+
+		return strings.TrimSpace(row[columns[column]])
+	}
+
+	result := make([]sourceRow, 0, len(rows)-headerIndex-1)
+	for i := headerIndex + 1; i < len(rows); i++ {
+		row := rows[i]
+		if isBlankRow(row) {
+			continue
+		}
+
+		result = append(result, sourceRow{
+			lineNum: i + 1,
+			record: CSVRecord{
+				OrganizationCode:      padOrganizationCode(cell(row, 0)),
+				PrefectureNameKanji:   cell(row, 1),
+				MunicipalityNameKanji: cell(row, 2),
+				PrefectureNameKana:    cell(row, 3),
+				MunicipalityNameKana:  cell(row, 4),
+			},
+		})
+	}
+
+	return result, nil
+}
+
+// readRawRows ファイルの形式に応じてすべての行を読み込む
+func readRawRows(source Source) ([][]string, error) {
+	format := source.Format
+	if format == "" {
+		format = SourceFormat(strings.TrimPrefix(strings.ToLower(filepath.Ext(source.Path)), "."))
+	}
+
+	switch format {
+	case FormatCSV:
+		return readCSVRows(source)
+	case FormatXLSX:
+		return readXLSXRows(source)
+	default:
+		return nil, fmt.Errorf("対応していないファイル形式です: %s", format)
+	}
+}
+
+func readCSVRows(source Source) ([][]string, error) {
+	file, err := os.Open(source.Path)
+	if err != nil {
+		return nil, fmt.Errorf("CSVファイルを開けませんでした: %w", err)
+	}
+	defer file.Close()
+
+	var r io.Reader
+	switch source.Encoding {
+	case "", EncodingUTF8:
+		r = file
+	case EncodingShiftJIS:
+		r = transform.NewReader(file, japanese.ShiftJIS.NewDecoder())
+	default:
+		return nil, fmt.Errorf("対応していない文字コードです: %s", source.Encoding)
+	}
+
+	reader := csv.NewReader(r)
+	// 総務省の一覧は末尾に空の列がある行とない行が混在するため、列数は揃っていなくてよい
+	reader.FieldsPerRecord = -1
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("CSV読み込みエラー: %w", err)
+	}
+
+	return rows, nil
+}
+
+func readXLSXRows(source Source) ([][]string, error) {
+	file, err := excelize.OpenFile(source.Path)
+	if err != nil {
+		return nil, fmt.Errorf("xlsxファイルを開けませんでした: %w", err)
+	}
+	defer file.Close()
+
+	sheet := source.Sheet
+	if sheet == "" {
+		sheet = file.GetSheetName(0)
+	}
+
+	rows, err := file.GetRows(sheet)
+	if err != nil {
+		return nil, fmt.Errorf("シートの読み込みに失敗しました (シート: %s): %w", sheet, err)
+	}
+
+	return rows, nil
+}
+
+// findHeader 見出し行を探し、見出し行の位置と取り込む列ごとの列番号を返す
+func findHeader(rows [][]string) (int, []int, error) {
+	for i := 0; i < len(rows) && i < headerSearchRows; i++ {
+		if columns, ok := matchHeader(rows[i]); ok {
+			return i, columns, nil
+		}
+	}
+
+	names := make([]string, len(sourceColumns))
+	for i, c := range sourceColumns {
+		names[i] = c.name
+	}
+
+	return 0, nil, fmt.Errorf("見出し行が見つかりませんでした (必要な列: %s)", strings.Join(names, ", "))
+}
+
+// matchHeader 行がすべての取り込む列の見出しを含む場合に、列ごとの列番号を返す
+func matchHeader(row []string) ([]int, bool) {
+	indexByHeader := make(map[string]int, len(row))
+	for i, cell := range row {
+		header := normalizeHeader(cell)
+		if _, ok := indexByHeader[header]; !ok {
+			indexByHeader[header] = i
+		}
+	}
+
+	columns := make([]int, len(sourceColumns))
+	for i, c := range sourceColumns {
+		found := false
+		for _, header := range c.headers {
+			if index, ok := indexByHeader[header]; ok {
+				columns[i] = index
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return nil, false
+		}
+	}
+
+	return columns, true
+}
+
+// normalizeHeader 見出しの表記揺れ（全角・半角、改行や空白、BOM）をなくす
+func normalizeHeader(s string) string {
+	s = norm.NFKC.String(strings.TrimPrefix(s, "\ufeff"))
+
+	return strings.Join(strings.Fields(s), "")
+}
+
+// padOrganizationCode xlsxで数値として保存された団体コードは先頭の0が落ちるため、6桁になるように0で埋める
+func padOrganizationCode(code string) string {
+	if code == "" || len(code) >= organizationCodeLength || strings.Trim(code, "0123456789") != "" {
+		return code
+	}
+
+	return strings.Repeat("0", organizationCodeLength-len(code)) + code
+}
+
+func isBlankRow(row []string) bool {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+
+	return true
+}
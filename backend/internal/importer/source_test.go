@@ -0,0 +1,154 @@
+package importer_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+	"golang.org/x/text/encoding/japanese"
+
+	"g_gen/internal/importer"
+	"g_gen/internal/infra/logger"
+	"g_gen/tests/testutils"
+)
+
+// writeXLSX テスト用のxlsxファイルを一時ディレクトリに作成する
+func writeXLSX(t *testing.T, sheet string, rows [][]any) string {
+	t.Helper()
+
+	f := excelize.NewFile()
+	defer f.Close()
+
+	require.NoError(t, f.SetSheetName("Sheet1", sheet))
+	for i, row := range rows {
+		cell, err := excelize.CoordinatesToCellName(1, i+1)
+		require.NoError(t, err)
+		require.NoError(t, f.SetSheetRow(sheet, cell, &row))
+	}
+
+	path := filepath.Join(t.TempDir(), "municipalities.xlsx")
+	require.NoError(t, f.SaveAs(path))
+
+	return path
+}
+
+func TestMunicipalityImporter_Diff_Source(t *testing.T) {
+	ctx := context.Background()
+
+	shiftJIS, err := japanese.ShiftJIS.NewEncoder().String("団体コード,都道府県名（漢字）,市区町村名（漢字）,都道府県名（カナ）,市区町村名（カナ）\r\n" +
+		"131016,東京都,千代田区,ﾄｳｷｮｳﾄ,ﾁﾖﾀﾞｸ\r\n")
+	require.NoError(t, err)
+	shiftJISPath := filepath.Join(t.TempDir(), "000925835.csv")
+	require.NoError(t, os.WriteFile(shiftJISPath, []byte(shiftJIS), 0o600))
+
+	tests := []struct {
+		name    string
+		source  func(t *testing.T) importer.Source
+		want    []*importer.DiffEntry
+		wantErr string
+	}{
+		{
+			name: "Success/UTF-8のCSV（BOM・見出しの改行あり）",
+			source: func(t *testing.T) importer.Source {
+				return importer.Source{Path: writeCSV(t, "\ufeff団体コード,\"都道府県名\n（漢字）\",\"市区町村名\n（漢字）\",\"都道府県名\n（カナ）\",\"市区町村名\n（カナ）\",,\n"+
+					"131016,東京都,千代田区,ﾄｳｷｮｳﾄ,ﾁﾖﾀﾞｸ,,\n"+
+					"\n"+
+					"131024,東京都,中央区,ﾄｳｷｮｳﾄ,ﾁｭｳｵｳｸ\n")}
+			},
+			want: []*importer.DiffEntry{
+				{Type: importer.DiffAdded, OrganizationCode: "131016", MunicipalityName: "千代田区"},
+				{Type: importer.DiffAdded, OrganizationCode: "131024", MunicipalityName: "中央区"},
+			},
+		},
+		{
+			name: "Success/Shift_JISのCSV",
+			source: func(t *testing.T) importer.Source {
+				return importer.Source{Path: shiftJISPath, Encoding: importer.EncodingShiftJIS}
+			},
+			want: []*importer.DiffEntry{
+				{Type: importer.DiffAdded, OrganizationCode: "131016", MunicipalityName: "千代田区"},
+			},
+		},
+		{
+			name: "Success/xlsx（表題行・列の並び替え・数値の団体コード）",
+			source: func(t *testing.T) importer.Source {
+				return importer.Source{Path: writeXLSX(t, "R6.1.1現在の団体", [][]any{
+					{"都道府県コード及び市区町村コード"},
+					{"市区町村名\n（カナ）", "都道府県名\n（カナ）", "市区町村名\n（漢字）", "都道府県名\n（漢字）", "団体コード"},
+					{"ｻｯﾎﾟﾛｼ", "ﾎｯｶｲﾄﾞｳ", "札幌市", "北海道", 11002},
+				})}
+			},
+			want: []*importer.DiffEntry{
+				{Type: importer.DiffAdded, OrganizationCode: "011002", MunicipalityName: "札幌市"},
+			},
+		},
+		{
+			name: "Success/xlsxのシートを指定",
+			source: func(t *testing.T) importer.Source {
+				path := writeXLSX(t, "R6.1.1現在の団体", [][]any{{"団体コード"}})
+				f, err := excelize.OpenFile(path)
+				require.NoError(t, err)
+				defer f.Close()
+
+				_, err = f.NewSheet("政令指定都市")
+				require.NoError(t, err)
+				require.NoError(t, f.SetSheetRow("政令指定都市", "A1", &[]any{"団体コード", "都道府県名（漢字）", "市区町村名（漢字）", "都道府県名（カナ）", "市区町村名（カナ）"}))
+				require.NoError(t, f.SetSheetRow("政令指定都市", "A2", &[]any{"011011", "北海道", "札幌市中央区", "ﾎｯｶｲﾄﾞｳ", "ｻｯﾎﾟﾛｼﾁｭｳｵｳｸ"}))
+				require.NoError(t, f.Save())
+
+				return importer.Source{Path: path, Sheet: "政令指定都市"}
+			},
+			want: []*importer.DiffEntry{
+				{Type: importer.DiffAdded, OrganizationCode: "011011", MunicipalityName: "札幌市中央区"},
+			},
+		},
+		{
+			name: "failure/見出し行がない",
+			source: func(t *testing.T) importer.Source {
+				return importer.Source{Path: writeCSV(t, "131016,東京都,千代田区,ﾄｳｷｮｳﾄ,ﾁﾖﾀﾞｸ\n")}
+			},
+			wantErr: "見出し行が見つかりませんでした (必要な列: 団体コード, 都道府県名（漢字）, 市区町村名（漢字）, 都道府県名（カナ）, 市区町村名（カナ）)",
+		},
+		{
+			name: "failure/対応していないファイル形式",
+			source: func(t *testing.T) importer.Source {
+				return importer.Source{Path: "municipalities.txt"}
+			},
+			wantErr: "対応していないファイル形式です: txt",
+		},
+		{
+			name: "failure/対応していない文字コード",
+			source: func(t *testing.T) importer.Source {
+				return importer.Source{Path: shiftJISPath, Encoding: "euc-jp"}
+			},
+			wantErr: "対応していない文字コードです: euc-jp",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock := testutils.NewTestClient(t)
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "prefectures"`)).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "code", "name", "region_id"}).
+					AddRow(1, "01", "北海道", 1).
+					AddRow(13, "13", "東京都", 3))
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "municipalities"`)).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "organization_code"}))
+
+			report, err := importer.NewMunicipalityImporter(ctx, client, logger.New(logger.DefaultConfig())).Diff(tt.source(t))
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, report.Entries)
+		})
+	}
+}
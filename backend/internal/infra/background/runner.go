@@ -0,0 +1,78 @@
+// Package background はリクエストの後にバックグラウンドで処理を実行する
+package background
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	domain "g_gen/internal/domain/repository"
+	"g_gen/internal/infra/logger"
+)
+
+// Runner goroutineで処理を実行し、サーバーの停止時に実行中の処理の終了を待つ
+type Runner struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	logger *logger.Logger
+}
+
+var _ domain.TaskRunner = (*Runner)(nil)
+
+// NewRunner 実行した処理は Shutdown で終了を待つ
+func NewRunner(l *logger.Logger) *Runner {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Runner{
+		ctx:    ctx,
+		cancel: cancel,
+		logger: l,
+	}
+}
+
+// Go fn をgoroutineで実行する。失敗した場合・panicした場合はリクエストのトレースIDをつけてログに出力する
+func (r *Runner) Go(ctx context.Context, name string, fn func(ctx context.Context) error) {
+	// リクエストのコンテキストはレスポンスを返すとキャンセルされるため、トレースIDだけを引き継ぐ
+	taskCtx := logger.WithTraceID(r.ctx, logger.TraceIDFromContext(ctx))
+
+	r.wg.Add(1)
+
+	go func() {
+		defer r.wg.Done()
+
+		if err := run(taskCtx, fn); err != nil {
+			r.logger.LogErrorContext(taskCtx, err, "background task failed", slog.String("task", name))
+		}
+	}()
+}
+
+// Shutdown 実行中の処理の終了を待つ。ctx が先に終わった場合は、実行中の処理のコンテキストをキャンセルする
+func (r *Runner) Shutdown(ctx context.Context) error {
+	defer r.cancel()
+
+	done := make(chan struct{})
+
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func run(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = fmt.Errorf("panic: %v", v)
+		}
+	}()
+
+	return fn(ctx)
+}
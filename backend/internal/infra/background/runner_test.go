@@ -0,0 +1,154 @@
+package background_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/infra/background"
+	"g_gen/internal/infra/logger"
+)
+
+// syncBuffer 複数のgoroutineから書き込むログの出力先
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.Write(p)
+}
+
+// entries 出力されたJSONのログ
+func (b *syncBuffer) entries(t *testing.T) []map[string]any {
+	t.Helper()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var entries []map[string]any
+
+	dec := json.NewDecoder(&b.buf)
+	for dec.More() {
+		var entry map[string]any
+		require.NoError(t, dec.Decode(&entry))
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func newRunner() (*background.Runner, *syncBuffer) {
+	out := &syncBuffer{}
+
+	return background.NewRunner(logger.New(logger.Config{Output: out, JSON: true})), out
+}
+
+func TestRunner_Go(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(ctx context.Context) error
+		// wantError 出力されるログの error（空の場合はログを出力しない）
+		wantError string
+	}{
+		{
+			name:      "Success/成功した場合はログを出力しない",
+			fn:        func(context.Context) error { return nil },
+			wantError: "",
+		},
+		{
+			name:      "failure/失敗した場合はトレースIDをつけてログを出力する",
+			fn:        func(context.Context) error { return errors.New("thumbnail failed") },
+			wantError: "thumbnail failed",
+		},
+		{
+			name:      "failure/panicした場合もトレースIDをつけてログを出力する",
+			fn:        func(context.Context) error { panic("unexpected") },
+			wantError: "panic: unexpected",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner, out := newRunner()
+
+			ctx, cancel := context.WithCancel(logger.WithTraceID(context.Background(), "trace-1"))
+
+			var gotTraceID string
+			var gotCtxErr error
+
+			runner.Go(ctx, "process photo", func(ctx context.Context) error {
+				gotTraceID = logger.TraceIDFromContext(ctx)
+				gotCtxErr = ctx.Err()
+
+				return tt.fn(ctx)
+			})
+			// リクエストが終わっても処理はキャンセルされない
+			cancel()
+
+			require.NoError(t, runner.Shutdown(context.Background()))
+
+			assert.Equal(t, "trace-1", gotTraceID)
+			assert.NoError(t, gotCtxErr)
+
+			entries := out.entries(t)
+			if tt.wantError == "" {
+				assert.Empty(t, entries)
+
+				return
+			}
+
+			require.Len(t, entries, 1)
+			assert.Equal(t, "background task failed", entries[0]["msg"])
+			assert.Equal(t, "process photo", entries[0]["task"])
+			assert.Equal(t, "trace-1", entries[0]["trace_id"])
+			assert.Equal(t, tt.wantError, entries[0]["error"])
+		})
+	}
+}
+
+func TestRunner_Shutdown(t *testing.T) {
+	t.Run("Success/実行中の処理の終了を待つ", func(t *testing.T) {
+		runner, _ := newRunner()
+
+		done := false
+		runner.Go(context.Background(), "slow", func(context.Context) error {
+			time.Sleep(50 * time.Millisecond)
+			done = true
+
+			return nil
+		})
+
+		require.NoError(t, runner.Shutdown(context.Background()))
+		assert.True(t, done)
+	})
+
+	t.Run("failure/待ちきれない場合は実行中の処理をキャンセルする", func(t *testing.T) {
+		runner, out := newRunner()
+
+		runner.Go(context.Background(), "blocked", func(ctx context.Context) error {
+			<-ctx.Done()
+
+			return ctx.Err()
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		assert.ErrorIs(t, runner.Shutdown(ctx), context.DeadlineExceeded)
+
+		// キャンセルされた処理の失敗がログに出力されるまで待つ
+		require.Eventually(t, func() bool { return len(out.entries(t)) == 1 }, time.Second, 10*time.Millisecond)
+	})
+}
@@ -0,0 +1,208 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// Config キャッシュの設定
+type Config struct {
+	// TTL エントリの有効期間。0以下の場合は期限切れにしない
+	TTL time.Duration
+	// MaxEntries 保持するエントリの上限。超えた場合は最も長く参照されていないエントリから破棄する。0以下の場合は上限なし
+	MaxEntries int
+}
+
+// Stats キャッシュの利用状況
+type Stats struct {
+	Hits      uint64 `json:"hits"`
+	Misses    uint64 `json:"misses"`
+	Evictions uint64 `json:"evictions"`
+	Entries   int    `json:"entries"`
+}
+
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+}
+
+// Cache TTLとサイズ上限付きのプロセス内キャッシュ
+// 同じキーの読み込みが同時に発生した場合はsingleflightで1回にまとめる
+// 取得した値は複数のリクエストで共有されるため、呼び出し側で変更してはならない
+type Cache[K comparable, V any] struct {
+	config Config
+	now    func() time.Time
+
+	mu      sync.Mutex
+	entries map[K]*list.Element
+	lru     *list.List
+	// generation Invalidate・Purgeのたびに進め、読み込み中に破棄された古い値を書き込まないようにする
+	generation uint64
+
+	group singleflight.Group
+
+	hits      atomic.Uint64
+	misses    atomic.Uint64
+	evictions atomic.Uint64
+}
+
+// New キャッシュを生成する
+func New[K comparable, V any](config Config) *Cache[K, V] {
+	return &Cache[K, V]{
+		config:  config,
+		now:     time.Now,
+		entries: make(map[K]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// Get キャッシュから値を取得する。存在しない、または期限切れの場合はfalseを返す
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	value, ok := c.get(key)
+	if ok {
+		c.hits.Add(1)
+	} else {
+		c.misses.Add(1)
+	}
+
+	return value, ok
+}
+
+// GetOrLoad キャッシュから値を取得し、存在しない場合はloadで読み込んで保持する
+// loadがエラーを返した場合は保持しない。loadには呼び出し元のキャンセルが伝わらないcontextを渡す
+// （同じキーを待っている他の呼び出しを巻き込まないため）
+func (c *Cache[K, V]) GetOrLoad(ctx context.Context, key K, load func(ctx context.Context) (V, error)) (V, error) {
+	if value, ok := c.Get(key); ok {
+		return value, nil
+	}
+
+	c.mu.Lock()
+	generation := c.generation
+	c.mu.Unlock()
+
+	result, err, _ := c.group.Do(fmt.Sprint(key), func() (any, error) {
+		value, err := load(context.WithoutCancel(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		if c.generation == generation {
+			c.set(key, value)
+		}
+		c.mu.Unlock()
+
+		return value, nil
+	})
+	if err != nil {
+		var zero V
+		return zero, err
+	}
+
+	return result.(V), nil
+}
+
+// Set 値をキャッシュに保持する
+func (c *Cache[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.set(key, value)
+}
+
+// Invalidate 指定したキーのエントリを破棄する
+func (c *Cache[K, V]) Invalidate(keys ...K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	for _, key := range keys {
+		if elem, ok := c.entries[key]; ok {
+			c.remove(elem)
+		}
+		// 破棄前に始まった読み込みに後続の呼び出しが相乗りしないようにする
+		c.group.Forget(fmt.Sprint(key))
+	}
+}
+
+// Purge すべてのエントリを破棄する
+func (c *Cache[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	c.entries = make(map[K]*list.Element)
+	c.lru.Init()
+}
+
+// Stats キャッシュの利用状況を返す
+func (c *Cache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	entries := c.lru.Len()
+	c.mu.Unlock()
+
+	return Stats{
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Evictions: c.evictions.Load(),
+		Entries:   entries,
+	}
+}
+
+func (c *Cache[K, V]) get(key K) (V, bool) {
+	elem, ok := c.entries[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	e := elem.Value.(*entry[K, V])
+	if !e.expiresAt.IsZero() && !c.now().Before(e.expiresAt) {
+		c.remove(elem)
+
+		var zero V
+		return zero, false
+	}
+
+	c.lru.MoveToFront(elem)
+
+	return e.value, true
+}
+
+func (c *Cache[K, V]) set(key K, value V) {
+	var expiresAt time.Time
+	if c.config.TTL > 0 {
+		expiresAt = c.now().Add(c.config.TTL)
+	}
+
+	if elem, ok := c.entries[key]; ok {
+		e := elem.Value.(*entry[K, V])
+		e.value = value
+		e.expiresAt = expiresAt
+		c.lru.MoveToFront(elem)
+
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(&entry[K, V]{key: key, value: value, expiresAt: expiresAt})
+
+	for c.config.MaxEntries > 0 && c.lru.Len() > c.config.MaxEntries {
+		c.remove(c.lru.Back())
+		c.evictions.Add(1)
+	}
+}
+
+func (c *Cache[K, V]) remove(elem *list.Element) {
+	c.lru.Remove(elem)
+	delete(c.entries, elem.Value.(*entry[K, V]).key)
+}
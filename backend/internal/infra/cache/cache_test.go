@@ -0,0 +1,162 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/infra/cache"
+)
+
+func TestCache_GetOrLoad(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success/2回目はキャッシュから取得", func(t *testing.T) {
+		c := cache.New[string, int](cache.Config{})
+		calls := 0
+		load := func(context.Context) (int, error) {
+			calls++
+			return 13, nil
+		}
+
+		for range 2 {
+			got, err := c.GetOrLoad(ctx, "13", load)
+			require.NoError(t, err)
+			assert.Equal(t, 13, got)
+		}
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, cache.Stats{Hits: 1, Misses: 1, Entries: 1}, c.Stats())
+	})
+
+	t.Run("Success/TTLを過ぎると読み込み直す", func(t *testing.T) {
+		c := cache.New[string, int](cache.Config{TTL: time.Minute})
+		now := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+		cache.SetNow(c, func() time.Time { return now })
+		calls := 0
+		load := func(context.Context) (int, error) {
+			calls++
+			return calls, nil
+		}
+
+		got, _ := c.GetOrLoad(ctx, "13", load)
+		assert.Equal(t, 1, got)
+
+		now = now.Add(59 * time.Second)
+		got, _ = c.GetOrLoad(ctx, "13", load)
+		assert.Equal(t, 1, got)
+
+		now = now.Add(time.Second)
+		got, _ = c.GetOrLoad(ctx, "13", load)
+		assert.Equal(t, 2, got)
+	})
+
+	t.Run("Success/上限を超えると最も長く参照されていないエントリを破棄", func(t *testing.T) {
+		c := cache.New[string, int](cache.Config{MaxEntries: 2})
+		c.Set("01", 1)
+		c.Set("13", 13)
+		_, _ = c.Get("01")
+		c.Set("27", 27)
+
+		_, ok := c.Get("13")
+		assert.False(t, ok)
+		_, ok = c.Get("01")
+		assert.True(t, ok)
+		_, ok = c.Get("27")
+		assert.True(t, ok)
+		assert.Equal(t, uint64(1), c.Stats().Evictions)
+		assert.Equal(t, 2, c.Stats().Entries)
+	})
+
+	t.Run("Success/同時の読み込みは1回にまとめる", func(t *testing.T) {
+		c := cache.New[string, int](cache.Config{})
+		var calls atomic.Int32
+		release := make(chan struct{})
+		load := func(context.Context) (int, error) {
+			calls.Add(1)
+			<-release
+			return 13, nil
+		}
+
+		var wg sync.WaitGroup
+		results := make([]int, 10)
+		for i := range results {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i], _ = c.GetOrLoad(ctx, "13", load)
+			}()
+		}
+		// すべての呼び出しがキャッシュを確認してから読み込みを終わらせる
+		assert.Eventually(t, func() bool { return c.Stats().Misses == 10 }, time.Second, time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		assert.Equal(t, int32(1), calls.Load())
+		for _, got := range results {
+			assert.Equal(t, 13, got)
+		}
+	})
+
+	t.Run("Success/読み込み中に破棄された値は保持しない", func(t *testing.T) {
+		c := cache.New[string, int](cache.Config{})
+		_, err := c.GetOrLoad(ctx, "13", func(context.Context) (int, error) {
+			c.Purge()
+			return 13, nil
+		})
+		require.NoError(t, err)
+
+		_, ok := c.Get("13")
+		assert.False(t, ok)
+	})
+
+	t.Run("Success/呼び出し元のキャンセルは読み込みに伝えない", func(t *testing.T) {
+		c := cache.New[string, int](cache.Config{})
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		got, err := c.GetOrLoad(canceled, "13", func(ctx context.Context) (int, error) {
+			return 13, ctx.Err()
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 13, got)
+	})
+
+	t.Run("failure/エラーは保持しない", func(t *testing.T) {
+		c := cache.New[string, int](cache.Config{})
+		_, err := c.GetOrLoad(ctx, "13", func(context.Context) (int, error) {
+			return 0, errors.New("db error")
+		})
+		assert.Error(t, err)
+
+		got, err := c.GetOrLoad(ctx, "13", func(context.Context) (int, error) {
+			return 13, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 13, got)
+	})
+}
+
+func TestCache_Invalidate(t *testing.T) {
+	c := cache.New[string, int](cache.Config{})
+	c.Set("01", 1)
+	c.Set("13", 13)
+
+	c.Invalidate("13")
+
+	_, ok := c.Get("13")
+	assert.False(t, ok)
+	_, ok = c.Get("01")
+	assert.True(t, ok)
+
+	c.Purge()
+
+	_, ok = c.Get("01")
+	assert.False(t, ok)
+	assert.Equal(t, 0, c.Stats().Entries)
+}
@@ -0,0 +1,8 @@
+package cache
+
+import "time"
+
+// SetNow テスト用に現在時刻を差し替える
+func SetNow[K comparable, V any](c *Cache[K, V], now func() time.Time) {
+	c.now = now
+}
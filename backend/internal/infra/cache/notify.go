@@ -0,0 +1,86 @@
+package cache
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"gorm.io/gorm"
+
+	"g_gen/internal/infra/logger"
+)
+
+// Channel キャッシュの破棄を通知するPostgreSQLのLISTEN/NOTIFYチャネル
+// インポーターなどAPIサーバーとは別のプロセスで更新した場合に、APIサーバーのキャッシュを破棄するために使う
+const Channel = "cache_invalidation"
+
+// listenRetryInterval 通知の待ち受けが切断された場合に再接続するまでの間隔
+const listenRetryInterval = 5 * time.Second
+
+// Notify 指定した名前のキャッシュの破棄を通知する
+// トランザクション内で呼び出した場合、通知はコミット時に送られる
+func Notify(ctx context.Context, db *gorm.DB, names ...string) error {
+	for _, name := range names {
+		if err := db.WithContext(ctx).Exec("SELECT pg_notify(?, ?)", Channel, name).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Listen ctxがキャンセルされるまでキャッシュの破棄の通知を待ち受け、通知されたキャッシュを破棄する
+// 切断された場合は、その間の通知を取りこぼしている可能性があるためすべてのキャッシュを破棄してから再接続する
+func Listen(ctx context.Context, db *sql.DB, registry *Registry, l *logger.Logger) {
+	for {
+		err := listen(ctx, db, registry, l)
+		if ctx.Err() != nil {
+			return
+		}
+
+		l.Error("cache invalidation listener disconnected", "error", err)
+		registry.InvalidateAll()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenRetryInterval):
+		}
+	}
+}
+
+func listen(ctx context.Context, db *sql.DB, registry *Registry, l *logger.Logger) error {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn any) error {
+		c, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return errors.New("cache invalidation requires the pgx driver")
+		}
+
+		if _, err := c.Conn().Exec(ctx, "LISTEN "+pgx.Identifier{Channel}.Sanitize()); err != nil {
+			return err
+		}
+
+		for {
+			notification, err := c.Conn().WaitForNotification(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err := registry.Invalidate(notification.Payload); err != nil {
+				l.Warn("failed to invalidate cache", "error", err)
+				continue
+			}
+
+			l.Debug("cache invalidated", "cache", notification.Payload)
+		}
+	})
+}
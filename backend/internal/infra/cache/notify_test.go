@@ -0,0 +1,40 @@
+package cache_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"g_gen/internal/infra/cache"
+	"g_gen/tests/testutils"
+)
+
+func TestNotify(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success/キャッシュ名ごとに通知", func(t *testing.T) {
+		client, mock := testutils.NewTestClient(t)
+		for _, name := range []string{"prefectures", "work_categories"} {
+			mock.ExpectExec(regexp.QuoteMeta("SELECT pg_notify($1, $2)")).
+				WithArgs(cache.Channel, name).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+		}
+
+		assert.NoError(t, cache.Notify(ctx, client.Conn(ctx), "prefectures", "work_categories"))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("DBエラー", func(t *testing.T) {
+		client, mock := testutils.NewTestClient(t)
+		mock.ExpectExec(regexp.QuoteMeta("SELECT pg_notify($1, $2)")).
+			WithArgs(cache.Channel, "prefectures").
+			WillReturnError(fmt.Errorf("db error"))
+
+		err := cache.Notify(ctx, client.Conn(ctx), "prefectures", "work_categories")
+		assert.EqualError(t, err, "db error")
+	})
+}
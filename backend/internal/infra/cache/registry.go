@@ -0,0 +1,106 @@
+package cache
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Invalidator Registryに登録するキャッシュ
+type Invalidator interface {
+	Purge()
+	Stats() Stats
+}
+
+// Registry 名前付きのキャッシュの一覧
+// 書き込み処理やインポーターはキャッシュ名を指定して破棄する（リポジトリごとにキャッシュ名を決める）
+type Registry struct {
+	mu     sync.RWMutex
+	caches map[string][]Invalidator
+}
+
+// NewRegistry Registryを生成する
+func NewRegistry() *Registry {
+	return &Registry{caches: make(map[string][]Invalidator)}
+}
+
+// Register キャッシュを名前を付けて登録する。同じ名前で複数回登録した場合はまとめて扱う
+func (r *Registry) Register(name string, caches ...Invalidator) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.caches[name] = append(r.caches[name], caches...)
+}
+
+// Invalidate 指定した名前のキャッシュをすべて破棄する。登録されていない名前が含まれる場合はエラーを返す
+// （登録されている名前のキャッシュは破棄する）
+func (r *Registry) Invalidate(names ...string) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var unknown []string
+	for _, name := range names {
+		caches, ok := r.caches[name]
+		if !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+
+		for _, c := range caches {
+			c.Purge()
+		}
+	}
+
+	if len(unknown) > 0 {
+		return fmt.Errorf("unknown cache: %v", unknown)
+	}
+
+	return nil
+}
+
+// InvalidateAll 登録されているすべてのキャッシュを破棄する
+func (r *Registry) InvalidateAll() {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, caches := range r.caches {
+		for _, c := range caches {
+			c.Purge()
+		}
+	}
+}
+
+// Names 登録されているキャッシュ名を名前順で返す
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.caches))
+	for name := range r.caches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Stats キャッシュ名ごとの利用状況を返す。同じ名前で登録したキャッシュは合算する
+func (r *Registry) Stats() map[string]Stats {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stats := make(map[string]Stats, len(r.caches))
+	for name, caches := range r.caches {
+		var s Stats
+		for _, c := range caches {
+			cs := c.Stats()
+			s.Hits += cs.Hits
+			s.Misses += cs.Misses
+			s.Evictions += cs.Evictions
+			s.Entries += cs.Entries
+		}
+		stats[name] = s
+	}
+
+	return stats
+}
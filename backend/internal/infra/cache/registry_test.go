@@ -0,0 +1,65 @@
+package cache_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"g_gen/internal/infra/cache"
+)
+
+func TestRegistry(t *testing.T) {
+	setup := func() (*cache.Registry, *cache.Cache[string, int], *cache.Cache[int, int], *cache.Cache[int, int]) {
+		byCode := cache.New[string, int](cache.Config{})
+		byRegionID := cache.New[int, int](cache.Config{})
+		workCategories := cache.New[int, int](cache.Config{})
+		byCode.Set("13", 13)
+		byRegionID.Set(3, 3)
+		workCategories.Set(1, 1)
+
+		registry := cache.NewRegistry()
+		registry.Register("prefectures", byCode, byRegionID)
+		registry.Register("work_categories", workCategories)
+
+		return registry, byCode, byRegionID, workCategories
+	}
+
+	t.Run("Success/名前を指定して破棄", func(t *testing.T) {
+		registry, byCode, byRegionID, workCategories := setup()
+
+		assert.NoError(t, registry.Invalidate("prefectures"))
+
+		assert.Equal(t, 0, byCode.Stats().Entries)
+		assert.Equal(t, 0, byRegionID.Stats().Entries)
+		assert.Equal(t, 1, workCategories.Stats().Entries)
+	})
+
+	t.Run("Success/すべて破棄", func(t *testing.T) {
+		registry, byCode, byRegionID, workCategories := setup()
+
+		registry.InvalidateAll()
+
+		assert.Equal(t, 0, byCode.Stats().Entries)
+		assert.Equal(t, 0, byRegionID.Stats().Entries)
+		assert.Equal(t, 0, workCategories.Stats().Entries)
+	})
+
+	t.Run("Success/同じ名前の利用状況は合算", func(t *testing.T) {
+		registry, byCode, byRegionID, _ := setup()
+		_, _ = byCode.Get("13")
+		_, _ = byRegionID.Get(4)
+
+		assert.Equal(t, []string{"prefectures", "work_categories"}, registry.Names())
+		assert.Equal(t, map[string]cache.Stats{
+			"prefectures":     {Hits: 1, Misses: 1, Entries: 2},
+			"work_categories": {Entries: 1},
+		}, registry.Stats())
+	})
+
+	t.Run("failure/登録されていない名前", func(t *testing.T) {
+		registry, byCode, _, _ := setup()
+
+		assert.Error(t, registry.Invalidate("unknown", "prefectures"))
+		assert.Equal(t, 0, byCode.Stats().Entries)
+	})
+}
@@ -0,0 +1,109 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+
+	"g_gen/internal/domain/model"
+	domain "g_gen/internal/domain/repository"
+	"g_gen/internal/infra/cache"
+	"g_gen/internal/pagination"
+)
+
+// PrefectureCacheName 都道府県リポジトリのキャッシュ名
+// 都道府県詳細は有効な市区町村を含むため、市区町村を更新した場合もこの名前で破棄する
+const PrefectureCacheName = "prefectures"
+
+// prefecturePage 都道府県一覧の1ページ分の取得結果
+type prefecturePage struct {
+	prefectures []*model.Prefecture
+	meta        pagination.Meta
+}
+
+// regionPageKey 地方区分別の都道府県一覧のキャッシュのキー
+type regionPageKey struct {
+	regionID int
+	params   string
+}
+
+type cachedPrefectureRepository struct {
+	repo        domain.PrefectureRepository
+	all         *cache.Cache[string, prefecturePage]
+	byCode      *cache.Cache[string, *model.Prefecture]
+	byRegionID  *cache.Cache[regionPageKey, prefecturePage]
+	byRegionIDs *cache.Cache[string, []*model.Prefecture]
+}
+
+// NewCachedPrefectureRepository 都道府県リポジトリの取得結果をキャッシュする
+// キャッシュはPrefectureCacheNameでregistryに登録する
+func NewCachedPrefectureRepository(
+	repo domain.PrefectureRepository,
+	config cache.Config,
+	registry *cache.Registry,
+) domain.PrefectureRepository {
+	r := &cachedPrefectureRepository{
+		repo:        repo,
+		all:         cache.New[string, prefecturePage](config),
+		byCode:      cache.New[string, *model.Prefecture](config),
+		byRegionID:  cache.New[regionPageKey, prefecturePage](config),
+		byRegionIDs: cache.New[string, []*model.Prefecture](config),
+	}
+	registry.Register(PrefectureCacheName, r.all, r.byCode, r.byRegionID, r.byRegionIDs)
+
+	return r
+}
+
+func (r *cachedPrefectureRepository) FindAll(
+	ctx context.Context,
+	params pagination.Params,
+) ([]*model.Prefecture, pagination.Meta, error) {
+	page, err := r.all.GetOrLoad(ctx, paramsCacheKey(params), func(ctx context.Context) (prefecturePage, error) {
+		prefectures, meta, err := r.repo.FindAll(ctx, params)
+		return prefecturePage{prefectures: prefectures, meta: meta}, err
+	})
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	return page.prefectures, page.meta, nil
+}
+
+func (r *cachedPrefectureRepository) FindByCode(ctx context.Context, code string) (*model.Prefecture, error) {
+	return r.byCode.GetOrLoad(ctx, code, func(ctx context.Context) (*model.Prefecture, error) {
+		return r.repo.FindByCode(ctx, code)
+	})
+}
+
+func (r *cachedPrefectureRepository) FindByRegionID(
+	ctx context.Context,
+	regionID int,
+	params pagination.Params,
+) ([]*model.Prefecture, pagination.Meta, error) {
+	key := regionPageKey{regionID: regionID, params: paramsCacheKey(params)}
+	page, err := r.byRegionID.GetOrLoad(ctx, key, func(ctx context.Context) (prefecturePage, error) {
+		prefectures, meta, err := r.repo.FindByRegionID(ctx, regionID, params)
+		return prefecturePage{prefectures: prefectures, meta: meta}, err
+	})
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	return page.prefectures, page.meta, nil
+}
+
+func (r *cachedPrefectureRepository) FindByRegionIDs(ctx context.Context, regionIDs []int) ([]*model.Prefecture, error) {
+	return r.byRegionIDs.GetOrLoad(ctx, fmt.Sprint(regionIDs), func(ctx context.Context) ([]*model.Prefecture, error) {
+		return r.repo.FindByRegionIDs(ctx, regionIDs)
+	})
+}
+
+// paramsCacheKey 一覧取得の条件からキャッシュのキーを組み立てる。条件が同じであれば同じキーになる
+func paramsCacheKey(params pagination.Params) string {
+	cursor := ""
+	if params.Cursor != nil {
+		cursor = fmt.Sprint(params.Cursor.Sort, params.Cursor.Keys)
+	}
+
+	// fmtはmapをキー順に出力するため、絞り込み条件の順序に依存しない
+	return fmt.Sprintf("%d/%d/%s/%v/%s", params.Page, params.PerPage, pagination.FormatSort(params.Sorts), params.Filters, cursor)
+}
@@ -0,0 +1,114 @@
+package datastore_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"g_gen/internal/domain/model"
+	domain "g_gen/internal/domain/repository"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/infra/cache"
+	"g_gen/internal/infra/datastore"
+	"g_gen/internal/pagination"
+	mockdomain "g_gen/tests/mock/domain"
+)
+
+func setupCachedPrefectureRepository(t *testing.T) (
+	*mockdomain.MockPrefectureRepository,
+	*cache.Registry,
+	domain.PrefectureRepository,
+) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mockdomain.NewMockPrefectureRepository(ctrl)
+	registry := cache.NewRegistry()
+	repo := datastore.NewCachedPrefectureRepository(mockRepo, cache.Config{}, registry)
+
+	return mockRepo, registry, repo
+}
+
+func TestCachedPrefectureRepository(t *testing.T) {
+	ctx := context.Background()
+	tokyo := &model.Prefecture{ID: 13, Code: "13", Name: "東京都", RegionID: 3}
+	kanto := []*model.Prefecture{tokyo, {ID: 14, Code: "14", Name: "神奈川県", RegionID: 3}}
+	params := pagination.NewParams(1, 20, nil, nil)
+	meta := pagination.NewMeta(params, 2, nil)
+
+	t.Run("Success/2回目以降はキャッシュから取得", func(t *testing.T) {
+		mockRepo, registry, repo := setupCachedPrefectureRepository(t)
+		mockRepo.EXPECT().FindAll(gomock.Any(), params).Return(kanto, meta, nil).Times(1)
+		mockRepo.EXPECT().FindByCode(gomock.Any(), "13").Return(tokyo, nil).Times(1)
+		mockRepo.EXPECT().FindByRegionID(gomock.Any(), 3, params).Return(kanto, meta, nil).Times(1)
+		mockRepo.EXPECT().FindByRegionIDs(gomock.Any(), []int{3}).Return(kanto, nil).Times(1)
+
+		for range 2 {
+			all, allMeta, err := repo.FindAll(ctx, params)
+			require.NoError(t, err)
+			assert.Equal(t, kanto, all)
+			assert.Equal(t, meta, allMeta)
+
+			prefecture, err := repo.FindByCode(ctx, "13")
+			require.NoError(t, err)
+			assert.Equal(t, tokyo, prefecture)
+
+			prefectures, regionMeta, err := repo.FindByRegionID(ctx, 3, params)
+			require.NoError(t, err)
+			assert.Equal(t, kanto, prefectures)
+			assert.Equal(t, meta, regionMeta)
+
+			grouped, err := repo.FindByRegionIDs(ctx, []int{3})
+			require.NoError(t, err)
+			assert.Equal(t, kanto, grouped)
+		}
+
+		assert.Equal(t, cache.Stats{Hits: 4, Misses: 4, Entries: 4}, registry.Stats()[datastore.PrefectureCacheName])
+	})
+
+	t.Run("Success/ページが異なる場合は別にキャッシュする", func(t *testing.T) {
+		mockRepo, _, repo := setupCachedPrefectureRepository(t)
+		nextParams := pagination.NewParams(2, 1, nil, nil)
+		cursorParams := pagination.NewParams(1, 1, nil, nil)
+		cursorParams.Cursor = &pagination.Cursor{Sort: "code", Keys: []string{"13"}}
+		mockRepo.EXPECT().FindAll(gomock.Any(), params).Return(kanto, meta, nil).Times(1)
+		mockRepo.EXPECT().FindAll(gomock.Any(), nextParams).Return(kanto[1:], pagination.NewMeta(nextParams, 2, nil), nil).Times(1)
+		mockRepo.EXPECT().FindAll(gomock.Any(), cursorParams).Return(kanto[1:], pagination.NewMeta(cursorParams, 2, nil), nil).Times(1)
+
+		for _, p := range []pagination.Params{params, nextParams, cursorParams, params, nextParams, cursorParams} {
+			_, _, err := repo.FindAll(ctx, p)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("Success/破棄するとDBから取得し直す", func(t *testing.T) {
+		mockRepo, registry, repo := setupCachedPrefectureRepository(t)
+		mockRepo.EXPECT().FindByCode(gomock.Any(), "13").Return(tokyo, nil).Times(2)
+
+		_, err := repo.FindByCode(ctx, "13")
+		require.NoError(t, err)
+
+		require.NoError(t, registry.Invalidate(datastore.PrefectureCacheName))
+
+		_, err = repo.FindByCode(ctx, "13")
+		require.NoError(t, err)
+	})
+
+	t.Run("failure/存在しない都道府県はキャッシュしない", func(t *testing.T) {
+		mockRepo, _, repo := setupCachedPrefectureRepository(t)
+		notFound := &myerrors.APIError{
+			Code:    myerrors.PrefectureNotFoundError,
+			Message: myerrors.PrefectureNotFoundErrorMessage,
+		}
+		mockRepo.EXPECT().FindByCode(gomock.Any(), "99").Return(nil, notFound).Times(2)
+
+		for range 2 {
+			_, err := repo.FindByCode(ctx, "99")
+			var apiErr *myerrors.APIError
+			require.True(t, errors.As(err, &apiErr))
+			assert.Equal(t, myerrors.PrefectureNotFoundError, apiErr.Code)
+		}
+	})
+}
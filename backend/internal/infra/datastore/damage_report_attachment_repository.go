@@ -0,0 +1,159 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+
+	"g_gen/internal/domain/model"
+	"g_gen/internal/domain/query"
+	domain "g_gen/internal/domain/repository"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/infra/db"
+	"g_gen/internal/pagination"
+)
+
+type damageReportAttachmentRepository struct {
+	client db.Client
+	query  *query.Query
+}
+
+func NewDamageReportAttachmentRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.DamageReportAttachmentRepository {
+	return &damageReportAttachmentRepository{
+		client: client,
+		query:  query.Use(client.Conn(ctx)),
+	}
+}
+
+// attachmentListQuery 添付ファイル一覧で利用できるソートのフィールド
+func (r *damageReportAttachmentRepository) attachmentListQuery() listQuery[*model.DamageReportAttachment] {
+	a := r.query.DamageReportAttachment
+
+	return listQuery[*model.DamageReportAttachment]{
+		sorts: map[string]sortColumn[*model.DamageReportAttachment]{
+			"id": int64SortColumn(a.ID, func(row *model.DamageReportAttachment) int64 { return row.ID }),
+			"created_at": timeSortColumn(a.CreatedAt, func(row *model.DamageReportAttachment) time.Time {
+				return row.CreatedAt
+			}),
+		},
+		tieBreaker: "id",
+	}
+}
+
+// FindByDamageReportID 被害報告の添付ファイルを1ページ分取得する。並び順の指定がない場合は登録した順とする
+func (r *damageReportAttachmentRepository) FindByDamageReportID(
+	ctx context.Context,
+	damageReportID int,
+	params pagination.Params,
+) ([]*model.DamageReportAttachment, pagination.Meta, error) {
+	plan, err := r.attachmentListQuery().plan(params)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	a := r.query.DamageReportAttachment
+	conds := append([]gen.Condition{a.DamageReportID.Eq(int64(damageReportID))}, plan.conds...)
+
+	// 次のページの有無を判定するため1件多く取得する
+	attachments, err := r.query.WithContext(ctx).
+		DamageReportAttachment.
+		Where(append(conds, plan.after...)...).
+		Order(plan.orders...).
+		Offset(params.Offset()).
+		Limit(params.PerPage + 1).
+		Find()
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	count, err := r.query.WithContext(ctx).
+		DamageReportAttachment.
+		Where(conds...).
+		Count()
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	attachments, meta := plan.page(params, attachments, count)
+
+	return attachments, meta, nil
+}
+
+func (r *damageReportAttachmentRepository) FindByID(
+	ctx context.Context,
+	damageReportID, id int,
+) (*model.DamageReportAttachment, error) {
+	a := r.query.DamageReportAttachment
+
+	attachment, err := r.query.WithContext(ctx).
+		DamageReportAttachment.
+		Where(a.ID.Eq(int64(id)), a.DamageReportID.Eq(int64(damageReportID))).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, attachmentNotFoundError()
+		}
+
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+func (r *damageReportAttachmentRepository) Create(
+	ctx context.Context,
+	attachment *model.DamageReportAttachment,
+) error {
+	return r.query.WithContext(ctx).
+		DamageReportAttachment.
+		Create(attachment)
+}
+
+func (r *damageReportAttachmentRepository) UpdatePhotoMetadata(
+	ctx context.Context,
+	attachment *model.DamageReportAttachment,
+) error {
+	a := r.query.DamageReportAttachment
+
+	info, err := r.query.WithContext(ctx).
+		DamageReportAttachment.
+		Where(a.ID.Eq(attachment.ID)).
+		UpdateSimple(
+			nullable(attachment.CapturedAt, a.CapturedAt.Value, a.CapturedAt.Null()),
+			nullable(attachment.Latitude, a.Latitude.Value, a.Latitude.Null()),
+			nullable(attachment.Longitude, a.Longitude.Value, a.Longitude.Null()),
+			nullable(attachment.ThumbnailKey, a.ThumbnailKey.Value, a.ThumbnailKey.Null()),
+		)
+	if err != nil {
+		return err
+	}
+
+	if info.RowsAffected == 0 {
+		return attachmentNotFoundError()
+	}
+
+	return nil
+}
+
+// nullable 値がnilの場合はNULLにする代入
+func nullable[T any](v *T, value func(T) field.AssignExpr, null field.AssignExpr) field.AssignExpr {
+	if v == nil {
+		return null
+	}
+
+	return value(*v)
+}
+
+func attachmentNotFoundError() *myerrors.APIError {
+	return &myerrors.APIError{
+		Code:    myerrors.AttachmentNotFoundError,
+		Message: myerrors.AttachmentNotFoundErrorMessage,
+	}
+}
@@ -0,0 +1,401 @@
+package datastore_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/domain/model"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/infra/datastore"
+	"g_gen/internal/infra/db"
+	"g_gen/internal/pagination"
+	"g_gen/tests/testutils"
+)
+
+// setupDamageReportAttachments 被害報告と、それに添付したファイルの情報を登録する
+func setupDamageReportAttachments(t *testing.T, client db.Client) {
+	setupDamageReports(t, client)
+
+	r := require.New(t)
+	r.NoError(client.Conn(context.Background()).Exec(
+		"INSERT INTO damage_report_attachments (damage_report_id, file_name, content_type, size, storage_key) VALUES "+
+			"(?, ?, ?, ?, ?), (?, ?, ?, ?, ?), (?, ?, ?, ?, ?)",
+		1, "被災状況.jpg", "image/jpeg", 204800, "damage-reports/1/a",
+		1, "見積書.pdf", "application/pdf", 51200, "damage-reports/1/b",
+		2, "全景.png", "image/png", 102400, "damage-reports/2/c",
+	).Error)
+}
+
+func chiyodaDamageReportAttachments() []*model.DamageReportAttachment {
+	return []*model.DamageReportAttachment{
+		{
+			ID:             1,
+			DamageReportID: 1,
+			FileName:       "被災状況.jpg",
+			ContentType:    "image/jpeg",
+			Size:           204800,
+			StorageKey:     "damage-reports/1/a",
+		},
+		{
+			ID:             2,
+			DamageReportID: 1,
+			FileName:       "見積書.pdf",
+			ContentType:    "application/pdf",
+			Size:           51200,
+			StorageKey:     "damage-reports/1/b",
+		},
+	}
+}
+
+func TestDamageReportAttachmentRepository_FindByDamageReportID(t *testing.T) {
+	withCursor := func(perPage int, cursor *pagination.Cursor) pagination.Params {
+		p := pagination.NewParams(1, perPage, nil, nil)
+		p.Cursor = cursor
+		return p
+	}
+
+	tests := []struct {
+		name           string
+		damageReportID int
+		params         pagination.Params
+		want           []*model.DamageReportAttachment
+		wantTotal      int64
+		wantCursor     *pagination.Cursor
+	}{
+		{
+			name:           "Success/登録した順に取得",
+			damageReportID: 1,
+			params:         pagination.NewParams(1, 20, nil, nil),
+			want:           chiyodaDamageReportAttachments(),
+			wantTotal:      2,
+		},
+		{
+			name:           "Success/IDの降順",
+			damageReportID: 1,
+			params:         pagination.NewParams(1, 20, []pagination.Sort{{Field: "id", Desc: true}}, nil),
+			want:           []*model.DamageReportAttachment{chiyodaDamageReportAttachments()[1], chiyodaDamageReportAttachments()[0]},
+			wantTotal:      2,
+		},
+		{
+			name:           "Success/1ページ目は次のページのカーソルを返す",
+			damageReportID: 1,
+			params:         pagination.NewParams(1, 1, nil, nil),
+			want:           chiyodaDamageReportAttachments()[:1],
+			wantTotal:      2,
+			wantCursor:     &pagination.Cursor{Sort: "id", Keys: []string{"1"}},
+		},
+		{
+			name:           "Success/カーソルより後ろを取得",
+			damageReportID: 1,
+			params:         withCursor(1, &pagination.Cursor{Sort: "id", Keys: []string{"1"}}),
+			want:           chiyodaDamageReportAttachments()[1:],
+			wantTotal:      2,
+		},
+		{
+			name:           "Success/添付ファイルがない場合は空",
+			damageReportID: 3,
+			params:         pagination.NewParams(1, 20, nil, nil),
+			want:           []*model.DamageReportAttachment{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			a := assert.New(t)
+
+			client := testutils.SetupTestDB(t)
+			defer client.Close()
+
+			repo := datastore.NewDamageReportAttachmentRepository(ctx, client)
+
+			testutils.TruncateAllTables(t, client)
+			setupDamageReportAttachments(t, client)
+
+			got, meta, err := repo.FindByDamageReportID(ctx, tt.damageReportID, tt.params)
+			a.NoError(err)
+			a.Equal(tt.wantTotal, meta.TotalCount)
+			a.Equal(tt.wantCursor, meta.NextCursor)
+
+			if !cmp.Equal(tt.want, got, testutils.IgnoreUpdatedAt) {
+				t.Errorf("diff %s", cmp.Diff(tt.want, got, testutils.IgnoreUpdatedAt))
+			}
+		})
+	}
+
+	t.Run("バリデーションエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, _ := testutils.NewTestClient(t)
+		repo := datastore.NewDamageReportAttachmentRepository(ctx, client)
+
+		for name, params := range map[string]pagination.Params{
+			"failure/許可されていないソート項目":   pagination.NewParams(1, 20, []pagination.Sort{{Field: "file_name"}}, nil),
+			"failure/ソート条件と一致しないカーソル": withCursor(20, &pagination.Cursor{Sort: "-created_at,id", Keys: []string{"1"}}),
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, _, err := repo.FindByDamageReportID(ctx, 1, params)
+
+				var apiErr *myerrors.APIError
+				require.ErrorAs(t, err, &apiErr)
+				assert.Equal(t, myerrors.ValidationError, apiErr.Code)
+			})
+		}
+	})
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewDamageReportAttachmentRepository(ctx, client)
+
+		t.Run("failure/Findエラー", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM \"damage_report_attachments\" WHERE \"damage_report_attachments\".\"damage_report_id\" = $1 ORDER BY \"damage_report_attachments\".\"id\" LIMIT $2")).
+				WithArgs(1, 21).
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, _, err := repo.FindByDamageReportID(ctx, 1, pagination.NewParams(1, 20, nil, nil))
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+		})
+
+		t.Run("failure/Countエラー", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM \"damage_report_attachments\" WHERE \"damage_report_attachments\".\"damage_report_id\" = $1 AND \"damage_report_attachments\".\"id\" > $2 ORDER BY \"damage_report_attachments\".\"id\" LIMIT $3")).
+				WithArgs(1, 1, 21).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM \"damage_report_attachments\" WHERE \"damage_report_attachments\".\"damage_report_id\" = $1")).
+				WithArgs(1).
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, _, err := repo.FindByDamageReportID(ctx, 1, withCursor(20, &pagination.Cursor{Sort: "id", Keys: []string{"1"}}))
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+		})
+	})
+}
+
+func TestDamageReportAttachmentRepository_FindByID(t *testing.T) {
+	tests := []struct {
+		name             string
+		damageReportID   int
+		id               int
+		want             *model.DamageReportAttachment
+		wantErrorMessage myerrors.ErrorMessage
+	}{
+		{
+			name:           "Success/IDで取得",
+			damageReportID: 1,
+			id:             2,
+			want:           chiyodaDamageReportAttachments()[1],
+		},
+		{
+			name:             "failure/他の被害報告の添付ファイルはNotFound",
+			damageReportID:   1,
+			id:               3,
+			wantErrorMessage: myerrors.AttachmentNotFoundErrorMessage,
+		},
+		{
+			name:             "failure/NotFound",
+			damageReportID:   1,
+			id:               99,
+			wantErrorMessage: myerrors.AttachmentNotFoundErrorMessage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			a := assert.New(t)
+
+			client := testutils.SetupTestDB(t)
+			defer client.Close()
+
+			repo := datastore.NewDamageReportAttachmentRepository(ctx, client)
+
+			testutils.TruncateAllTables(t, client)
+			setupDamageReportAttachments(t, client)
+
+			got, err := repo.FindByID(ctx, tt.damageReportID, tt.id)
+			if tt.wantErrorMessage != "" {
+				var apiErr *myerrors.APIError
+				require.ErrorAs(t, err, &apiErr)
+				a.Equal(tt.wantErrorMessage, apiErr.Message)
+				return
+			}
+			a.NoError(err)
+
+			if !cmp.Equal(tt.want, got, testutils.IgnoreUpdatedAt) {
+				t.Errorf("diff %s", cmp.Diff(tt.want, got, testutils.IgnoreUpdatedAt))
+			}
+		})
+	}
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewDamageReportAttachmentRepository(ctx, client)
+
+		t.Run("failure/Firstエラー", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM \"damage_report_attachments\" WHERE \"damage_report_attachments\".\"id\" = $1 AND \"damage_report_attachments\".\"damage_report_id\" = $2 ORDER BY \"damage_report_attachments\".\"id\" LIMIT $3")).
+				WithArgs(2, 1, 1).
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, err := repo.FindByID(ctx, 1, 2)
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+		})
+	})
+}
+
+func TestDamageReportAttachmentRepository_Create(t *testing.T) {
+	t.Run("Success/登録した添付ファイルを取得できる", func(t *testing.T) {
+		ctx := context.Background()
+		a := assert.New(t)
+
+		client := testutils.SetupTestDB(t)
+		defer client.Close()
+
+		repo := datastore.NewDamageReportAttachmentRepository(ctx, client)
+
+		testutils.TruncateAllTables(t, client)
+		setupDamageReportAttachments(t, client)
+
+		attachment := &model.DamageReportAttachment{
+			DamageReportID: 3,
+			FileName:       "崩落箇所.webp",
+			ContentType:    "image/webp",
+			Size:           30720,
+			StorageKey:     "damage-reports/3/d",
+		}
+		a.NoError(repo.Create(ctx, attachment))
+		a.Equal(int64(4), attachment.ID)
+
+		got, err := repo.FindByID(ctx, 3, 4)
+		a.NoError(err)
+
+		want := &model.DamageReportAttachment{
+			ID:             4,
+			DamageReportID: 3,
+			FileName:       "崩落箇所.webp",
+			ContentType:    "image/webp",
+			Size:           30720,
+			StorageKey:     "damage-reports/3/d",
+		}
+		if !cmp.Equal(want, got, testutils.IgnoreUpdatedAt) {
+			t.Errorf("diff %s", cmp.Diff(want, got, testutils.IgnoreUpdatedAt))
+		}
+	})
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewDamageReportAttachmentRepository(ctx, client)
+
+		t.Run("failure/Createエラー", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO \"damage_report_attachments\"")).
+				WillReturnError(fmt.Errorf("db error"))
+			mock.ExpectRollback()
+
+			err := repo.Create(ctx, &model.DamageReportAttachment{DamageReportID: 1, FileName: "被災状況.jpg"})
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+}
+
+func TestDamageReportAttachmentRepository_UpdatePhotoMetadata(t *testing.T) {
+	capturedAt := time.Date(2026, 6, 30, 14, 5, 0, 0, time.FixedZone("JST", 9*60*60))
+	latitude := 35.685175
+	longitude := 139.752799
+	thumbnailKey := "damage-reports/1/a-thumbnail.jpg"
+
+	tests := []struct {
+		name             string
+		attachment       *model.DamageReportAttachment
+		want             *model.DamageReportAttachment
+		wantErrorMessage myerrors.ErrorMessage
+	}{
+		{
+			name: "Success/撮影日時・撮影位置とサムネイルのキーを更新",
+			attachment: &model.DamageReportAttachment{
+				ID:           1,
+				CapturedAt:   &capturedAt,
+				Latitude:     &latitude,
+				Longitude:    &longitude,
+				ThumbnailKey: &thumbnailKey,
+			},
+			want: func() *model.DamageReportAttachment {
+				a := chiyodaDamageReportAttachments()[0]
+				a.CapturedAt = &capturedAt
+				a.Latitude = &latitude
+				a.Longitude = &longitude
+				a.ThumbnailKey = &thumbnailKey
+
+				return a
+			}(),
+		},
+		{
+			name:             "failure/NotFound",
+			attachment:       &model.DamageReportAttachment{ID: 99, ThumbnailKey: &thumbnailKey},
+			wantErrorMessage: myerrors.AttachmentNotFoundErrorMessage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			a := assert.New(t)
+
+			client := testutils.SetupTestDB(t)
+			defer client.Close()
+
+			repo := datastore.NewDamageReportAttachmentRepository(ctx, client)
+
+			testutils.TruncateAllTables(t, client)
+			setupDamageReportAttachments(t, client)
+
+			err := repo.UpdatePhotoMetadata(ctx, tt.attachment)
+			if tt.wantErrorMessage != "" {
+				var apiErr *myerrors.APIError
+				require.ErrorAs(t, err, &apiErr)
+				a.Equal(tt.wantErrorMessage, apiErr.Message)
+				return
+			}
+			a.NoError(err)
+
+			got, err := repo.FindByID(ctx, int(tt.want.DamageReportID), int(tt.want.ID))
+			a.NoError(err)
+
+			if !cmp.Equal(tt.want, got, testutils.IgnoreUpdatedAt) {
+				t.Errorf("diff %s", cmp.Diff(tt.want, got, testutils.IgnoreUpdatedAt))
+			}
+		})
+	}
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewDamageReportAttachmentRepository(ctx, client)
+
+		t.Run("failure/Updateエラー", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE \"damage_report_attachments\" SET \"captured_at\"=$1,\"latitude\"=$2,\"longitude\"=$3,\"thumbnail_key\"=$4 WHERE \"damage_report_attachments\".\"id\" = $5")).
+				WithArgs(nil, nil, nil, thumbnailKey, 1).
+				WillReturnError(fmt.Errorf("db error"))
+			mock.ExpectRollback()
+
+			err := repo.UpdatePhotoMetadata(ctx, &model.DamageReportAttachment{ID: 1, ThumbnailKey: &thumbnailKey})
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+}
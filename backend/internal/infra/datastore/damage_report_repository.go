@@ -0,0 +1,220 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+
+	"g_gen/internal/domain/model"
+	"g_gen/internal/domain/query"
+	domain "g_gen/internal/domain/repository"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/infra/db"
+	"g_gen/internal/pagination"
+)
+
+type damageReportRepository struct {
+	client db.Client
+	query  *query.Query
+}
+
+func NewDamageReportRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.DamageReportRepository {
+	return &damageReportRepository{
+		client: client,
+		query:  query.Use(client.Conn(ctx)),
+	}
+}
+
+// damageReportListQuery 被害報告一覧で利用できるソート・絞り込みのフィールド
+func (r *damageReportRepository) damageReportListQuery() listQuery[*model.DamageReport] {
+	d := r.query.DamageReport
+
+	return listQuery[*model.DamageReport]{
+		sorts: map[string]sortColumn[*model.DamageReport]{
+			"id": int64SortColumn(d.ID, func(row *model.DamageReport) int64 { return row.ID }),
+			"damage_date": timeSortColumn(d.DamageDate, func(row *model.DamageReport) time.Time {
+				return row.DamageDate
+			}),
+			"estimated_amount": int64SortColumn(d.EstimatedAmount, func(row *model.DamageReport) int64 {
+				return row.EstimatedAmount
+			}),
+		},
+		filters: map[string]func(value string) (gen.Condition, error){
+			"organization_code": func(v string) (gen.Condition, error) { return d.OrganizationCode.Eq(v), nil },
+			"work_category_id": func(v string) (gen.Condition, error) {
+				id, err := strconv.ParseInt(v, 10, 64)
+				if err != nil {
+					return nil, err
+				}
+
+				return d.WorkCategoryID.Eq(id), nil
+			},
+			"status": func(v string) (gen.Condition, error) { return d.Status.Eq(v), nil },
+			"damage_date_from": func(v string) (gen.Condition, error) {
+				date, err := time.Parse(time.DateOnly, v)
+				if err != nil {
+					return nil, err
+				}
+
+				return d.DamageDate.Gte(date), nil
+			},
+			"damage_date_to": func(v string) (gen.Condition, error) {
+				date, err := time.Parse(time.DateOnly, v)
+				if err != nil {
+					return nil, err
+				}
+
+				return d.DamageDate.Lte(date), nil
+			},
+		},
+		tieBreaker: "id",
+	}
+}
+
+// FindAll 被害報告を市区町村・工種区分とあわせて1ページ分取得する。並び順の指定がない場合はID順とする
+func (r *damageReportRepository) FindAll(
+	ctx context.Context,
+	params pagination.Params,
+) ([]*model.DamageReport, pagination.Meta, error) {
+	plan, err := r.damageReportListQuery().plan(params)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	d := r.query.DamageReport
+
+	// 次のページの有無を判定するため1件多く取得する
+	damageReports, err := r.query.WithContext(ctx).
+		DamageReport.
+		Preload(d.Municipality, d.WorkCategory).
+		Where(append(plan.conds, plan.after...)...).
+		Order(plan.orders...).
+		Offset(params.Offset()).
+		Limit(params.PerPage + 1).
+		Find()
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	count, err := r.query.WithContext(ctx).
+		DamageReport.
+		Where(plan.conds...).
+		Count()
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	damageReports, meta := plan.page(params, damageReports, count)
+
+	return damageReports, meta, nil
+}
+
+func (r *damageReportRepository) FindByID(ctx context.Context, id int) (*model.DamageReport, error) {
+	d := r.query.DamageReport
+
+	damageReport, err := r.query.WithContext(ctx).
+		DamageReport.
+		Preload(d.Municipality, d.WorkCategory).
+		Where(d.ID.Eq(int64(id))).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, damageReportNotFoundError()
+		}
+
+		return nil, err
+	}
+
+	return damageReport, nil
+}
+
+// Create 被害報告を登録する。市区町村・工種区分は参照するだけで登録・更新しない
+func (r *damageReportRepository) Create(ctx context.Context, damageReport *model.DamageReport) error {
+	return r.query.WithContext(ctx).
+		DamageReport.
+		Omit(field.AssociationFields).
+		Create(damageReport)
+}
+
+func (r *damageReportRepository) Update(ctx context.Context, damageReport *model.DamageReport) error {
+	d := r.query.DamageReport
+
+	area := d.Area.Null()
+	if damageReport.Area != nil {
+		area = d.Area.Value(*damageReport.Area)
+	}
+
+	info, err := r.query.WithContext(ctx).
+		DamageReport.
+		Where(d.ID.Eq(damageReport.ID)).
+		UpdateSimple(
+			d.OrganizationCode.Value(damageReport.OrganizationCode),
+			d.WorkCategoryID.Value(damageReport.WorkCategoryID),
+			d.DamageDate.Value(damageReport.DamageDate),
+			d.Location.Value(damageReport.Location),
+			d.EstimatedAmount.Value(damageReport.EstimatedAmount),
+			area,
+		)
+	if err != nil {
+		return err
+	}
+
+	if info.RowsAffected == 0 {
+		return damageReportNotFoundError()
+	}
+
+	return nil
+}
+
+// Transition 状態が遷移前のままの場合だけ更新し、同時に行われた別の遷移を上書きしない
+func (r *damageReportRepository) Transition(ctx context.Context, id int, from, to string) error {
+	d := r.query.DamageReport
+
+	info, err := r.query.WithContext(ctx).
+		DamageReport.
+		Where(d.ID.Eq(int64(id)), d.Status.Eq(from)).
+		UpdateSimple(d.Status.Value(to))
+	if err != nil {
+		return err
+	}
+
+	if info.RowsAffected == 0 {
+		return myerrors.NewAPIError(
+			myerrors.InvalidStateTransitionError,
+			myerrors.InvalidStateTransitionErrorMessage,
+			fmt.Errorf("damage report %d is not %s", id, from),
+			"conflicting damage report transition",
+		)
+	}
+
+	return nil
+}
+
+// PrefillFromPhoto 位置が未設定の場合だけ撮影位置を設定する
+// 被災日は入力が必須のため写真からは変更せず、撮影日は添付ファイルで被災日の候補として返す
+func (r *damageReportRepository) PrefillFromPhoto(ctx context.Context, id int, latitude, longitude float64) error {
+	d := r.query.DamageReport
+
+	_, err := r.query.WithContext(ctx).
+		DamageReport.
+		Where(d.ID.Eq(int64(id)), d.Status.Eq(domain.DamageReportStatusDraft), d.Latitude.IsNull()).
+		UpdateSimple(d.Latitude.Value(latitude), d.Longitude.Value(longitude))
+
+	return err
+}
+
+func damageReportNotFoundError() *myerrors.APIError {
+	return &myerrors.APIError{
+		Code:    myerrors.DamageReportNotFoundError,
+		Message: myerrors.DamageReportNotFoundErrorMessage,
+	}
+}
@@ -0,0 +1,637 @@
+package datastore_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/domain/model"
+	domain "g_gen/internal/domain/repository"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/infra/datastore"
+	"g_gen/internal/infra/db"
+	"g_gen/internal/pagination"
+	"g_gen/tests/testutils"
+)
+
+// setupDamageReports 市区町村・工種区分と、それらを参照する被害報告を登録する
+func setupDamageReports(t *testing.T, client db.Client) {
+	setupMunicipalities(t, client)
+	setupWorkCategories(t, client)
+
+	r := require.New(t)
+	r.NoError(client.Conn(context.Background()).Exec(
+		"INSERT INTO damage_reports (organization_code, work_category_id, damage_date, location, estimated_amount, area, status) VALUES "+
+			"(?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?)",
+		"131016", 1, "2026-07-01", "千代田区千代田1番地先", 500000, 12.5, "draft",
+		"011002", 2, "2026-06-15", "札幌市中央区北1条西2丁目地先", 1200000, nil, "reported",
+		"131016", 2, "2026-07-10", "千代田区丸の内2番地先", 300000, 40, "confirmed",
+	).Error)
+}
+
+func chiyodaDamageReport() *model.DamageReport {
+	area := 12.5
+
+	return &model.DamageReport{
+		ID:               1,
+		OrganizationCode: "131016",
+		WorkCategoryID:   1,
+		DamageDate:       time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+		Location:         "千代田区千代田1番地先",
+		EstimatedAmount:  500000,
+		Area:             &area,
+		Status:           domain.DamageReportStatusDraft,
+		Municipality:     *chiyodaMunicipality(),
+		WorkCategory:     model.WorkCategory{ID: 1, CategoryName: "農地", SortOrder: 20, IsActive: true},
+	}
+}
+
+func sapporoDamageReport() *model.DamageReport {
+	return &model.DamageReport{
+		ID:               2,
+		OrganizationCode: "011002",
+		WorkCategoryID:   2,
+		DamageDate:       time.Date(2026, 6, 15, 0, 0, 0, 0, time.UTC),
+		Location:         "札幌市中央区北1条西2丁目地先",
+		EstimatedAmount:  1200000,
+		Status:           domain.DamageReportStatusReported,
+		Municipality:     *sapporoMunicipality(),
+		WorkCategory:     model.WorkCategory{ID: 2, CategoryName: "水路", SortOrder: 10, IsActive: true},
+	}
+}
+
+func marunouchiDamageReport() *model.DamageReport {
+	area := 40.0
+
+	return &model.DamageReport{
+		ID:               3,
+		OrganizationCode: "131016",
+		WorkCategoryID:   2,
+		DamageDate:       time.Date(2026, 7, 10, 0, 0, 0, 0, time.UTC),
+		Location:         "千代田区丸の内2番地先",
+		EstimatedAmount:  300000,
+		Area:             &area,
+		Status:           domain.DamageReportStatusConfirmed,
+		Municipality:     *chiyodaMunicipality(),
+		WorkCategory:     model.WorkCategory{ID: 2, CategoryName: "水路", SortOrder: 10, IsActive: true},
+	}
+}
+
+func TestDamageReportRepository_FindAll(t *testing.T) {
+	tests := []struct {
+		name       string
+		params     pagination.Params
+		want       []*model.DamageReport
+		wantTotal  int64
+		wantCursor *pagination.Cursor
+		setup      func(t *testing.T, client db.Client)
+	}{
+		{
+			name:      "Success/市区町村・工種区分とあわせてID順に取得",
+			params:    pagination.NewParams(1, 20, nil, nil),
+			want:      []*model.DamageReport{chiyodaDamageReport(), sapporoDamageReport(), marunouchiDamageReport()},
+			wantTotal: 3,
+			setup:     setupDamageReports,
+		},
+		{
+			name:      "Success/被災日の降順",
+			params:    pagination.NewParams(1, 20, []pagination.Sort{{Field: "damage_date", Desc: true}}, nil),
+			want:      []*model.DamageReport{marunouchiDamageReport(), chiyodaDamageReport(), sapporoDamageReport()},
+			wantTotal: 3,
+			setup:     setupDamageReports,
+		},
+		{
+			name:       "Success/1ページ目は次のページのカーソルを返す",
+			params:     pagination.NewParams(1, 1, []pagination.Sort{{Field: "damage_date"}}, nil),
+			want:       []*model.DamageReport{sapporoDamageReport()},
+			wantTotal:  3,
+			wantCursor: &pagination.Cursor{Sort: "damage_date,id", Keys: []string{"2026-06-15T00:00:00Z", "2"}},
+			setup:      setupDamageReports,
+		},
+		{
+			name: "Success/カーソルより後ろを取得",
+			params: func() pagination.Params {
+				p := pagination.NewParams(1, 20, []pagination.Sort{{Field: "estimated_amount", Desc: true}}, nil)
+				p.Cursor = &pagination.Cursor{Sort: "-estimated_amount,id", Keys: []string{"1200000", "2"}}
+				return p
+			}(),
+			want:      []*model.DamageReport{chiyodaDamageReport(), marunouchiDamageReport()},
+			wantTotal: 3,
+			setup:     setupDamageReports,
+		},
+		{
+			name:      "Success/団体コードと状態で絞り込み",
+			params:    pagination.NewParams(1, 20, nil, map[string]string{"organization_code": "131016", "status": "confirmed"}),
+			want:      []*model.DamageReport{marunouchiDamageReport()},
+			wantTotal: 1,
+			setup:     setupDamageReports,
+		},
+		{
+			name:      "Success/工種区分IDで絞り込み",
+			params:    pagination.NewParams(1, 20, nil, map[string]string{"work_category_id": "2"}),
+			want:      []*model.DamageReport{sapporoDamageReport(), marunouchiDamageReport()},
+			wantTotal: 2,
+			setup:     setupDamageReports,
+		},
+		{
+			name: "Success/被災日の期間で絞り込み",
+			params: pagination.NewParams(1, 20, nil, map[string]string{
+				"damage_date_from": "2026-06-16",
+				"damage_date_to":   "2026-07-01",
+			}),
+			want:      []*model.DamageReport{chiyodaDamageReport()},
+			wantTotal: 1,
+			setup:     setupDamageReports,
+		},
+		{
+			name:   "Success/データなし",
+			params: pagination.NewParams(1, 20, nil, nil),
+			want:   []*model.DamageReport{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			a := assert.New(t)
+
+			client := testutils.SetupTestDB(t)
+			defer client.Close()
+
+			repo := datastore.NewDamageReportRepository(ctx, client)
+
+			testutils.TruncateAllTables(t, client)
+
+			if tt.setup != nil {
+				tt.setup(t, client)
+			}
+
+			got, meta, err := repo.FindAll(ctx, tt.params)
+			a.NoError(err)
+			a.Equal(tt.wantTotal, meta.TotalCount)
+			a.Equal(tt.wantCursor, meta.NextCursor)
+
+			if !cmp.Equal(tt.want, got, testutils.IgnoreUpdatedAt) {
+				t.Errorf("diff %s", cmp.Diff(tt.want, got, testutils.IgnoreUpdatedAt))
+			}
+		})
+	}
+
+	t.Run("バリデーションエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, _ := testutils.NewTestClient(t)
+		repo := datastore.NewDamageReportRepository(ctx, client)
+
+		withCursor := func(sorts []pagination.Sort, cursor *pagination.Cursor) pagination.Params {
+			p := pagination.NewParams(1, 20, sorts, nil)
+			p.Cursor = cursor
+			return p
+		}
+
+		for name, params := range map[string]pagination.Params{
+			"failure/許可されていないソート項目":  pagination.NewParams(1, 20, []pagination.Sort{{Field: "location"}}, nil),
+			"failure/許可されていない絞り込み項目": pagination.NewParams(1, 20, nil, map[string]string{"location": "千代田"}),
+			"failure/数値でない工種区分ID":    pagination.NewParams(1, 20, nil, map[string]string{"work_category_id": "abc"}),
+			"failure/日付でない被災日":       pagination.NewParams(1, 20, nil, map[string]string{"damage_date_from": "2026/07/01"}),
+			"failure/日時でないカーソルの値": withCursor(
+				[]pagination.Sort{{Field: "damage_date"}},
+				&pagination.Cursor{Sort: "damage_date,id", Keys: []string{"2026-07-01", "1"}},
+			),
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, _, err := repo.FindAll(ctx, params)
+
+				var apiErr *myerrors.APIError
+				require.ErrorAs(t, err, &apiErr)
+				assert.Equal(t, myerrors.ValidationError, apiErr.Code)
+			})
+		}
+	})
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewDamageReportRepository(ctx, client)
+
+		t.Run("failure/Findエラー", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM \"damage_reports\" ORDER BY \"damage_reports\".\"id\" LIMIT $1")).
+				WithArgs(21).
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, _, err := repo.FindAll(ctx, pagination.NewParams(1, 20, nil, nil))
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+		})
+
+		t.Run("failure/Countエラー", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM \"damage_reports\" WHERE \"damage_reports\".\"status\" = $1 ORDER BY \"damage_reports\".\"id\" LIMIT $2")).
+				WithArgs("draft", 21).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM \"damage_reports\" WHERE \"damage_reports\".\"status\" = $1")).
+				WithArgs("draft").
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, _, err := repo.FindAll(ctx, pagination.NewParams(1, 20, nil, map[string]string{"status": "draft"}))
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+		})
+	})
+}
+
+func TestDamageReportRepository_FindByID(t *testing.T) {
+	tests := []struct {
+		name             string
+		id               int
+		want             *model.DamageReport
+		wantErrorMessage myerrors.ErrorMessage
+		setup            func(t *testing.T, client db.Client)
+	}{
+		{
+			name:  "Success/市区町村・工種区分とあわせて取得",
+			id:    1,
+			want:  chiyodaDamageReport(),
+			setup: setupDamageReports,
+		},
+		{
+			name:  "Success/被害面積が未確定",
+			id:    2,
+			want:  sapporoDamageReport(),
+			setup: setupDamageReports,
+		},
+		{
+			name:             "failure/NotFound",
+			id:               99,
+			wantErrorMessage: myerrors.DamageReportNotFoundErrorMessage,
+			setup:            setupDamageReports,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			a := assert.New(t)
+
+			client := testutils.SetupTestDB(t)
+			defer client.Close()
+
+			repo := datastore.NewDamageReportRepository(ctx, client)
+
+			testutils.TruncateAllTables(t, client)
+
+			if tt.setup != nil {
+				tt.setup(t, client)
+			}
+
+			got, err := repo.FindByID(ctx, tt.id)
+			if tt.wantErrorMessage != "" {
+				var apiErr *myerrors.APIError
+				require.ErrorAs(t, err, &apiErr)
+				a.Equal(tt.wantErrorMessage, apiErr.Message)
+				return
+			}
+			a.NoError(err)
+
+			if !cmp.Equal(tt.want, got, testutils.IgnoreUpdatedAt) {
+				t.Errorf("diff %s", cmp.Diff(tt.want, got, testutils.IgnoreUpdatedAt))
+			}
+		})
+	}
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewDamageReportRepository(ctx, client)
+
+		t.Run("failure/Firstエラー", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM \"damage_reports\" WHERE \"damage_reports\".\"id\" = $1 ORDER BY \"damage_reports\".\"id\" LIMIT $2")).
+				WithArgs(1, 1).
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, err := repo.FindByID(ctx, 1)
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+		})
+	})
+}
+
+func TestDamageReportRepository_Create(t *testing.T) {
+	t.Run("Success/登録した被害報告を取得できる", func(t *testing.T) {
+		ctx := context.Background()
+		a := assert.New(t)
+
+		client := testutils.SetupTestDB(t)
+		defer client.Close()
+
+		repo := datastore.NewDamageReportRepository(ctx, client)
+
+		testutils.TruncateAllTables(t, client)
+		setupDamageReports(t, client)
+
+		damageReport := &model.DamageReport{
+			OrganizationCode: "011002",
+			WorkCategoryID:   1,
+			DamageDate:       time.Date(2026, 8, 3, 0, 0, 0, 0, time.UTC),
+			Location:         "札幌市中央区南1条西5丁目地先",
+			EstimatedAmount:  800000,
+			Status:           domain.DamageReportStatusDraft,
+		}
+		a.NoError(repo.Create(ctx, damageReport))
+		a.Equal(int64(4), damageReport.ID)
+
+		got, err := repo.FindByID(ctx, 4)
+		a.NoError(err)
+
+		want := &model.DamageReport{
+			ID:               4,
+			OrganizationCode: "011002",
+			WorkCategoryID:   1,
+			DamageDate:       time.Date(2026, 8, 3, 0, 0, 0, 0, time.UTC),
+			Location:         "札幌市中央区南1条西5丁目地先",
+			EstimatedAmount:  800000,
+			Status:           domain.DamageReportStatusDraft,
+			Municipality:     *sapporoMunicipality(),
+			WorkCategory:     model.WorkCategory{ID: 1, CategoryName: "農地", SortOrder: 20, IsActive: true},
+		}
+		if !cmp.Equal(want, got, testutils.IgnoreUpdatedAt) {
+			t.Errorf("diff %s", cmp.Diff(want, got, testutils.IgnoreUpdatedAt))
+		}
+	})
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewDamageReportRepository(ctx, client)
+
+		t.Run("failure/Createエラー", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO \"damage_reports\"")).
+				WillReturnError(fmt.Errorf("db error"))
+			mock.ExpectRollback()
+
+			err := repo.Create(ctx, &model.DamageReport{OrganizationCode: "131016", WorkCategoryID: 1})
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+}
+
+func TestDamageReportRepository_Update(t *testing.T) {
+	tests := []struct {
+		name             string
+		damageReport     *model.DamageReport
+		want             *model.DamageReport
+		wantErrorMessage myerrors.ErrorMessage
+	}{
+		{
+			name: "Success/内容を更新し、被害面積を未確定に戻す。状態は変更しない",
+			damageReport: &model.DamageReport{
+				ID:               1,
+				OrganizationCode: "011002",
+				WorkCategoryID:   2,
+				DamageDate:       time.Date(2026, 7, 2, 0, 0, 0, 0, time.UTC),
+				Location:         "札幌市中央区北3条西4丁目地先",
+				EstimatedAmount:  650000,
+				Status:           domain.DamageReportStatusConfirmed,
+			},
+			want: &model.DamageReport{
+				ID:               1,
+				OrganizationCode: "011002",
+				WorkCategoryID:   2,
+				DamageDate:       time.Date(2026, 7, 2, 0, 0, 0, 0, time.UTC),
+				Location:         "札幌市中央区北3条西4丁目地先",
+				EstimatedAmount:  650000,
+				Status:           domain.DamageReportStatusDraft,
+				Municipality:     *sapporoMunicipality(),
+				WorkCategory:     model.WorkCategory{ID: 2, CategoryName: "水路", SortOrder: 10, IsActive: true},
+			},
+		},
+		{
+			name:             "failure/NotFound",
+			damageReport:     &model.DamageReport{ID: 99, OrganizationCode: "131016", WorkCategoryID: 1, Status: domain.DamageReportStatusDraft},
+			wantErrorMessage: myerrors.DamageReportNotFoundErrorMessage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			a := assert.New(t)
+
+			client := testutils.SetupTestDB(t)
+			defer client.Close()
+
+			repo := datastore.NewDamageReportRepository(ctx, client)
+
+			testutils.TruncateAllTables(t, client)
+			setupDamageReports(t, client)
+
+			err := repo.Update(ctx, tt.damageReport)
+			if tt.wantErrorMessage != "" {
+				var apiErr *myerrors.APIError
+				require.ErrorAs(t, err, &apiErr)
+				a.Equal(tt.wantErrorMessage, apiErr.Message)
+				return
+			}
+			a.NoError(err)
+
+			got, err := repo.FindByID(ctx, int(tt.damageReport.ID))
+			a.NoError(err)
+
+			if !cmp.Equal(tt.want, got, testutils.IgnoreUpdatedAt) {
+				t.Errorf("diff %s", cmp.Diff(tt.want, got, testutils.IgnoreUpdatedAt))
+			}
+		})
+	}
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewDamageReportRepository(ctx, client)
+
+		t.Run("failure/Updateエラー", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE \"damage_reports\" SET")).
+				WillReturnError(fmt.Errorf("db error"))
+			mock.ExpectRollback()
+
+			err := repo.Update(ctx, &model.DamageReport{ID: 1, OrganizationCode: "131016", WorkCategoryID: 1})
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+}
+
+func TestDamageReportRepository_Transition(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            int
+		from          string
+		to            string
+		wantStatus    string
+		wantErrorCode myerrors.ErrorCode
+	}{
+		{
+			name:       "Success/遷移前の状態の場合は状態を変更する",
+			id:         2,
+			from:       domain.DamageReportStatusReported,
+			to:         domain.DamageReportStatusConfirmed,
+			wantStatus: domain.DamageReportStatusConfirmed,
+		},
+		{
+			name:          "failure/遷移前の状態が異なる場合は遷移しない",
+			id:            2,
+			from:          domain.DamageReportStatusDraft,
+			to:            domain.DamageReportStatusReported,
+			wantStatus:    domain.DamageReportStatusReported,
+			wantErrorCode: myerrors.InvalidStateTransitionError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			a := assert.New(t)
+
+			client := testutils.SetupTestDB(t)
+			defer client.Close()
+
+			repo := datastore.NewDamageReportRepository(ctx, client)
+
+			testutils.TruncateAllTables(t, client)
+			setupDamageReports(t, client)
+
+			err := repo.Transition(ctx, tt.id, tt.from, tt.to)
+			if tt.wantErrorCode != "" {
+				var apiErr *myerrors.APIError
+				require.ErrorAs(t, err, &apiErr)
+				a.Equal(tt.wantErrorCode, apiErr.Code)
+			} else {
+				a.NoError(err)
+			}
+
+			got, err := repo.FindByID(ctx, tt.id)
+			a.NoError(err)
+			a.Equal(tt.wantStatus, got.Status)
+		})
+	}
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewDamageReportRepository(ctx, client)
+
+		t.Run("failure/Updateエラー", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE \"damage_reports\" SET \"status\"")).
+				WillReturnError(fmt.Errorf("db error"))
+			mock.ExpectRollback()
+
+			err := repo.Transition(ctx, 1, domain.DamageReportStatusDraft, domain.DamageReportStatusReported)
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+}
+
+func TestDamageReportRepository_PrefillFromPhoto(t *testing.T) {
+	latitude := 35.685175
+	longitude := 139.752799
+	savedLatitude := 35.6
+	savedLongitude := 139.7
+
+	tests := []struct {
+		name string
+		// setup 写真を添付する前の被害報告の状態を変える
+		setup func(t *testing.T, client db.Client)
+		id    int
+		want  *model.DamageReport
+	}{
+		{
+			name: "Success/未設定の位置を設定し、被災日は変えない",
+			id:   1,
+			want: func() *model.DamageReport {
+				d := chiyodaDamageReport()
+				d.Latitude = &latitude
+				d.Longitude = &longitude
+
+				return d
+			}(),
+		},
+		{
+			name: "Success/位置が設定済みの場合は変えない",
+			setup: func(t *testing.T, client db.Client) {
+				require.NoError(t, client.Conn(context.Background()).
+					Exec("UPDATE damage_reports SET latitude = ?, longitude = ? WHERE id = ?", savedLatitude, savedLongitude, 1).Error)
+			},
+			id: 1,
+			want: func() *model.DamageReport {
+				d := chiyodaDamageReport()
+				d.Latitude = &savedLatitude
+				d.Longitude = &savedLongitude
+
+				return d
+			}(),
+		},
+		{
+			name: "Success/作成中でない被害報告は変更しない",
+			id:   2,
+			want: sapporoDamageReport(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			a := assert.New(t)
+
+			client := testutils.SetupTestDB(t)
+			defer client.Close()
+
+			repo := datastore.NewDamageReportRepository(ctx, client)
+
+			testutils.TruncateAllTables(t, client)
+			setupDamageReports(t, client)
+
+			if tt.setup != nil {
+				tt.setup(t, client)
+			}
+
+			a.NoError(repo.PrefillFromPhoto(ctx, tt.id, latitude, longitude))
+
+			got, err := repo.FindByID(ctx, tt.id)
+			a.NoError(err)
+
+			if !cmp.Equal(tt.want, got, testutils.IgnoreUpdatedAt) {
+				t.Errorf("diff %s", cmp.Diff(tt.want, got, testutils.IgnoreUpdatedAt))
+			}
+		})
+	}
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewDamageReportRepository(ctx, client)
+
+		t.Run("failure/Updateエラー", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE \"damage_reports\" SET \"latitude\"=$1,\"longitude\"=$2,\"updated_at\"=$3 WHERE \"damage_reports\".\"id\" = $4 AND \"damage_reports\".\"status\" = $5 AND \"damage_reports\".\"latitude\" IS NULL")).
+				WillReturnError(fmt.Errorf("db error"))
+			mock.ExpectRollback()
+
+			err := repo.PrefillFromPhoto(ctx, 1, latitude, longitude)
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+}
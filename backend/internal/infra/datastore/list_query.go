@@ -0,0 +1,284 @@
+package datastore
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/pagination"
+)
+
+// sortColumn ソートに利用できるフィールド
+type sortColumn[T any] struct {
+	order field.OrderExpr
+	// after カーソルの値より後ろ（昇順なら大きい、降順なら小さい）の行を表す条件
+	after func(key string, desc bool) (field.Expr, error)
+	// equal カーソルの値と等しい行を表す条件
+	equal func(key string) (field.Expr, error)
+	// key 行からカーソルに保存する値を取り出す
+	key func(row T) string
+}
+
+func stringSortColumn[T any](f field.String, key func(row T) string) sortColumn[T] {
+	return sortColumn[T]{
+		order: f,
+		after: func(k string, desc bool) (field.Expr, error) {
+			if desc {
+				return f.Lt(k), nil
+			}
+
+			return f.Gt(k), nil
+		},
+		equal: func(k string) (field.Expr, error) { return f.Eq(k), nil },
+		key:   key,
+	}
+}
+
+func int32SortColumn[T any](f field.Int32, key func(row T) int32) sortColumn[T] {
+	parse := func(k string) (int32, error) {
+		v, err := strconv.ParseInt(k, 10, 32)
+		return int32(v), err
+	}
+
+	return sortColumn[T]{
+		order: f,
+		after: func(k string, desc bool) (field.Expr, error) {
+			v, err := parse(k)
+			if err != nil {
+				return nil, err
+			}
+
+			if desc {
+				return f.Lt(v), nil
+			}
+
+			return f.Gt(v), nil
+		},
+		equal: func(k string) (field.Expr, error) {
+			v, err := parse(k)
+			if err != nil {
+				return nil, err
+			}
+
+			return f.Eq(v), nil
+		},
+		key: func(row T) string { return strconv.FormatInt(int64(key(row)), 10) },
+	}
+}
+
+func int64SortColumn[T any](f field.Int64, key func(row T) int64) sortColumn[T] {
+	parse := func(k string) (int64, error) { return strconv.ParseInt(k, 10, 64) }
+
+	return sortColumn[T]{
+		order: f,
+		after: func(k string, desc bool) (field.Expr, error) {
+			v, err := parse(k)
+			if err != nil {
+				return nil, err
+			}
+
+			if desc {
+				return f.Lt(v), nil
+			}
+
+			return f.Gt(v), nil
+		},
+		equal: func(k string) (field.Expr, error) {
+			v, err := parse(k)
+			if err != nil {
+				return nil, err
+			}
+
+			return f.Eq(v), nil
+		},
+		key: func(row T) string { return strconv.FormatInt(key(row), 10) },
+	}
+}
+
+// timeSortColumn 日時のフィールド。カーソルにはRFC3339形式（ナノ秒まで）で保存する
+func timeSortColumn[T any](f field.Time, key func(row T) time.Time) sortColumn[T] {
+	parse := func(k string) (time.Time, error) { return time.Parse(time.RFC3339Nano, k) }
+
+	return sortColumn[T]{
+		order: f,
+		after: func(k string, desc bool) (field.Expr, error) {
+			v, err := parse(k)
+			if err != nil {
+				return nil, err
+			}
+
+			if desc {
+				return f.Lt(v), nil
+			}
+
+			return f.Gt(v), nil
+		},
+		equal: func(k string) (field.Expr, error) {
+			v, err := parse(k)
+			if err != nil {
+				return nil, err
+			}
+
+			return f.Eq(v), nil
+		},
+		key: func(row T) string { return key(row).Format(time.RFC3339Nano) },
+	}
+}
+
+// listQuery 一覧取得で利用できるソート・絞り込みのフィールド定義
+// APIで指定されたフィールド名をそのままSQLに渡さないよう、ここに定義したフィールドのみを許可する
+type listQuery[T any] struct {
+	// sorts ソートに利用できるフィールド
+	sorts map[string]sortColumn[T]
+	// filters 絞り込みに利用できるフィールドと条件の組み立て方。値を解釈できない場合はエラーを返す
+	filters map[string]func(value string) (gen.Condition, error)
+	// defaultSorts ソート条件の指定がない場合の並び順。空の場合はtieBreakerの昇順とする
+	defaultSorts []pagination.Sort
+	// tieBreaker 並び順を一意に定めるための一意なフィールド。ソート条件の最後に昇順で付与する
+	tieBreaker string
+}
+
+// listPlan 一覧取得の条件をgenの検索条件と並び順に変換したもの
+type listPlan[T any] struct {
+	// conds 絞り込み条件。総件数の取得にも使う
+	conds []gen.Condition
+	// after カーソルより後ろの行に限定する条件。カーソルを指定しない場合は空
+	after  []gen.Condition
+	orders []field.Expr
+	sorts  []pagination.Sort
+	query  listQuery[T]
+}
+
+// plan 一覧取得の条件をgenの検索条件と並び順に変換する
+// 許可されていないフィールドや、ソート条件と一致しないカーソルが指定された場合はバリデーションエラーを返す
+func (q listQuery[T]) plan(params pagination.Params) (*listPlan[T], error) {
+	// 生成されるSQLが毎回同じになるようフィールド名順に条件を組み立てる
+	names := make([]string, 0, len(params.Filters))
+	for name := range params.Filters {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	conds := make([]gen.Condition, 0, len(names))
+	for _, name := range names {
+		filter, ok := q.filters[name]
+		if !ok {
+			return nil, invalidListQueryError(fmt.Errorf("unknown filter field: %s", name))
+		}
+
+		cond, err := filter(params.Filters[name])
+		if err != nil {
+			return nil, invalidListQueryError(fmt.Errorf("invalid filter value for %s: %w", name, err))
+		}
+
+		conds = append(conds, cond)
+	}
+
+	requested := params.Sorts
+	if len(requested) == 0 {
+		requested = q.defaultSorts
+	}
+
+	sorts := make([]pagination.Sort, 0, len(requested)+1)
+	hasTieBreaker := false
+	for _, s := range requested {
+		if _, ok := q.sorts[s.Field]; !ok {
+			return nil, invalidListQueryError(fmt.Errorf("unknown sort field: %s", s.Field))
+		}
+
+		sorts = append(sorts, s)
+		hasTieBreaker = hasTieBreaker || s.Field == q.tieBreaker
+	}
+
+	if !hasTieBreaker {
+		sorts = append(sorts, pagination.Sort{Field: q.tieBreaker})
+	}
+
+	orders := make([]field.Expr, len(sorts))
+	for i, s := range sorts {
+		orders[i] = q.sorts[s.Field].order
+		if s.Desc {
+			orders[i] = q.sorts[s.Field].order.Desc()
+		}
+	}
+
+	p := &listPlan[T]{conds: conds, orders: orders, sorts: sorts, query: q}
+	if params.Cursor != nil {
+		after, err := p.afterCursor(params.Cursor)
+		if err != nil {
+			return nil, invalidListQueryError(err)
+		}
+
+		p.after = []gen.Condition{after}
+	}
+
+	return p, nil
+}
+
+// afterCursor カーソルより後ろの行に限定する条件を組み立てる
+// ソート条件が (a, b) の場合、a > ka OR (a = ka AND b > kb) となる
+func (p *listPlan[T]) afterCursor(cursor *pagination.Cursor) (field.Expr, error) {
+	if cursor.Sort != pagination.FormatSort(p.sorts) || len(cursor.Keys) != len(p.sorts) {
+		return nil, fmt.Errorf("cursor does not match sort: %q", cursor.Sort)
+	}
+
+	alternatives := make([]field.Expr, len(p.sorts))
+	for i, s := range p.sorts {
+		exprs := make([]field.Expr, 0, i+1)
+		for j := range i {
+			eq, err := p.query.sorts[p.sorts[j].Field].equal(cursor.Keys[j])
+			if err != nil {
+				return nil, fmt.Errorf("invalid cursor key for %s: %w", p.sorts[j].Field, err)
+			}
+
+			exprs = append(exprs, eq)
+		}
+
+		after, err := p.query.sorts[s.Field].after(cursor.Keys[i], s.Desc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor key for %s: %w", s.Field, err)
+		}
+
+		alternatives[i] = field.And(append(exprs, after)...)
+	}
+
+	// 条件が1つの場合にfield.Orを使うと、前の絞り込み条件とORで結合されてしまう
+	if len(alternatives) == 1 {
+		return alternatives[0], nil
+	}
+
+	return field.Or(alternatives...), nil
+}
+
+// page 1件多く取得した結果から1ページ分の行とページ情報を組み立てる
+func (p *listPlan[T]) page(params pagination.Params, rows []T, totalCount int64) ([]T, pagination.Meta) {
+	var next *pagination.Cursor
+	if len(rows) > params.PerPage {
+		rows = rows[:params.PerPage]
+		last := rows[len(rows)-1]
+
+		keys := make([]string, len(p.sorts))
+		for i, s := range p.sorts {
+			keys[i] = p.query.sorts[s.Field].key(last)
+		}
+
+		next = &pagination.Cursor{Sort: pagination.FormatSort(p.sorts), Keys: keys}
+	}
+
+	return rows, pagination.NewMeta(params, totalCount, next)
+}
+
+func invalidListQueryError(err error) error {
+	return myerrors.NewAPIError(
+		myerrors.ValidationError,
+		myerrors.ValidationErrorMessage,
+		err,
+		"invalid list query",
+	)
+}
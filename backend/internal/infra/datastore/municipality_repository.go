@@ -1 +1,242 @@
 package datastore
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+
+	"g_gen/internal/domain/model"
+	"g_gen/internal/domain/query"
+	domain "g_gen/internal/domain/repository"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/infra/db"
+	"g_gen/internal/pagination"
+)
+
+type municipalityRepository struct {
+	client db.Client
+	query  *query.Query
+}
+
+func NewMunicipalityRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.Municipality {
+	return &municipalityRepository{
+		client: client,
+		query:  query.Use(client.Conn(ctx)),
+	}
+}
+
+// municipalityListQuery 市区町村一覧で利用できるソート・絞り込みのフィールド
+func (r *municipalityRepository) municipalityListQuery() listQuery[*model.Municipality] {
+	m := r.query.Municipality
+
+	return listQuery[*model.Municipality]{
+		sorts: map[string]sortColumn[*model.Municipality]{
+			"id": int64SortColumn(m.ID, func(row *model.Municipality) int64 { return row.ID }),
+			"organization_code": stringSortColumn(m.OrganizationCode, func(row *model.Municipality) string {
+				return row.OrganizationCode
+			}),
+			"prefecture_code": stringSortColumn(m.PrefectureCode, func(row *model.Municipality) string {
+				return row.PrefectureCode
+			}),
+			"municipality_name_kana": stringSortColumn(m.MunicipalityNameKana, func(row *model.Municipality) string {
+				return row.MunicipalityNameKana
+			}),
+		},
+		filters: map[string]func(value string) (gen.Condition, error){
+			"prefecture_code": func(v string) (gen.Condition, error) { return m.PrefectureCode.Eq(v), nil },
+			"municipality_name_kanji": func(v string) (gen.Condition, error) {
+				return m.MunicipalityNameKanji.Like(containsPattern(v)), nil
+			},
+		},
+		tieBreaker: "organization_code",
+	}
+}
+
+// FindAll 有効な市区町村を1ページ分取得する。並び順の指定がない場合は団体コード順とする
+func (r *municipalityRepository) FindAll(
+	ctx context.Context,
+	params pagination.Params,
+) ([]*model.Municipality, pagination.Meta, error) {
+	return r.findPage(ctx, params, r.query.Municipality.IsActive.Is(true))
+}
+
+func (r *municipalityRepository) FindByID(ctx context.Context, id int) (*model.Municipality, error) {
+	municipality, err := r.query.WithContext(ctx).
+		Municipality.
+		Where(r.query.Municipality.ID.Eq(int64(id))).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &myerrors.APIError{
+				Code:    myerrors.MunicipalityNotFoundError,
+				Message: myerrors.MunicipalityNotFoundErrorMessage,
+			}
+		}
+
+		return nil, err
+	}
+
+	return municipality, nil
+}
+
+// FindByOrganizationCode 団体コードで市区町村を取得する。合併などで無効化された市区町村も対象とする
+func (r *municipalityRepository) FindByOrganizationCode(
+	ctx context.Context,
+	organizationCode string,
+) (*model.Municipality, error) {
+	municipality, err := r.query.WithContext(ctx).
+		Municipality.
+		Where(r.query.Municipality.OrganizationCode.Eq(organizationCode)).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &myerrors.APIError{
+				Code:    myerrors.MunicipalityNotFoundError,
+				Message: myerrors.MunicipalityNotFoundErrorMessage,
+			}
+		}
+
+		return nil, err
+	}
+
+	return municipality, nil
+}
+
+// FindByPrefectureCode 都道府県に属する有効な市区町村を1ページ分取得する。並び順の指定がない場合は団体コード順とする
+func (r *municipalityRepository) FindByPrefectureCode(
+	ctx context.Context,
+	prefectureCode string,
+	params pagination.Params,
+) ([]*model.Municipality, pagination.Meta, error) {
+	return r.findPage(
+		ctx,
+		params,
+		r.query.Municipality.PrefectureCode.Eq(prefectureCode),
+		r.query.Municipality.IsActive.Is(true),
+	)
+}
+
+// findPage 1ページ分の市区町村と、カーソルを除いた絞り込み条件に一致する総件数を取得する
+func (r *municipalityRepository) findPage(
+	ctx context.Context,
+	params pagination.Params,
+	baseConds ...gen.Condition,
+) ([]*model.Municipality, pagination.Meta, error) {
+	plan, err := r.municipalityListQuery().plan(params)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	conds := append(baseConds, plan.conds...)
+
+	// 次のページの有無を判定するため1件多く取得する
+	municipalities, err := r.query.WithContext(ctx).
+		Municipality.
+		Where(append(conds, plan.after...)...).
+		Order(plan.orders...).
+		Offset(params.Offset()).
+		Limit(params.PerPage + 1).
+		Find()
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	count, err := r.query.WithContext(ctx).
+		Municipality.
+		Where(conds...).
+		Count()
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	municipalities, meta := plan.page(params, municipalities, count)
+
+	return municipalities, meta, nil
+}
+
+func (r *municipalityRepository) Search(
+	ctx context.Context,
+	cond domain.MunicipalitySearchCondition,
+) ([]*model.Municipality, error) {
+	m := r.query.Municipality
+
+	nameConds := make([]field.Expr, 0, len(cond.NameKeywords)+1)
+	for _, keyword := range cond.NameKeywords {
+		nameConds = append(nameConds, m.MunicipalityNameKanji.Like(containsPattern(keyword)))
+	}
+
+	if cond.KanaKeyword != "" {
+		nameConds = append(nameConds, m.MunicipalityNameKana.Like(containsPattern(cond.KanaKeyword)))
+	}
+
+	if len(nameConds) == 0 {
+		return []*model.Municipality{}, nil
+	}
+
+	conds := []gen.Condition{m.IsActive.Is(true), field.Or(nameConds...)}
+	if cond.PrefectureCode != "" {
+		conds = append(conds, m.PrefectureCode.Eq(cond.PrefectureCode))
+	}
+
+	municipalities, err := r.query.WithContext(ctx).
+		Municipality.
+		Where(conds...).
+		Order(m.OrganizationCode).
+		Find()
+	if err != nil {
+		return nil, err
+	}
+
+	return municipalities, nil
+}
+
+// Statistics 無効化された市区町村を含めた件数を都道府県ごとに集計し、全体の件数は都道府県ごとの件数の合計とする
+func (r *municipalityRepository) Statistics(ctx context.Context) (*domain.MunicipalityStatistics, error) {
+	m := r.query.Municipality
+
+	var counts []*domain.PrefectureMunicipalityCount
+	err := m.WithContext(ctx).
+		UnderlyingDB().
+		Select(
+			"prefecture_code",
+			"MIN(prefecture_name_kanji) AS prefecture_name",
+			"COUNT(*) AS total",
+			"COUNT(*) FILTER (WHERE municipality_name_kanji = '') AS prefecture_level",
+			"COUNT(*) FILTER (WHERE municipality_name_kanji <> '') AS municipality_level",
+			"COUNT(*) FILTER (WHERE is_active) AS active",
+			"COUNT(*) FILTER (WHERE NOT is_active) AS inactive",
+		).
+		Group("prefecture_code").
+		Order("prefecture_code").
+		Scan(&counts).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &domain.MunicipalityStatistics{Prefectures: make([]*domain.PrefectureMunicipalityCount, 0, len(counts))}
+	for _, c := range counts {
+		stats.Total += c.Total
+		stats.PrefectureLevel += c.PrefectureLevel
+		stats.MunicipalityLevel += c.MunicipalityLevel
+		stats.Active += c.Active
+		stats.Inactive += c.Inactive
+		stats.Prefectures = append(stats.Prefectures, c)
+	}
+
+	return stats, nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// containsPattern 部分一致検索用のLIKEパターンを組み立てる
+func containsPattern(keyword string) string {
+	return "%" + likeEscaper.Replace(keyword) + "%"
+}
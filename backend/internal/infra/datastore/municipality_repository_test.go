@@ -0,0 +1,665 @@
+package datastore_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/domain/model"
+	domain "g_gen/internal/domain/repository"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/infra/datastore"
+	"g_gen/internal/infra/db"
+	"g_gen/internal/pagination"
+	"g_gen/tests/testutils"
+)
+
+func setupMunicipalities(t *testing.T, client db.Client) {
+	r := require.New(t)
+	conn := client.Conn(context.Background())
+	r.NoError(conn.Exec(
+		"INSERT INTO prefectures (id, name, code, region_id) VALUES (1, '北海道', '01', 1), (13, '東京都', '13', 2)",
+	).Error)
+	r.NoError(conn.Exec(
+		"INSERT INTO municipalities (prefecture_code, organization_code, prefecture_name_kanji, municipality_name_kanji, prefecture_name_kana, municipality_name_kana) VALUES (?, ?, ?, ?, ?, ?)",
+		"13", "131016", "東京都", "千代田区", "ﾄｳｷｮｳﾄ", "ﾁﾖﾀﾞｸ").Error,
+	)
+	r.NoError(conn.Exec(
+		"INSERT INTO municipalities (prefecture_code, organization_code, prefecture_name_kanji, municipality_name_kanji, prefecture_name_kana, municipality_name_kana) VALUES (?, ?, ?, ?, ?, ?)",
+		"01", "011002", "北海道", "札幌市", "ﾎｯｶｲﾄﾞｳ", "ｻｯﾎﾟﾛｼ").Error,
+	)
+	r.NoError(conn.Exec(
+		"INSERT INTO municipalities (prefecture_code, organization_code, prefecture_name_kanji, municipality_name_kanji, prefecture_name_kana, municipality_name_kana, is_active) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		"13", "132047", "東京都", "三鷹市", "ﾄｳｷｮｳﾄ", "ﾐﾀｶｼ", false).Error,
+	)
+}
+
+func sapporoMunicipality() *model.Municipality {
+	return &model.Municipality{
+		ID:                    2,
+		PrefectureCode:        "01",
+		OrganizationCode:      "011002",
+		PrefectureNameKanji:   "北海道",
+		MunicipalityNameKanji: "札幌市",
+		PrefectureNameKana:    "ﾎｯｶｲﾄﾞｳ",
+		MunicipalityNameKana:  "ｻｯﾎﾟﾛｼ",
+		IsActive:              true,
+	}
+}
+
+func chiyodaMunicipality() *model.Municipality {
+	return &model.Municipality{
+		ID:                    1,
+		PrefectureCode:        "13",
+		OrganizationCode:      "131016",
+		PrefectureNameKanji:   "東京都",
+		MunicipalityNameKanji: "千代田区",
+		PrefectureNameKana:    "ﾄｳｷｮｳﾄ",
+		MunicipalityNameKana:  "ﾁﾖﾀﾞｸ",
+		IsActive:              true,
+	}
+}
+
+func TestMunicipalityRepository_FindAll(t *testing.T) {
+	tests := []struct {
+		name       string
+		params     pagination.Params
+		want       []*model.Municipality
+		wantTotal  int64
+		wantCursor *pagination.Cursor
+		setup      func(t *testing.T, client db.Client)
+	}{
+		{
+			name:      "Success/isActive trueのみ団体コード順に取得",
+			params:    pagination.NewParams(1, 20, nil, nil),
+			want:      []*model.Municipality{sapporoMunicipality(), chiyodaMunicipality()},
+			wantTotal: 2,
+			setup:     setupMunicipalities,
+		},
+		{
+			name:      "Success/都道府県コードの降順",
+			params:    pagination.NewParams(1, 20, []pagination.Sort{{Field: "prefecture_code", Desc: true}}, nil),
+			want:      []*model.Municipality{chiyodaMunicipality(), sapporoMunicipality()},
+			wantTotal: 2,
+			setup:     setupMunicipalities,
+		},
+		{
+			name:       "Success/1ページ目は次のページのカーソルを返す",
+			params:     pagination.NewParams(1, 1, nil, nil),
+			want:       []*model.Municipality{sapporoMunicipality()},
+			wantTotal:  2,
+			wantCursor: &pagination.Cursor{Sort: "organization_code", Keys: []string{"011002"}},
+			setup:      setupMunicipalities,
+		},
+		{
+			name:      "Success/2ページ目",
+			params:    pagination.NewParams(2, 1, nil, nil),
+			want:      []*model.Municipality{chiyodaMunicipality()},
+			wantTotal: 2,
+			setup:     setupMunicipalities,
+		},
+		{
+			name: "Success/カーソルより後ろを取得",
+			params: func() pagination.Params {
+				p := pagination.NewParams(1, 1, nil, nil)
+				p.Cursor = &pagination.Cursor{Sort: "organization_code", Keys: []string{"011002"}}
+				return p
+			}(),
+			want:      []*model.Municipality{chiyodaMunicipality()},
+			wantTotal: 2,
+			setup:     setupMunicipalities,
+		},
+		{
+			name: "Success/降順のカーソル",
+			params: func() pagination.Params {
+				p := pagination.NewParams(1, 1, []pagination.Sort{{Field: "prefecture_code", Desc: true}}, nil)
+				p.Cursor = &pagination.Cursor{Sort: "-prefecture_code,organization_code", Keys: []string{"13", "131016"}}
+				return p
+			}(),
+			want:      []*model.Municipality{sapporoMunicipality()},
+			wantTotal: 2,
+			setup:     setupMunicipalities,
+		},
+		{
+			name:      "Success/都道府県コードで絞り込み",
+			params:    pagination.NewParams(1, 20, nil, map[string]string{"prefecture_code": "01"}),
+			want:      []*model.Municipality{sapporoMunicipality()},
+			wantTotal: 1,
+			setup:     setupMunicipalities,
+		},
+		{
+			name:      "Success/市区町村名の部分一致で絞り込み",
+			params:    pagination.NewParams(1, 20, nil, map[string]string{"municipality_name_kanji": "千代田"}),
+			want:      []*model.Municipality{chiyodaMunicipality()},
+			wantTotal: 1,
+			setup:     setupMunicipalities,
+		},
+		{
+			name:   "Success/データなし",
+			params: pagination.NewParams(1, 20, nil, nil),
+			want:   []*model.Municipality{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			a := assert.New(t)
+
+			client := testutils.SetupTestDB(t)
+			defer client.Close()
+
+			repo := datastore.NewMunicipalityRepository(ctx, client)
+
+			testutils.TruncateAllTables(t, client)
+
+			if tt.setup != nil {
+				tt.setup(t, client)
+			}
+
+			got, meta, err := repo.FindAll(ctx, tt.params)
+			a.NoError(err)
+			a.Equal(tt.wantTotal, meta.TotalCount)
+			a.Equal(tt.wantCursor, meta.NextCursor)
+
+			if !cmp.Equal(tt.want, got, testutils.IgnoreUpdatedAt) {
+				t.Errorf("diff %s", cmp.Diff(tt.want, got, testutils.IgnoreUpdatedAt))
+			}
+		})
+	}
+
+	t.Run("バリデーションエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, _ := testutils.NewTestClient(t)
+		repo := datastore.NewMunicipalityRepository(ctx, client)
+
+		withCursor := func(sorts []pagination.Sort, cursor *pagination.Cursor) pagination.Params {
+			p := pagination.NewParams(1, 20, sorts, nil)
+			p.Cursor = cursor
+			return p
+		}
+
+		for name, params := range map[string]pagination.Params{
+			"failure/許可されていないソート項目":  pagination.NewParams(1, 20, []pagination.Sort{{Field: "is_active"}}, nil),
+			"failure/許可されていない絞り込み項目": pagination.NewParams(1, 20, nil, map[string]string{"is_active": "false"}),
+			"failure/ソート条件と一致しないカーソル": withCursor(
+				[]pagination.Sort{{Field: "prefecture_code"}},
+				&pagination.Cursor{Sort: "organization_code", Keys: []string{"011002"}},
+			),
+			"failure/カーソルの値の数が一致しない": withCursor(
+				nil,
+				&pagination.Cursor{Sort: "organization_code", Keys: []string{"011002", "extra"}},
+			),
+			"failure/数値でないカーソルの値": withCursor(
+				[]pagination.Sort{{Field: "id"}},
+				&pagination.Cursor{Sort: "id,organization_code", Keys: []string{"abc", "011002"}},
+			),
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, _, err := repo.FindAll(ctx, params)
+
+				var apiErr *myerrors.APIError
+				require.ErrorAs(t, err, &apiErr)
+				assert.Equal(t, myerrors.ValidationError, apiErr.Code)
+			})
+		}
+	})
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewMunicipalityRepository(ctx, client)
+
+		t.Run("failure/Findエラー", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM \"municipalities\" WHERE \"municipalities\".\"is_active\" = $1 ORDER BY \"municipalities\".\"organization_code\" LIMIT $2")).
+				WithArgs(true, 21).
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, _, err := repo.FindAll(ctx, pagination.NewParams(1, 20, nil, nil))
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+		})
+
+		t.Run("failure/Countエラー", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM \"municipalities\" WHERE \"municipalities\".\"is_active\" = $1 ORDER BY \"municipalities\".\"organization_code\" LIMIT $2 OFFSET $3")).
+				WithArgs(true, 21, 20).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM \"municipalities\" WHERE \"municipalities\".\"is_active\" = $1")).
+				WithArgs(true).
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, _, err := repo.FindAll(ctx, pagination.NewParams(2, 20, nil, nil))
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+		})
+
+		t.Run("failure/カーソル指定時のFindエラー", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM \"municipalities\" WHERE \"municipalities\".\"is_active\" = $1 AND (\"municipalities\".\"prefecture_code\" < $2 OR (\"municipalities\".\"prefecture_code\" = $3 AND \"municipalities\".\"organization_code\" > $4)) ORDER BY \"municipalities\".\"prefecture_code\" DESC,\"municipalities\".\"organization_code\" LIMIT $5")).
+				WithArgs(true, "13", "13", "131016", 21).
+				WillReturnError(fmt.Errorf("db error"))
+
+			params := pagination.NewParams(1, 20, []pagination.Sort{{Field: "prefecture_code", Desc: true}}, nil)
+			params.Cursor = &pagination.Cursor{Sort: "-prefecture_code,organization_code", Keys: []string{"13", "131016"}}
+			_, _, err := repo.FindAll(ctx, params)
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+		})
+	})
+}
+
+func TestMunicipalityRepository_FindByID(t *testing.T) {
+	tests := []struct {
+		name             string
+		id               int
+		want             *model.Municipality
+		wantErr          bool
+		wantErrorCode    myerrors.ErrorCode
+		wantErrorMessage myerrors.ErrorMessage
+		setup            func(t *testing.T, client db.Client)
+	}{
+		{
+			name: "Success/無効な市区町村も取得",
+			id:   3,
+			want: &model.Municipality{
+				ID:                    3,
+				PrefectureCode:        "13",
+				OrganizationCode:      "132047",
+				PrefectureNameKanji:   "東京都",
+				MunicipalityNameKanji: "三鷹市",
+				PrefectureNameKana:    "ﾄｳｷｮｳﾄ",
+				MunicipalityNameKana:  "ﾐﾀｶｼ",
+				IsActive:              false,
+			},
+			setup: setupMunicipalities,
+		},
+		{
+			name:             "failure/NotFound",
+			id:               999,
+			wantErr:          true,
+			wantErrorCode:    myerrors.MunicipalityNotFoundError,
+			wantErrorMessage: myerrors.MunicipalityNotFoundErrorMessage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			a := assert.New(t)
+
+			client := testutils.SetupTestDB(t)
+			defer client.Close()
+
+			repo := datastore.NewMunicipalityRepository(ctx, client)
+
+			testutils.TruncateAllTables(t, client)
+
+			if tt.setup != nil {
+				tt.setup(t, client)
+			}
+
+			got, err := repo.FindByID(ctx, tt.id)
+			if tt.wantErr {
+				var apiErr *myerrors.APIError
+				if !a.ErrorAs(err, &apiErr) {
+					t.Errorf("expected error of type *myerrors.APIError, got %T", err)
+
+					return
+				}
+				a.Equal(tt.wantErrorCode, apiErr.Code)
+				a.Equal(tt.wantErrorMessage, apiErr.Message)
+
+				return
+			}
+
+			a.NoError(err)
+
+			if !cmp.Equal(tt.want, got, testutils.IgnoreUpdatedAt) {
+				t.Errorf("diff %s", cmp.Diff(tt.want, got, testutils.IgnoreUpdatedAt))
+			}
+		})
+	}
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewMunicipalityRepository(ctx, client)
+
+		t.Run("failure/Firstエラー", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM \"municipalities\" WHERE \"municipalities\".\"id\" = $1 ORDER BY \"municipalities\".\"id\" LIMIT $2")).
+				WithArgs(1, 1).
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, err := repo.FindByID(ctx, 1)
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+		})
+	})
+}
+
+func TestMunicipalityRepository_FindByOrganizationCode(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          string
+		wantID        int64
+		wantErrorCode myerrors.ErrorCode
+	}{
+		{
+			name:   "Success/無効な市区町村も取得",
+			code:   "132047",
+			wantID: 3,
+		},
+		{
+			name:          "failure/NotFound",
+			code:          "999999",
+			wantErrorCode: myerrors.MunicipalityNotFoundError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			a := assert.New(t)
+
+			client := testutils.SetupTestDB(t)
+			defer client.Close()
+
+			repo := datastore.NewMunicipalityRepository(ctx, client)
+
+			testutils.TruncateAllTables(t, client)
+			setupMunicipalities(t, client)
+
+			got, err := repo.FindByOrganizationCode(ctx, tt.code)
+			if tt.wantErrorCode != "" {
+				var apiErr *myerrors.APIError
+				require.ErrorAs(t, err, &apiErr)
+				a.Equal(tt.wantErrorCode, apiErr.Code)
+
+				return
+			}
+
+			a.NoError(err)
+			a.Equal(tt.wantID, got.ID)
+		})
+	}
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewMunicipalityRepository(ctx, client)
+
+		t.Run("failure/Firstエラー", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM \"municipalities\" WHERE \"municipalities\".\"organization_code\" = $1 ORDER BY \"municipalities\".\"id\" LIMIT $2")).
+				WithArgs("011002", 1).
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, err := repo.FindByOrganizationCode(ctx, "011002")
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+		})
+	})
+}
+
+func TestMunicipalityRepository_FindByPrefectureCode(t *testing.T) {
+	tests := []struct {
+		name      string
+		code      string
+		want      []*model.Municipality
+		wantTotal int64
+		wantErr   bool
+		setup     func(t *testing.T, client db.Client)
+	}{
+		{
+			name:      "Success/isActive trueのみ取得",
+			code:      "13",
+			want:      []*model.Municipality{chiyodaMunicipality()},
+			wantTotal: 1,
+			setup:     setupMunicipalities,
+		},
+		{
+			name:  "Success/該当なし",
+			code:  "47",
+			want:  []*model.Municipality{},
+			setup: setupMunicipalities,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			a := assert.New(t)
+
+			client := testutils.SetupTestDB(t)
+			defer client.Close()
+
+			repo := datastore.NewMunicipalityRepository(ctx, client)
+
+			testutils.TruncateAllTables(t, client)
+
+			if tt.setup != nil {
+				tt.setup(t, client)
+			}
+
+			got, meta, err := repo.FindByPrefectureCode(ctx, tt.code, pagination.NewParams(1, 20, nil, nil))
+			if tt.wantErr {
+				a.Error(err)
+				return
+			}
+			a.NoError(err)
+			a.Equal(tt.wantTotal, meta.TotalCount)
+
+			if !cmp.Equal(tt.want, got, testutils.IgnoreUpdatedAt) {
+				t.Errorf("diff %s", cmp.Diff(tt.want, got, testutils.IgnoreUpdatedAt))
+			}
+		})
+	}
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewMunicipalityRepository(ctx, client)
+
+		t.Run("failure/Findエラー", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM \"municipalities\" WHERE \"municipalities\".\"prefecture_code\" = $1 AND \"municipalities\".\"is_active\" = $2 ORDER BY \"municipalities\".\"organization_code\" LIMIT $3")).
+				WithArgs("13", true, 21).
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, _, err := repo.FindByPrefectureCode(ctx, "13", pagination.NewParams(1, 20, nil, nil))
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+		})
+	})
+}
+
+func TestMunicipalityRepository_Search(t *testing.T) {
+	chiyoda := &model.Municipality{
+		ID:                    1,
+		PrefectureCode:        "13",
+		OrganizationCode:      "131016",
+		PrefectureNameKanji:   "東京都",
+		MunicipalityNameKanji: "千代田区",
+		PrefectureNameKana:    "ﾄｳｷｮｳﾄ",
+		MunicipalityNameKana:  "ﾁﾖﾀﾞｸ",
+		IsActive:              true,
+	}
+	sapporo := &model.Municipality{
+		ID:                    2,
+		PrefectureCode:        "01",
+		OrganizationCode:      "011002",
+		PrefectureNameKanji:   "北海道",
+		MunicipalityNameKanji: "札幌市",
+		PrefectureNameKana:    "ﾎｯｶｲﾄﾞｳ",
+		MunicipalityNameKana:  "ｻｯﾎﾟﾛｼ",
+		IsActive:              true,
+	}
+
+	tests := []struct {
+		name    string
+		cond    domain.MunicipalitySearchCondition
+		want    []*model.Municipality
+		wantErr bool
+		setup   func(t *testing.T, client db.Client)
+	}{
+		{
+			name:  "Success/漢字で部分一致",
+			cond:  domain.MunicipalitySearchCondition{NameKeywords: []string{"代田"}},
+			want:  []*model.Municipality{chiyoda},
+			setup: setupMunicipalities,
+		},
+		{
+			name:  "Success/半角カナで部分一致",
+			cond:  domain.MunicipalitySearchCondition{KanaKeyword: "ｻｯﾎﾟﾛ"},
+			want:  []*model.Municipality{sapporo},
+			setup: setupMunicipalities,
+		},
+		{
+			name: "Success/都道府県で絞り込み",
+			cond: domain.MunicipalitySearchCondition{
+				NameKeywords:   []string{"市"},
+				PrefectureCode: "13",
+			},
+			want:  []*model.Municipality{},
+			setup: setupMunicipalities,
+		},
+		{
+			name:  "Success/ワイルドカードはエスケープされる",
+			cond:  domain.MunicipalitySearchCondition{NameKeywords: []string{"%"}},
+			want:  []*model.Municipality{},
+			setup: setupMunicipalities,
+		},
+		{
+			name:  "Success/条件なし",
+			cond:  domain.MunicipalitySearchCondition{},
+			want:  []*model.Municipality{},
+			setup: setupMunicipalities,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			a := assert.New(t)
+
+			client := testutils.SetupTestDB(t)
+			defer client.Close()
+
+			repo := datastore.NewMunicipalityRepository(ctx, client)
+
+			testutils.TruncateAllTables(t, client)
+
+			if tt.setup != nil {
+				tt.setup(t, client)
+			}
+
+			got, err := repo.Search(ctx, tt.cond)
+			if tt.wantErr {
+				a.Error(err)
+				return
+			}
+			a.NoError(err)
+
+			if !cmp.Equal(tt.want, got, testutils.IgnoreUpdatedAt) {
+				t.Errorf("diff %s", cmp.Diff(tt.want, got, testutils.IgnoreUpdatedAt))
+			}
+		})
+	}
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewMunicipalityRepository(ctx, client)
+
+		t.Run("failure/Findエラー", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM \"municipalities\" WHERE \"municipalities\".\"is_active\" = $1 AND (\"municipalities\".\"municipality_name_kanji\" LIKE $2 OR \"municipalities\".\"municipality_name_kana\" LIKE $3) AND \"municipalities\".\"prefecture_code\" = $4 ORDER BY \"municipalities\".\"organization_code\"")).
+				WithArgs(true, "%札幌%", "%ｻｯﾎﾟﾛ%", "01").
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, err := repo.Search(ctx, domain.MunicipalitySearchCondition{
+				NameKeywords:   []string{"札幌"},
+				KanaKeyword:    "ｻｯﾎﾟﾛ",
+				PrefectureCode: "01",
+			})
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+		})
+	})
+}
+
+func TestMunicipalityRepository_Statistics(t *testing.T) {
+	tests := []struct {
+		name  string
+		want  *domain.MunicipalityStatistics
+		setup func(t *testing.T, client db.Client)
+	}{
+		{
+			name: "Success/都道府県ごとに集計",
+			want: &domain.MunicipalityStatistics{
+				Total:             4,
+				PrefectureLevel:   1,
+				MunicipalityLevel: 3,
+				Active:            3,
+				Inactive:          1,
+				Prefectures: []*domain.PrefectureMunicipalityCount{
+					{PrefectureCode: "01", PrefectureName: "北海道", Total: 1, MunicipalityLevel: 1, Active: 1},
+					{PrefectureCode: "13", PrefectureName: "東京都", Total: 3, PrefectureLevel: 1, MunicipalityLevel: 2, Active: 2, Inactive: 1},
+				},
+			},
+			setup: func(t *testing.T, client db.Client) {
+				setupMunicipalities(t, client)
+				require.NoError(t, client.Conn(context.Background()).Exec(
+					"INSERT INTO municipalities (prefecture_code, organization_code, prefecture_name_kanji, municipality_name_kanji, prefecture_name_kana, municipality_name_kana) VALUES (?, ?, ?, ?, ?, ?)",
+					"13", "130001", "東京都", "", "ﾄｳｷｮｳﾄ", "").Error,
+				)
+			},
+		},
+		{
+			name: "Success/データなし",
+			want: &domain.MunicipalityStatistics{Prefectures: []*domain.PrefectureMunicipalityCount{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			a := assert.New(t)
+
+			client := testutils.SetupTestDB(t)
+			defer client.Close()
+
+			repo := datastore.NewMunicipalityRepository(ctx, client)
+
+			testutils.TruncateAllTables(t, client)
+
+			if tt.setup != nil {
+				tt.setup(t, client)
+			}
+
+			got, err := repo.Statistics(ctx)
+			a.NoError(err)
+
+			if !cmp.Equal(tt.want, got) {
+				t.Errorf("diff %s", cmp.Diff(tt.want, got))
+			}
+		})
+	}
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewMunicipalityRepository(ctx, client)
+
+		t.Run("failure/集計エラー", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT \"prefecture_code\",MIN(prefecture_name_kanji) AS prefecture_name,COUNT(*) AS total,COUNT(*) FILTER (WHERE municipality_name_kanji = '') AS prefecture_level,COUNT(*) FILTER (WHERE municipality_name_kanji <> '') AS municipality_level,COUNT(*) FILTER (WHERE is_active) AS active,COUNT(*) FILTER (WHERE NOT is_active) AS inactive FROM \"municipalities\" GROUP BY \"prefecture_code\" ORDER BY prefecture_code")).
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, err := repo.Statistics(ctx)
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+		})
+	})
+}
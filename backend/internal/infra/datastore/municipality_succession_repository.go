@@ -0,0 +1,48 @@
+package datastore
+
+import (
+	"context"
+	"time"
+
+	"g_gen/internal/domain/model"
+	"g_gen/internal/domain/query"
+	domain "g_gen/internal/domain/repository"
+	"g_gen/internal/infra/db"
+)
+
+type municipalitySuccessionRepository struct {
+	client db.Client
+	query  *query.Query
+}
+
+func NewMunicipalitySuccessionRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.MunicipalitySuccessionRepository {
+	return &municipalitySuccessionRepository{
+		client: client,
+		query:  query.Use(client.Conn(ctx)),
+	}
+}
+
+func (r *municipalitySuccessionRepository) FindEffectiveByPredecessorCode(
+	ctx context.Context,
+	predecessorCode string,
+	at time.Time,
+) ([]*model.MunicipalitySuccession, error) {
+	s := r.query.MunicipalitySuccession
+
+	successions, err := r.query.WithContext(ctx).
+		MunicipalitySuccession.
+		Where(
+			s.PredecessorCode.Eq(predecessorCode),
+			s.EffectiveDate.Lte(at),
+		).
+		Order(s.EffectiveDate.Desc(), s.SuccessorCode).
+		Find()
+	if err != nil {
+		return nil, err
+	}
+
+	return successions, nil
+}
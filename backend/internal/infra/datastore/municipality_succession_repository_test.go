@@ -0,0 +1,95 @@
+package datastore_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/infra/datastore"
+	"g_gen/internal/infra/db"
+	"g_gen/tests/testutils"
+)
+
+func setupMunicipalitySuccessions(t *testing.T, client db.Client) {
+	setupMunicipalities(t, client)
+
+	r := require.New(t)
+	r.NoError(client.Conn(context.Background()).Exec(
+		"INSERT INTO municipality_successions (predecessor_code, successor_code, effective_date) VALUES (?, ?, ?), (?, ?, ?), (?, ?, ?)",
+		"132047", "131016", "2005-04-01",
+		"011991", "132047", "2001-01-01",
+		"011991", "011002", "2099-01-01",
+	).Error)
+}
+
+func TestMunicipalitySuccessionRepository_FindEffectiveByPredecessorCode(t *testing.T) {
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		code     string
+		wantSucc []string
+		setup    func(t *testing.T, client db.Client)
+	}{
+		{
+			name:     "Success/施行済みの承継のみ取得",
+			code:     "011991",
+			wantSucc: []string{"132047"},
+			setup:    setupMunicipalitySuccessions,
+		},
+		{
+			name:     "Success/承継なし",
+			code:     "131016",
+			wantSucc: []string{},
+			setup:    setupMunicipalitySuccessions,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			a := assert.New(t)
+
+			client := testutils.SetupTestDB(t)
+			defer client.Close()
+
+			repo := datastore.NewMunicipalitySuccessionRepository(ctx, client)
+
+			testutils.TruncateAllTables(t, client)
+
+			if tt.setup != nil {
+				tt.setup(t, client)
+			}
+
+			got, err := repo.FindEffectiveByPredecessorCode(ctx, tt.code, at)
+			a.NoError(err)
+
+			succ := make([]string, len(got))
+			for i, s := range got {
+				succ[i] = s.SuccessorCode
+			}
+			a.Equal(tt.wantSucc, succ)
+		})
+	}
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewMunicipalitySuccessionRepository(ctx, client)
+
+		t.Run("failure/Findエラー", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM \"municipality_successions\" WHERE \"municipality_successions\".\"predecessor_code\" = $1 AND \"municipality_successions\".\"effective_date\" <= $2 ORDER BY \"municipality_successions\".\"effective_date\" DESC,\"municipality_successions\".\"successor_code\"")).
+				WithArgs("011991", at).
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, err := repo.FindEffectiveByPredecessorCode(ctx, "011991", at)
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+		})
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"gorm.io/gen"
 	"gorm.io/gorm"
 
 	"g_gen/internal/domain/model"
@@ -11,6 +12,7 @@ import (
 	domain "g_gen/internal/domain/repository"
 	myerrors "g_gen/internal/errors"
 	"g_gen/internal/infra/db"
+	"g_gen/internal/pagination"
 )
 
 type prefectureRepository struct {
@@ -28,13 +30,25 @@ func NewPrefectureRepository(
 	}
 }
 
-func (r *prefectureRepository) FindAll(ctx context.Context) ([]*model.Prefecture, error) {
-	prefectures, err := r.query.WithContext(ctx).Prefecture.Find()
-	if err != nil {
-		return nil, err
+// prefectureListQuery 都道府県一覧で利用できるソートのフィールド
+func (r *prefectureRepository) prefectureListQuery() listQuery[*model.Prefecture] {
+	p := r.query.Prefecture
+
+	return listQuery[*model.Prefecture]{
+		sorts: map[string]sortColumn[*model.Prefecture]{
+			"id":   int64SortColumn(p.ID, func(row *model.Prefecture) int64 { return row.ID }),
+			"code": stringSortColumn(p.Code, func(row *model.Prefecture) string { return row.Code }),
+		},
+		tieBreaker: "code",
 	}
+}
 
-	return prefectures, nil
+// FindAll 都道府県を1ページ分取得する。並び順の指定がない場合は都道府県コード順とする
+func (r *prefectureRepository) FindAll(
+	ctx context.Context,
+	params pagination.Params,
+) ([]*model.Prefecture, pagination.Meta, error) {
+	return r.findPage(ctx, params)
 }
 
 func (r *prefectureRepository) FindByCode(ctx context.Context, code string) (*model.Prefecture, error) {
@@ -56,3 +70,69 @@ func (r *prefectureRepository) FindByCode(ctx context.Context, code string) (*mo
 
 	return prefecture, nil
 }
+
+// FindByRegionID 地方区分に属する都道府県を1ページ分取得する。並び順の指定がない場合は都道府県コード順とする
+func (r *prefectureRepository) FindByRegionID(
+	ctx context.Context,
+	regionID int,
+	params pagination.Params,
+) ([]*model.Prefecture, pagination.Meta, error) {
+	return r.findPage(ctx, params, r.query.Prefecture.RegionID.Eq(int64(regionID)))
+}
+
+// FindByRegionIDs 地方区分に属する都道府県を都道府県コード順にすべて取得する
+func (r *prefectureRepository) FindByRegionIDs(ctx context.Context, regionIDs []int) ([]*model.Prefecture, error) {
+	ids := make([]int64, len(regionIDs))
+	for i, id := range regionIDs {
+		ids[i] = int64(id)
+	}
+
+	prefectures, err := r.query.WithContext(ctx).
+		Prefecture.
+		Where(r.query.Prefecture.RegionID.In(ids...)).
+		Order(r.query.Prefecture.Code).
+		Find()
+	if err != nil {
+		return nil, err
+	}
+
+	return prefectures, nil
+}
+
+// findPage 1ページ分の都道府県と、カーソルを除いた絞り込み条件に一致する総件数を取得する
+func (r *prefectureRepository) findPage(
+	ctx context.Context,
+	params pagination.Params,
+	baseConds ...gen.Condition,
+) ([]*model.Prefecture, pagination.Meta, error) {
+	plan, err := r.prefectureListQuery().plan(params)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	conds := append(baseConds, plan.conds...)
+
+	// 次のページの有無を判定するため1件多く取得する
+	prefectures, err := r.query.WithContext(ctx).
+		Prefecture.
+		Where(append(conds, plan.after...)...).
+		Order(plan.orders...).
+		Offset(params.Offset()).
+		Limit(params.PerPage + 1).
+		Find()
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	count, err := r.query.WithContext(ctx).
+		Prefecture.
+		Where(conds...).
+		Count()
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	prefectures, meta := plan.page(params, prefectures, count)
+
+	return prefectures, meta, nil
+}
@@ -14,36 +14,64 @@ import (
 	myerrors "g_gen/internal/errors"
 	"g_gen/internal/infra/datastore"
 	"g_gen/internal/infra/db"
+	"g_gen/internal/pagination"
 	"g_gen/tests/testutils"
 )
 
 func TestPrefectureRepository_Find(t *testing.T) {
+	tokyo := &model.Prefecture{ID: 13, Name: "東京都", Code: "13", RegionID: 2}
+	osaka := &model.Prefecture{ID: 27, Name: "大阪府", Code: "27", RegionID: 4}
+	setup := func(t *testing.T, client db.Client) {
+		require.NoError(t, client.Conn(context.Background()).Exec(
+			"INSERT INTO prefectures (id, name, code, region_id) VALUES (27, '大阪府', '27', 4), (13, '東京都', '13', 2)",
+		).Error)
+	}
+
 	tests := []struct {
-		name    string
-		want    []*model.Prefecture
-		wantErr bool
-		setup   func(t *testing.T, client db.Client)
-		useMock bool
+		name       string
+		params     pagination.Params
+		want       []*model.Prefecture
+		wantTotal  int64
+		wantCursor *pagination.Cursor
+		setup      func(t *testing.T, client db.Client)
 	}{
 		{
-			name: "Success",
-			want: []*model.Prefecture{
-				{
-					ID:   13,
-					Name: "東京都",
-					Code: "23",
-				},
-				{
-					ID:   27,
-					Name: "大阪府",
-					Code: "27",
-				},
-			},
-			setup: func(t *testing.T, client db.Client) {
-				client.Conn(context.Background()).Exec(
-					"INSERT INTO prefectures (id, name, code) VALUES (13, '東京都', '23'), (27, '大阪府', '27')",
-				)
-			},
+			name:      "Success/都道府県コード順に取得",
+			params:    pagination.NewParams(1, 20, nil, nil),
+			want:      []*model.Prefecture{tokyo, osaka},
+			wantTotal: 2,
+			setup:     setup,
+		},
+		{
+			name:      "Success/IDの降順",
+			params:    pagination.NewParams(1, 20, []pagination.Sort{{Field: "id", Desc: true}}, nil),
+			want:      []*model.Prefecture{osaka, tokyo},
+			wantTotal: 2,
+			setup:     setup,
+		},
+		{
+			name:       "Success/1ページ目は次のページのカーソルを返す",
+			params:     pagination.NewParams(1, 1, nil, nil),
+			want:       []*model.Prefecture{tokyo},
+			wantTotal:  2,
+			wantCursor: &pagination.Cursor{Sort: "code", Keys: []string{"13"}},
+			setup:      setup,
+		},
+		{
+			name: "Success/カーソルより後ろを取得",
+			params: func() pagination.Params {
+				p := pagination.NewParams(1, 1, nil, nil)
+				p.Cursor = &pagination.Cursor{Sort: "code", Keys: []string{"13"}}
+				return p
+			}(),
+			want:      []*model.Prefecture{osaka},
+			wantTotal: 2,
+			setup:     setup,
+		},
+		{
+			name:   "Success/データなし",
+			params: pagination.NewParams(1, 20, nil, nil),
+			want:   []*model.Prefecture{},
 		},
 	}
 
@@ -63,31 +91,40 @@ func TestPrefectureRepository_Find(t *testing.T) {
 				tt.setup(t, client)
 			}
 
-			got, err := repo.FindAll(ctx)
-			if tt.wantErr {
-				a.Error(err)
-				return
-			}
+			got, meta, err := repo.FindAll(ctx, tt.params)
 			a.NoError(err)
+			a.Equal(tt.wantTotal, meta.TotalCount)
+			a.Equal(tt.wantCursor, meta.NextCursor)
 
-			if tt.want != nil {
-				if !cmp.Equal(tt.want, got) {
-					t.Errorf("diff %s", cmp.Diff(tt.want, got))
-				}
+			if !cmp.Equal(tt.want, got, testutils.IgnoreUpdatedAt) {
+				t.Errorf("diff %s", cmp.Diff(tt.want, got, testutils.IgnoreUpdatedAt))
 			}
 		})
 	}
 
+	t.Run("バリデーションエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, _ := testutils.NewTestClient(t)
+		repo := datastore.NewPrefectureRepository(ctx, client)
+
+		_, _, err := repo.FindAll(ctx, pagination.NewParams(1, 20, []pagination.Sort{{Field: "name"}}, nil))
+
+		var apiErr *myerrors.APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, myerrors.ValidationError, apiErr.Code)
+	})
+
 	t.Run("DBエラー", func(t *testing.T) {
 		ctx := context.Background()
 		client, mock := testutils.NewTestClient(t)
 		repo := datastore.NewPrefectureRepository(ctx, client)
 
 		t.Run("failure/Findエラー", func(t *testing.T) {
-			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM \"prefectures\"")).
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM \"prefectures\" ORDER BY \"prefectures\".\"code\" LIMIT $1")).
+				WithArgs(21).
 				WillReturnError(fmt.Errorf("db error"))
 
-			_, err := repo.FindAll(ctx)
+			_, _, err := repo.FindAll(ctx, pagination.NewParams(1, 20, nil, nil))
 			require.Error(t, err)
 			assert.Equal(t, "db error", err.Error())
 		})
@@ -108,9 +145,10 @@ func TestPrefectureRepository_FindByID(t *testing.T) {
 			name: "Success/isActive trueのみ取得",
 			code: "23",
 			want: &model.Prefecture{
-				ID:   13,
-				Name: "東京都",
-				Code: "23",
+				ID:       13,
+				Name:     "東京都",
+				Code:     "23",
+				RegionID: 2,
 				Municipalities: []model.Municipality{
 					{
 						ID:                    1,
@@ -137,7 +175,7 @@ func TestPrefectureRepository_FindByID(t *testing.T) {
 			setup: func(t *testing.T, client db.Client) {
 				r := require.New(t)
 				client.Conn(context.Background()).Exec(
-					"INSERT INTO prefectures (id, name, code) VALUES (13, '東京都', '23')",
+					"INSERT INTO prefectures (id, name, code, region_id) VALUES (13, '東京都', '23', 2)",
 				)
 				r.NoError(client.Conn(context.Background()).Exec(
 					"INSERT INTO municipalities (prefecture_code, organization_code, prefecture_name_kanji, municipality_name_kanji, prefecture_name_kana, municipality_name_kana) VALUES (?, ?, ?, ?, ?, ?)",
@@ -198,8 +236,8 @@ func TestPrefectureRepository_FindByID(t *testing.T) {
 
 			a.NoError(err)
 
-			if !cmp.Equal(tt.want, got) {
-				t.Errorf("diff %s", cmp.Diff(tt.want, got))
+			if !cmp.Equal(tt.want, got, testutils.IgnoreUpdatedAt) {
+				t.Errorf("diff %s", cmp.Diff(tt.want, got, testutils.IgnoreUpdatedAt))
 			}
 		})
 	}
@@ -220,3 +258,134 @@ func TestPrefectureRepository_FindByID(t *testing.T) {
 		})
 	})
 }
+
+func TestPrefectureRepository_FindByRegionID(t *testing.T) {
+	tests := []struct {
+		name       string
+		regionID   int
+		params     pagination.Params
+		want       []*model.Prefecture
+		wantTotal  int64
+		wantCursor *pagination.Cursor
+		setup      func(t *testing.T, client db.Client)
+	}{
+		{
+			name:     "Success/都道府県コード順に取得",
+			regionID: 2,
+			params:   pagination.NewParams(1, 20, nil, nil),
+			want: []*model.Prefecture{
+				{ID: 12, Name: "千葉県", Code: "12", RegionID: 2},
+				{ID: 13, Name: "東京都", Code: "13", RegionID: 2},
+			},
+			wantTotal: 2,
+			setup:     setupRegionPrefectures,
+		},
+		{
+			name:       "Success/1ページ目は次のページのカーソルを返す",
+			regionID:   2,
+			params:     pagination.NewParams(1, 1, nil, nil),
+			want:       []*model.Prefecture{{ID: 12, Name: "千葉県", Code: "12", RegionID: 2}},
+			wantTotal:  2,
+			wantCursor: &pagination.Cursor{Sort: "code", Keys: []string{"12"}},
+			setup:      setupRegionPrefectures,
+		},
+		{
+			name:     "Success/該当なし",
+			regionID: 6,
+			params:   pagination.NewParams(1, 20, nil, nil),
+			want:     []*model.Prefecture{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			a := assert.New(t)
+
+			client := testutils.SetupTestDB(t)
+			defer client.Close()
+
+			repo := datastore.NewPrefectureRepository(ctx, client)
+
+			testutils.TruncateAllTables(t, client)
+
+			if tt.setup != nil {
+				tt.setup(t, client)
+			}
+
+			got, meta, err := repo.FindByRegionID(ctx, tt.regionID, tt.params)
+			a.NoError(err)
+			a.Equal(tt.wantTotal, meta.TotalCount)
+			a.Equal(tt.wantCursor, meta.NextCursor)
+
+			if !cmp.Equal(tt.want, got, testutils.IgnoreUpdatedAt) {
+				t.Errorf("diff %s", cmp.Diff(tt.want, got, testutils.IgnoreUpdatedAt))
+			}
+		})
+	}
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewPrefectureRepository(ctx, client)
+
+		t.Run("failure/Findエラー", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM \"prefectures\" WHERE \"prefectures\".\"region_id\" = $1 ORDER BY \"prefectures\".\"code\" LIMIT $2")).
+				WithArgs(2, 21).
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, _, err := repo.FindByRegionID(ctx, 2, pagination.NewParams(1, 20, nil, nil))
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+		})
+	})
+}
+
+func TestPrefectureRepository_FindByRegionIDs(t *testing.T) {
+	t.Run("Success/複数の地方区分の都道府県を都道府県コード順に取得", func(t *testing.T) {
+		ctx := context.Background()
+
+		client := testutils.SetupTestDB(t)
+		defer client.Close()
+
+		repo := datastore.NewPrefectureRepository(ctx, client)
+
+		testutils.TruncateAllTables(t, client)
+		setupRegionPrefectures(t, client)
+
+		got, err := repo.FindByRegionIDs(ctx, []int{4, 2})
+		require.NoError(t, err)
+
+		want := []*model.Prefecture{
+			{ID: 12, Name: "千葉県", Code: "12", RegionID: 2},
+			{ID: 13, Name: "東京都", Code: "13", RegionID: 2},
+			{ID: 27, Name: "大阪府", Code: "27", RegionID: 4},
+		}
+		if !cmp.Equal(want, got, testutils.IgnoreUpdatedAt) {
+			t.Errorf("diff %s", cmp.Diff(want, got, testutils.IgnoreUpdatedAt))
+		}
+	})
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewPrefectureRepository(ctx, client)
+
+		t.Run("failure/Findエラー", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM \"prefectures\" WHERE \"prefectures\".\"region_id\" IN ($1,$2) ORDER BY \"prefectures\".\"code\"")).
+				WithArgs(2, 4).
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, err := repo.FindByRegionIDs(ctx, []int{2, 4})
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+		})
+	})
+}
+
+// setupRegionPrefectures 関東と近畿の都道府県を登録する
+func setupRegionPrefectures(t *testing.T, client db.Client) {
+	require.NoError(t, client.Conn(context.Background()).Exec(
+		"INSERT INTO prefectures (id, name, code, region_id) VALUES (13, '東京都', '13', 2), (12, '千葉県', '12', 2), (27, '大阪府', '27', 4)",
+	).Error)
+}
@@ -0,0 +1,93 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"g_gen/internal/domain/model"
+	"g_gen/internal/domain/query"
+	domain "g_gen/internal/domain/repository"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/infra/db"
+	"g_gen/internal/pagination"
+)
+
+type regionRepository struct {
+	client db.Client
+	query  *query.Query
+}
+
+func NewRegionRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.RegionRepository {
+	return &regionRepository{
+		client: client,
+		query:  query.Use(client.Conn(ctx)),
+	}
+}
+
+// regionListQuery 地方区分一覧で利用できるソートのフィールド
+func (r *regionRepository) regionListQuery() listQuery[*model.Region] {
+	g := r.query.Region
+
+	return listQuery[*model.Region]{
+		sorts: map[string]sortColumn[*model.Region]{
+			"id": int64SortColumn(g.ID, func(row *model.Region) int64 { return row.ID }),
+		},
+		tieBreaker: "id",
+	}
+}
+
+// FindAll 地方区分を1ページ分取得する。並び順の指定がない場合はID順とする
+func (r *regionRepository) FindAll(ctx context.Context, params pagination.Params) ([]*model.Region, pagination.Meta, error) {
+	plan, err := r.regionListQuery().plan(params)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	// 次のページの有無を判定するため1件多く取得する
+	regions, err := r.query.WithContext(ctx).
+		Region.
+		Where(append(plan.conds, plan.after...)...).
+		Order(plan.orders...).
+		Offset(params.Offset()).
+		Limit(params.PerPage + 1).
+		Find()
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	count, err := r.query.WithContext(ctx).
+		Region.
+		Where(plan.conds...).
+		Count()
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	regions, meta := plan.page(params, regions, count)
+
+	return regions, meta, nil
+}
+
+func (r *regionRepository) FindByID(ctx context.Context, id int) (*model.Region, error) {
+	region, err := r.query.WithContext(ctx).
+		Region.
+		Where(r.query.Region.ID.Eq(int64(id))).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &myerrors.APIError{
+				Code:    myerrors.RegionNotFoundError,
+				Message: myerrors.RegionNotFoundErrorMessage,
+			}
+		}
+
+		return nil, err
+	}
+
+	return region, nil
+}
@@ -0,0 +1,158 @@
+package datastore_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/domain/model"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/infra/datastore"
+	"g_gen/internal/pagination"
+	"g_gen/tests/testutils"
+)
+
+// 地方区分はマイグレーションで投入されるマスタデータのため、テーブルのトランケート対象外とする
+func TestRegionRepository_FindAll(t *testing.T) {
+	regions := []*model.Region{
+		{ID: 1, Name: "北海道・東北"},
+		{ID: 2, Name: "関東"},
+		{ID: 3, Name: "中部"},
+		{ID: 4, Name: "近畿"},
+		{ID: 5, Name: "中国"},
+		{ID: 6, Name: "四国"},
+		{ID: 7, Name: "九州・沖縄"},
+	}
+
+	tests := []struct {
+		name       string
+		params     pagination.Params
+		want       []*model.Region
+		wantCursor *pagination.Cursor
+	}{
+		{
+			name:   "Success/マスタデータをID順に取得",
+			params: pagination.NewParams(1, 20, nil, nil),
+			want:   regions,
+		},
+		{
+			name:       "Success/1ページ目は次のページのカーソルを返す",
+			params:     pagination.NewParams(1, 3, nil, nil),
+			want:       regions[:3],
+			wantCursor: &pagination.Cursor{Sort: "id", Keys: []string{"3"}},
+		},
+		{
+			name: "Success/カーソルより後ろを取得",
+			params: func() pagination.Params {
+				p := pagination.NewParams(1, 3, nil, nil)
+				p.Cursor = &pagination.Cursor{Sort: "id", Keys: []string{"6"}}
+				return p
+			}(),
+			want: regions[6:],
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			client := testutils.SetupTestDB(t)
+			defer client.Close()
+
+			repo := datastore.NewRegionRepository(ctx, client)
+
+			got, meta, err := repo.FindAll(ctx, tt.params)
+			require.NoError(t, err)
+			assert.Equal(t, int64(len(regions)), meta.TotalCount)
+			assert.Equal(t, tt.wantCursor, meta.NextCursor)
+
+			if !cmp.Equal(tt.want, got) {
+				t.Errorf("diff %s", cmp.Diff(tt.want, got))
+			}
+		})
+	}
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewRegionRepository(ctx, client)
+
+		t.Run("failure/Findエラー", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM \"regions\" ORDER BY \"regions\".\"id\" LIMIT $1")).
+				WithArgs(21).
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, _, err := repo.FindAll(ctx, pagination.NewParams(1, 20, nil, nil))
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+		})
+	})
+}
+
+func TestRegionRepository_FindByID(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            int
+		want          *model.Region
+		wantErrorCode myerrors.ErrorCode
+	}{
+		{
+			name: "Success",
+			id:   7,
+			want: &model.Region{ID: 7, Name: "九州・沖縄"},
+		},
+		{
+			name:          "failure/NotFound",
+			id:            99,
+			wantErrorCode: myerrors.RegionNotFoundError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			a := assert.New(t)
+
+			client := testutils.SetupTestDB(t)
+			defer client.Close()
+
+			repo := datastore.NewRegionRepository(ctx, client)
+
+			got, err := repo.FindByID(ctx, tt.id)
+			if tt.wantErrorCode != "" {
+				var apiErr *myerrors.APIError
+				require.ErrorAs(t, err, &apiErr)
+				a.Equal(tt.wantErrorCode, apiErr.Code)
+
+				return
+			}
+
+			a.NoError(err)
+
+			if !cmp.Equal(tt.want, got) {
+				t.Errorf("diff %s", cmp.Diff(tt.want, got))
+			}
+		})
+	}
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewRegionRepository(ctx, client)
+
+		t.Run("failure/Firstエラー", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM \"regions\" WHERE \"regions\".\"id\" = $1 ORDER BY \"regions\".\"id\" LIMIT $2")).
+				WithArgs(1, 1).
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, err := repo.FindByID(ctx, 1)
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+		})
+	})
+}
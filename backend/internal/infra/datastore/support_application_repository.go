@@ -0,0 +1,150 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+
+	"g_gen/internal/domain/model"
+	"g_gen/internal/domain/query"
+	domain "g_gen/internal/domain/repository"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/infra/db"
+	"g_gen/internal/pagination"
+)
+
+type supportApplicationRepository struct {
+	client db.Client
+	query  *query.Query
+}
+
+func NewSupportApplicationRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.SupportApplicationRepository {
+	return &supportApplicationRepository{
+		client: client,
+		query:  query.Use(client.Conn(ctx)),
+	}
+}
+
+func (r *supportApplicationRepository) FindByID(ctx context.Context, id int) (*model.SupportApplication, error) {
+	s := r.query.SupportApplication
+
+	application, err := r.query.WithContext(ctx).
+		SupportApplication.
+		Where(s.ID.Eq(int64(id))).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, supportApplicationNotFoundError()
+		}
+
+		return nil, err
+	}
+
+	return application, nil
+}
+
+// transitionListQuery 遷移履歴の一覧で利用できるソートのフィールド
+func (r *supportApplicationRepository) transitionListQuery() listQuery[*model.SupportApplicationTransition] {
+	t := r.query.SupportApplicationTransition
+
+	return listQuery[*model.SupportApplicationTransition]{
+		sorts: map[string]sortColumn[*model.SupportApplicationTransition]{
+			"id": int64SortColumn(t.ID, func(row *model.SupportApplicationTransition) int64 { return row.ID }),
+		},
+		tieBreaker: "id",
+	}
+}
+
+// FindTransitions 支援申請の遷移履歴を1ページ分取得する。並び順の指定がない場合は遷移した順とする
+func (r *supportApplicationRepository) FindTransitions(
+	ctx context.Context,
+	id int,
+	params pagination.Params,
+) ([]*model.SupportApplicationTransition, pagination.Meta, error) {
+	plan, err := r.transitionListQuery().plan(params)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	t := r.query.SupportApplicationTransition
+	conds := append([]gen.Condition{t.SupportApplicationID.Eq(int64(id))}, plan.conds...)
+
+	// 次のページの有無を判定するため1件多く取得する
+	transitions, err := r.query.WithContext(ctx).
+		SupportApplicationTransition.
+		Where(append(conds, plan.after...)...).
+		Order(plan.orders...).
+		Offset(params.Offset()).
+		Limit(params.PerPage + 1).
+		Find()
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	count, err := r.query.WithContext(ctx).
+		SupportApplicationTransition.
+		Where(conds...).
+		Count()
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	transitions, meta := plan.page(params, transitions, count)
+
+	return transitions, meta, nil
+}
+
+// Create 支援申請を登録する。被害報告は参照するだけで登録・更新しない
+func (r *supportApplicationRepository) Create(ctx context.Context, application *model.SupportApplication) error {
+	return r.query.WithContext(ctx).
+		SupportApplication.
+		Omit(field.AssociationFields).
+		Create(application)
+}
+
+// Transition 状態の変更と遷移履歴の登録を1トランザクションで行う
+// 遷移前の状態を更新の条件にし、読み込んだ後に他のリクエストが状態を変えていた場合は遷移しない
+func (r *supportApplicationRepository) Transition(
+	ctx context.Context,
+	transition *model.SupportApplicationTransition,
+) error {
+	return r.client.Transaction(ctx, func(tx db.Client) error {
+		q := query.Use(tx.Conn(ctx))
+		s := q.SupportApplication
+
+		info, err := q.WithContext(ctx).
+			SupportApplication.
+			Where(s.ID.Eq(transition.SupportApplicationID), s.Status.Eq(transition.FromStatus)).
+			UpdateSimple(s.Status.Value(transition.ToStatus))
+		if err != nil {
+			return err
+		}
+
+		if info.RowsAffected == 0 {
+			return myerrors.NewAPIError(
+				myerrors.InvalidStateTransitionError,
+				myerrors.InvalidStateTransitionErrorMessage,
+				fmt.Errorf("support application %d is not %s", transition.SupportApplicationID, transition.FromStatus),
+				"conflicting support application transition",
+			)
+		}
+
+		return q.WithContext(ctx).
+			SupportApplicationTransition.
+			Create(transition)
+	})
+}
+
+func supportApplicationNotFoundError() *myerrors.APIError {
+	return &myerrors.APIError{
+		Code:    myerrors.SupportApplicationNotFoundError,
+		Message: myerrors.SupportApplicationNotFoundErrorMessage,
+	}
+}
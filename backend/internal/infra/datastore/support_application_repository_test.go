@@ -0,0 +1,415 @@
+package datastore_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/domain/model"
+	domain "g_gen/internal/domain/repository"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/infra/datastore"
+	"g_gen/internal/infra/db"
+	"g_gen/internal/pagination"
+	"g_gen/tests/testutils"
+)
+
+// setupSupportApplications 被害報告と、それを参照する支援申請・遷移履歴を登録する
+func setupSupportApplications(t *testing.T, client db.Client) {
+	setupDamageReports(t, client)
+
+	r := require.New(t)
+	conn := client.Conn(context.Background())
+	r.NoError(conn.Exec(
+		"INSERT INTO support_applications (damage_report_id, applicant_name, requested_amount, status) VALUES "+
+			"(?, ?, ?, ?), (?, ?, ?, ?)",
+		1, "千代田 太郎", 400000, "draft",
+		3, "丸の内 花子", 250000, "municipality_review",
+	).Error)
+	r.NoError(conn.Exec(
+		"INSERT INTO support_application_transitions (support_application_id, action, from_status, to_status, actor_role, comment) VALUES "+
+			"(?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?)",
+		2, "submit", "draft", "submitted", "applicant", nil,
+		2, "accept", "submitted", "municipality_review", "municipality", "書類を受け付けました",
+	).Error)
+}
+
+func chiyodaSupportApplication() *model.SupportApplication {
+	return &model.SupportApplication{
+		ID:              1,
+		DamageReportID:  1,
+		ApplicantName:   "千代田 太郎",
+		RequestedAmount: 400000,
+		Status:          domain.SupportApplicationStatusDraft,
+	}
+}
+
+func TestSupportApplicationRepository_FindByID(t *testing.T) {
+	tests := []struct {
+		name             string
+		id               int
+		want             *model.SupportApplication
+		wantErrorMessage myerrors.ErrorMessage
+	}{
+		{
+			name: "Success/IDで取得",
+			id:   1,
+			want: chiyodaSupportApplication(),
+		},
+		{
+			name:             "failure/NotFound",
+			id:               99,
+			wantErrorMessage: myerrors.SupportApplicationNotFoundErrorMessage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			a := assert.New(t)
+
+			client := testutils.SetupTestDB(t)
+			defer client.Close()
+
+			repo := datastore.NewSupportApplicationRepository(ctx, client)
+
+			testutils.TruncateAllTables(t, client)
+			setupSupportApplications(t, client)
+
+			got, err := repo.FindByID(ctx, tt.id)
+			if tt.wantErrorMessage != "" {
+				var apiErr *myerrors.APIError
+				require.ErrorAs(t, err, &apiErr)
+				a.Equal(tt.wantErrorMessage, apiErr.Message)
+				return
+			}
+			a.NoError(err)
+
+			if !cmp.Equal(tt.want, got, testutils.IgnoreUpdatedAt) {
+				t.Errorf("diff %s", cmp.Diff(tt.want, got, testutils.IgnoreUpdatedAt))
+			}
+		})
+	}
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewSupportApplicationRepository(ctx, client)
+
+		t.Run("failure/Firstエラー", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM \"support_applications\" WHERE \"support_applications\".\"id\" = $1 ORDER BY \"support_applications\".\"id\" LIMIT $2")).
+				WithArgs(1, 1).
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, err := repo.FindByID(ctx, 1)
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+		})
+	})
+}
+
+func TestSupportApplicationRepository_FindTransitions(t *testing.T) {
+	comment := "書類を受け付けました"
+	submitted := &model.SupportApplicationTransition{
+		ID:                   1,
+		SupportApplicationID: 2,
+		Action:               "submit",
+		FromStatus:           domain.SupportApplicationStatusDraft,
+		ToStatus:             domain.SupportApplicationStatusSubmitted,
+		ActorRole:            domain.RoleApplicant,
+	}
+	accepted := &model.SupportApplicationTransition{
+		ID:                   2,
+		SupportApplicationID: 2,
+		Action:               "accept",
+		FromStatus:           domain.SupportApplicationStatusSubmitted,
+		ToStatus:             domain.SupportApplicationStatusMunicipalityReview,
+		ActorRole:            domain.RoleMunicipality,
+		Comment:              &comment,
+	}
+
+	tests := []struct {
+		name       string
+		id         int
+		params     pagination.Params
+		want       []*model.SupportApplicationTransition
+		wantTotal  int64
+		wantCursor *pagination.Cursor
+	}{
+		{
+			name:      "Success/遷移した順に取得",
+			id:        2,
+			params:    pagination.NewParams(1, 20, nil, nil),
+			want:      []*model.SupportApplicationTransition{submitted, accepted},
+			wantTotal: 2,
+		},
+		{
+			name:       "Success/新しい順の1ページ目は次のページのカーソルを返す",
+			id:         2,
+			params:     pagination.NewParams(1, 1, []pagination.Sort{{Field: "id", Desc: true}}, nil),
+			want:       []*model.SupportApplicationTransition{accepted},
+			wantTotal:  2,
+			wantCursor: &pagination.Cursor{Sort: "-id", Keys: []string{"2"}},
+		},
+		{
+			name: "Success/カーソルより後ろを取得",
+			id:   2,
+			params: func() pagination.Params {
+				p := pagination.NewParams(1, 1, nil, nil)
+				p.Cursor = &pagination.Cursor{Sort: "id", Keys: []string{"1"}}
+				return p
+			}(),
+			want:      []*model.SupportApplicationTransition{accepted},
+			wantTotal: 2,
+		},
+		{
+			name:   "Success/遷移していない場合は空",
+			id:     1,
+			params: pagination.NewParams(1, 20, nil, nil),
+			want:   []*model.SupportApplicationTransition{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			a := assert.New(t)
+
+			client := testutils.SetupTestDB(t)
+			defer client.Close()
+
+			repo := datastore.NewSupportApplicationRepository(ctx, client)
+
+			testutils.TruncateAllTables(t, client)
+			setupSupportApplications(t, client)
+
+			got, meta, err := repo.FindTransitions(ctx, tt.id, tt.params)
+			a.NoError(err)
+			a.Equal(tt.wantTotal, meta.TotalCount)
+			a.Equal(tt.wantCursor, meta.NextCursor)
+
+			if !cmp.Equal(tt.want, got, testutils.IgnoreUpdatedAt) {
+				t.Errorf("diff %s", cmp.Diff(tt.want, got, testutils.IgnoreUpdatedAt))
+			}
+		})
+	}
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewSupportApplicationRepository(ctx, client)
+
+		t.Run("failure/Findエラー", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM \"support_application_transitions\" WHERE \"support_application_transitions\".\"support_application_id\" = $1 ORDER BY \"support_application_transitions\".\"id\" LIMIT $2")).
+				WithArgs(1, 21).
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, _, err := repo.FindTransitions(ctx, 1, pagination.NewParams(1, 20, nil, nil))
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+		})
+	})
+}
+
+func TestSupportApplicationRepository_Create(t *testing.T) {
+	t.Run("Success/登録した支援申請を取得できる", func(t *testing.T) {
+		ctx := context.Background()
+		a := assert.New(t)
+
+		client := testutils.SetupTestDB(t)
+		defer client.Close()
+
+		repo := datastore.NewSupportApplicationRepository(ctx, client)
+
+		testutils.TruncateAllTables(t, client)
+		setupSupportApplications(t, client)
+
+		application := &model.SupportApplication{
+			DamageReportID:  2,
+			ApplicantName:   "札幌 次郎",
+			RequestedAmount: 900000,
+			Status:          domain.SupportApplicationStatusDraft,
+		}
+		a.NoError(repo.Create(ctx, application))
+		a.Equal(int64(3), application.ID)
+
+		got, err := repo.FindByID(ctx, 3)
+		a.NoError(err)
+
+		want := &model.SupportApplication{
+			ID:              3,
+			DamageReportID:  2,
+			ApplicantName:   "札幌 次郎",
+			RequestedAmount: 900000,
+			Status:          domain.SupportApplicationStatusDraft,
+		}
+		if !cmp.Equal(want, got, testutils.IgnoreUpdatedAt) {
+			t.Errorf("diff %s", cmp.Diff(want, got, testutils.IgnoreUpdatedAt))
+		}
+	})
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewSupportApplicationRepository(ctx, client)
+
+		t.Run("failure/Createエラー", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO \"support_applications\"")).
+				WillReturnError(fmt.Errorf("db error"))
+			mock.ExpectRollback()
+
+			err := repo.Create(ctx, &model.SupportApplication{DamageReportID: 1, ApplicantName: "千代田 太郎"})
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+}
+
+func TestSupportApplicationRepository_Transition(t *testing.T) {
+	comment := "都道府県の審査に進達します"
+
+	tests := []struct {
+		name            string
+		transition      *model.SupportApplicationTransition
+		want            *model.SupportApplication
+		wantTransitions int
+		wantErrorCode   myerrors.ErrorCode
+	}{
+		{
+			name: "Success/状態を変更して遷移履歴を登録する",
+			transition: &model.SupportApplicationTransition{
+				SupportApplicationID: 2,
+				Action:               "forward",
+				FromStatus:           domain.SupportApplicationStatusMunicipalityReview,
+				ToStatus:             domain.SupportApplicationStatusPrefectureReview,
+				ActorRole:            domain.RoleMunicipality,
+				Comment:              &comment,
+			},
+			want: &model.SupportApplication{
+				ID:              2,
+				DamageReportID:  3,
+				ApplicantName:   "丸の内 花子",
+				RequestedAmount: 250000,
+				Status:          domain.SupportApplicationStatusPrefectureReview,
+			},
+			wantTransitions: 3,
+		},
+		{
+			name: "failure/遷移前の状態が異なる場合は遷移しない",
+			transition: &model.SupportApplicationTransition{
+				SupportApplicationID: 2,
+				Action:               "accept",
+				FromStatus:           domain.SupportApplicationStatusSubmitted,
+				ToStatus:             domain.SupportApplicationStatusMunicipalityReview,
+				ActorRole:            domain.RoleMunicipality,
+			},
+			want: &model.SupportApplication{
+				ID:              2,
+				DamageReportID:  3,
+				ApplicantName:   "丸の内 花子",
+				RequestedAmount: 250000,
+				Status:          domain.SupportApplicationStatusMunicipalityReview,
+			},
+			wantTransitions: 2,
+			wantErrorCode:   myerrors.InvalidStateTransitionError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			a := assert.New(t)
+
+			client := testutils.SetupTestDB(t)
+			defer client.Close()
+
+			repo := datastore.NewSupportApplicationRepository(ctx, client)
+
+			testutils.TruncateAllTables(t, client)
+			setupSupportApplications(t, client)
+
+			err := repo.Transition(ctx, tt.transition)
+			if tt.wantErrorCode != "" {
+				var apiErr *myerrors.APIError
+				require.ErrorAs(t, err, &apiErr)
+				a.Equal(tt.wantErrorCode, apiErr.Code)
+			} else {
+				a.NoError(err)
+			}
+
+			got, err := repo.FindByID(ctx, int(tt.transition.SupportApplicationID))
+			a.NoError(err)
+
+			if !cmp.Equal(tt.want, got, testutils.IgnoreUpdatedAt) {
+				t.Errorf("diff %s", cmp.Diff(tt.want, got, testutils.IgnoreUpdatedAt))
+			}
+
+			transitions, _, err := repo.FindTransitions(ctx, int(tt.transition.SupportApplicationID), pagination.NewParams(1, 20, nil, nil))
+			a.NoError(err)
+			a.Len(transitions, tt.wantTransitions)
+		})
+	}
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewSupportApplicationRepository(ctx, client)
+
+		transition := &model.SupportApplicationTransition{
+			SupportApplicationID: 1,
+			Action:               "submit",
+			FromStatus:           domain.SupportApplicationStatusDraft,
+			ToStatus:             domain.SupportApplicationStatusSubmitted,
+			ActorRole:            domain.RoleApplicant,
+		}
+
+		t.Run("failure/状態が変わっていた場合はロールバック", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE \"support_applications\" SET \"status\"=$1,\"updated_at\"=$2 WHERE \"support_applications\".\"id\" = $3 AND \"support_applications\".\"status\" = $4")).
+				WithArgs("submitted", sqlmock.AnyArg(), 1, "draft").
+				WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectRollback()
+
+			err := repo.Transition(ctx, transition)
+
+			var apiErr *myerrors.APIError
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, myerrors.InvalidStateTransitionError, apiErr.Code)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("failure/更新エラーの場合はロールバック", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE \"support_applications\" SET")).
+				WillReturnError(fmt.Errorf("db error"))
+			mock.ExpectRollback()
+
+			err := repo.Transition(ctx, transition)
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("failure/履歴の登録エラーの場合はロールバック", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE \"support_applications\" SET")).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO \"support_application_transitions\"")).
+				WillReturnError(fmt.Errorf("db error"))
+			mock.ExpectRollback()
+
+			err := repo.Transition(ctx, transition)
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+}
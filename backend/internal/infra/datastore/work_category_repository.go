@@ -0,0 +1,224 @@
+package datastore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gen"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"g_gen/internal/domain/model"
+	"g_gen/internal/domain/query"
+	domain "g_gen/internal/domain/repository"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/infra/db"
+	"g_gen/internal/pagination"
+)
+
+// sortOrderStep 並び替え時に割り当てる表示順序の間隔
+const sortOrderStep = 10
+
+type workCategoryRepository struct {
+	client db.Client
+	query  *query.Query
+}
+
+func NewWorkCategoryRepository(
+	ctx context.Context,
+	client db.Client,
+) domain.WorkCategoryRepository {
+	return &workCategoryRepository{
+		client: client,
+		query:  query.Use(client.Conn(ctx)),
+	}
+}
+
+// workCategoryListQuery 工種区分一覧で利用できるソートのフィールド
+func (r *workCategoryRepository) workCategoryListQuery() listQuery[*model.WorkCategory] {
+	w := r.query.WorkCategory
+
+	return listQuery[*model.WorkCategory]{
+		sorts: map[string]sortColumn[*model.WorkCategory]{
+			"id": int64SortColumn(w.ID, func(row *model.WorkCategory) int64 { return row.ID }),
+			"sort_order": int32SortColumn(w.SortOrder, func(row *model.WorkCategory) int32 {
+				return row.SortOrder
+			}),
+		},
+		defaultSorts: []pagination.Sort{{Field: "sort_order"}},
+		tieBreaker:   "id",
+	}
+}
+
+// FindActive 有効な工種区分を1ページ分取得する。並び順の指定がない場合は表示順とする
+func (r *workCategoryRepository) FindActive(
+	ctx context.Context,
+	params pagination.Params,
+) ([]*model.WorkCategory, pagination.Meta, error) {
+	plan, err := r.workCategoryListQuery().plan(params)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	conds := append([]gen.Condition{r.query.WorkCategory.IsActive.Is(true)}, plan.conds...)
+
+	// 次のページの有無を判定するため1件多く取得する
+	workCategories, err := r.query.WithContext(ctx).
+		WorkCategory.
+		Where(append(conds, plan.after...)...).
+		Order(plan.orders...).
+		Offset(params.Offset()).
+		Limit(params.PerPage + 1).
+		Find()
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	count, err := r.query.WithContext(ctx).
+		WorkCategory.
+		Where(conds...).
+		Count()
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	workCategories, meta := plan.page(params, workCategories, count)
+
+	return workCategories, meta, nil
+}
+
+func (r *workCategoryRepository) FindAllActive(ctx context.Context) ([]*model.WorkCategory, error) {
+	workCategories, err := r.query.WithContext(ctx).
+		WorkCategory.
+		Where(r.query.WorkCategory.IsActive.Is(true)).
+		Order(r.query.WorkCategory.SortOrder, r.query.WorkCategory.ID).
+		Find()
+	if err != nil {
+		return nil, err
+	}
+
+	return workCategories, nil
+}
+
+func (r *workCategoryRepository) FindByID(ctx context.Context, id int) (*model.WorkCategory, error) {
+	workCategory, err := r.query.WithContext(ctx).
+		WorkCategory.
+		Where(r.query.WorkCategory.ID.Eq(int64(id))).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, workCategoryNotFoundError()
+		}
+
+		return nil, err
+	}
+
+	return workCategory, nil
+}
+
+func (r *workCategoryRepository) Create(ctx context.Context, workCategory *model.WorkCategory) error {
+	return r.query.WithContext(ctx).WorkCategory.Create(workCategory)
+}
+
+func (r *workCategoryRepository) Update(ctx context.Context, workCategory *model.WorkCategory) error {
+	info, err := r.query.WithContext(ctx).
+		WorkCategory.
+		Where(r.query.WorkCategory.ID.Eq(workCategory.ID)).
+		UpdateSimple(
+			r.query.WorkCategory.CategoryName.Value(workCategory.CategoryName),
+			r.query.WorkCategory.IconName.Value(workCategory.IconName),
+			r.query.WorkCategory.SortOrder.Value(workCategory.SortOrder),
+		)
+	if err != nil {
+		return err
+	}
+
+	if info.RowsAffected == 0 {
+		return workCategoryNotFoundError()
+	}
+
+	return nil
+}
+
+func (r *workCategoryRepository) Deactivate(ctx context.Context, id int) error {
+	info, err := r.query.WithContext(ctx).
+		WorkCategory.
+		Where(r.query.WorkCategory.ID.Eq(int64(id))).
+		UpdateSimple(r.query.WorkCategory.IsActive.Value(false))
+	if err != nil {
+		return err
+	}
+
+	if info.RowsAffected == 0 {
+		return workCategoryNotFoundError()
+	}
+
+	return nil
+}
+
+// Reorder 有効な工種区分全体の表示順序を、指定されたID順に1トランザクションで振り直す
+func (r *workCategoryRepository) Reorder(ctx context.Context, ids []int) error {
+	return r.client.Transaction(ctx, func(tx db.Client) error {
+		q := query.Use(tx.Conn(ctx))
+
+		// 並び替え中に他のリクエストが有効な工種区分を変更しないよう行ロックを取得する
+		var activeIDs []int64
+		if err := q.WithContext(ctx).
+			WorkCategory.
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where(q.WorkCategory.IsActive.Is(true)).
+			Pluck(q.WorkCategory.ID, &activeIDs); err != nil {
+			return err
+		}
+
+		if err := validateReorderIDs(ids, activeIDs); err != nil {
+			return myerrors.NewAPIError(
+				myerrors.ValidationError,
+				myerrors.ValidationErrorMessage,
+				err,
+				"invalid work category order",
+			)
+		}
+
+		for i, id := range ids {
+			if _, err := q.WithContext(ctx).
+				WorkCategory.
+				Where(q.WorkCategory.ID.Eq(int64(id))).
+				UpdateSimple(q.WorkCategory.SortOrder.Value(int32((i + 1) * sortOrderStep))); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// validateReorderIDs 指定されたIDが有効な工種区分と過不足なく一致するか検証する
+func validateReorderIDs(ids []int, activeIDs []int64) error {
+	if len(ids) != len(activeIDs) {
+		return fmt.Errorf("expected %d work category ids, got %d", len(activeIDs), len(ids))
+	}
+
+	active := make(map[int]bool, len(activeIDs))
+	for _, id := range activeIDs {
+		active[int(id)] = true
+	}
+
+	for _, id := range ids {
+		if !active[id] {
+			return fmt.Errorf("work category %d is not active or duplicated", id)
+		}
+
+		delete(active, id)
+	}
+
+	return nil
+}
+
+func workCategoryNotFoundError() *myerrors.APIError {
+	return &myerrors.APIError{
+		Code:    myerrors.WorkCategoryNotFoundError,
+		Message: myerrors.WorkCategoryNotFoundErrorMessage,
+	}
+}
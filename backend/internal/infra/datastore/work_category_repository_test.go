@@ -0,0 +1,265 @@
+package datastore_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/domain/model"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/infra/datastore"
+	"g_gen/internal/infra/db"
+	"g_gen/internal/pagination"
+	"g_gen/tests/testutils"
+)
+
+func setupWorkCategories(t *testing.T, client db.Client) {
+	r := require.New(t)
+	r.NoError(client.Conn(context.Background()).Exec(
+		"INSERT INTO work_categories (category_name, icon_name, sort_order, is_active) VALUES (?, ?, ?, ?), (?, ?, ?, ?), (?, ?, ?, ?)",
+		"農地", "", 20, true,
+		"水路", "", 10, true,
+		"農道", "", 30, false,
+	).Error)
+}
+
+func TestWorkCategoryRepository_FindActive(t *testing.T) {
+	waterway := &model.WorkCategory{ID: 2, CategoryName: "水路", IconName: "", SortOrder: 10, IsActive: true}
+	farmland := &model.WorkCategory{ID: 1, CategoryName: "農地", IconName: "", SortOrder: 20, IsActive: true}
+
+	tests := []struct {
+		name       string
+		params     pagination.Params
+		want       []*model.WorkCategory
+		wantTotal  int64
+		wantCursor *pagination.Cursor
+		setup      func(t *testing.T, client db.Client)
+	}{
+		{
+			name:      "Success/isActive trueのみ表示順序で取得",
+			params:    pagination.NewParams(1, 20, nil, nil),
+			want:      []*model.WorkCategory{waterway, farmland},
+			wantTotal: 2,
+			setup:     setupWorkCategories,
+		},
+		{
+			name:      "Success/IDの順",
+			params:    pagination.NewParams(1, 20, []pagination.Sort{{Field: "id"}}, nil),
+			want:      []*model.WorkCategory{farmland, waterway},
+			wantTotal: 2,
+			setup:     setupWorkCategories,
+		},
+		{
+			name:       "Success/1ページ目は次のページのカーソルを返す",
+			params:     pagination.NewParams(1, 1, nil, nil),
+			want:       []*model.WorkCategory{waterway},
+			wantTotal:  2,
+			wantCursor: &pagination.Cursor{Sort: "sort_order,id", Keys: []string{"10", "2"}},
+			setup:      setupWorkCategories,
+		},
+		{
+			name: "Success/カーソルより後ろを取得",
+			params: func() pagination.Params {
+				p := pagination.NewParams(1, 1, nil, nil)
+				p.Cursor = &pagination.Cursor{Sort: "sort_order,id", Keys: []string{"10", "2"}}
+				return p
+			}(),
+			want:      []*model.WorkCategory{farmland},
+			wantTotal: 2,
+			setup:     setupWorkCategories,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			a := assert.New(t)
+
+			client := testutils.SetupTestDB(t)
+			defer client.Close()
+
+			repo := datastore.NewWorkCategoryRepository(ctx, client)
+
+			testutils.TruncateAllTables(t, client)
+
+			if tt.setup != nil {
+				tt.setup(t, client)
+			}
+
+			got, meta, err := repo.FindActive(ctx, tt.params)
+			a.NoError(err)
+			a.Equal(tt.wantTotal, meta.TotalCount)
+			a.Equal(tt.wantCursor, meta.NextCursor)
+
+			if !cmp.Equal(tt.want, got, testutils.IgnoreUpdatedAt) {
+				t.Errorf("diff %s", cmp.Diff(tt.want, got, testutils.IgnoreUpdatedAt))
+			}
+		})
+	}
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewWorkCategoryRepository(ctx, client)
+
+		t.Run("failure/Findエラー", func(t *testing.T) {
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM \"work_categories\" WHERE \"work_categories\".\"is_active\" = $1 ORDER BY \"work_categories\".\"sort_order\",\"work_categories\".\"id\" LIMIT $2")).
+				WithArgs(true, 21).
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, _, err := repo.FindActive(ctx, pagination.NewParams(1, 20, nil, nil))
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+		})
+	})
+}
+
+func TestWorkCategoryRepository_CreateAndUpdate(t *testing.T) {
+	ctx := context.Background()
+	a := assert.New(t)
+	r := require.New(t)
+
+	client := testutils.SetupTestDB(t)
+	defer client.Close()
+
+	repo := datastore.NewWorkCategoryRepository(ctx, client)
+
+	testutils.TruncateAllTables(t, client)
+
+	created := &model.WorkCategory{CategoryName: "護岸", IconName: "revetment.svg", SortOrder: 100, IsActive: true}
+	r.NoError(repo.Create(ctx, created))
+	a.NotZero(created.ID)
+
+	created.CategoryName = "護岸（更新）"
+	created.SortOrder = 5
+	r.NoError(repo.Update(ctx, created))
+
+	got, err := repo.FindByID(ctx, int(created.ID))
+	r.NoError(err)
+	a.Equal("護岸（更新）", got.CategoryName)
+	a.Equal(int32(5), got.SortOrder)
+
+	r.NoError(repo.Deactivate(ctx, int(created.ID)))
+	got, err = repo.FindByID(ctx, int(created.ID))
+	r.NoError(err)
+	a.False(got.IsActive)
+
+	t.Run("failure/NotFound", func(t *testing.T) {
+		var apiErr *myerrors.APIError
+
+		err := repo.Update(ctx, &model.WorkCategory{ID: 999, CategoryName: "なし"})
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, myerrors.WorkCategoryNotFoundError, apiErr.Code)
+
+		err = repo.Deactivate(ctx, 999)
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, myerrors.WorkCategoryNotFoundError, apiErr.Code)
+
+		_, err = repo.FindByID(ctx, 999)
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, myerrors.WorkCategoryNotFoundError, apiErr.Code)
+	})
+}
+
+func TestWorkCategoryRepository_Reorder(t *testing.T) {
+	tests := []struct {
+		name          string
+		ids           []int
+		want          []*model.WorkCategory
+		wantErrorCode myerrors.ErrorCode
+	}{
+		{
+			name: "Success/指定順に10刻みで振り直す",
+			ids:  []int{1, 2},
+			want: []*model.WorkCategory{
+				{ID: 1, CategoryName: "農地", IconName: "", SortOrder: 10, IsActive: true},
+				{ID: 2, CategoryName: "水路", IconName: "", SortOrder: 20, IsActive: true},
+			},
+		},
+		{
+			name:          "failure/有効な工種区分が不足",
+			ids:           []int{1},
+			wantErrorCode: myerrors.ValidationError,
+		},
+		{
+			name:          "failure/無効な工種区分を含む",
+			ids:           []int{1, 3},
+			wantErrorCode: myerrors.ValidationError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			a := assert.New(t)
+
+			client := testutils.SetupTestDB(t)
+			defer client.Close()
+
+			repo := datastore.NewWorkCategoryRepository(ctx, client)
+
+			testutils.TruncateAllTables(t, client)
+			setupWorkCategories(t, client)
+
+			err := repo.Reorder(ctx, tt.ids)
+			if tt.wantErrorCode != "" {
+				var apiErr *myerrors.APIError
+				require.ErrorAs(t, err, &apiErr)
+				a.Equal(tt.wantErrorCode, apiErr.Code)
+
+				return
+			}
+			a.NoError(err)
+
+			got, err := repo.FindAllActive(ctx)
+			a.NoError(err)
+
+			if !cmp.Equal(tt.want, got, testutils.IgnoreUpdatedAt) {
+				t.Errorf("diff %s", cmp.Diff(tt.want, got, testutils.IgnoreUpdatedAt))
+			}
+		})
+	}
+
+	t.Run("DBエラー", func(t *testing.T) {
+		ctx := context.Background()
+		client, mock := testutils.NewTestClient(t)
+		repo := datastore.NewWorkCategoryRepository(ctx, client)
+
+		t.Run("failure/不一致の場合はロールバック", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT \"id\" FROM \"work_categories\" WHERE \"work_categories\".\"is_active\" = $1 FOR UPDATE")).
+				WithArgs(true).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+			mock.ExpectRollback()
+
+			err := repo.Reorder(ctx, []int{2, 5})
+
+			var apiErr *myerrors.APIError
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, myerrors.ValidationError, apiErr.Code)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		t.Run("failure/更新エラーの場合はロールバック", func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT \"id\" FROM \"work_categories\" WHERE \"work_categories\".\"is_active\" = $1 FOR UPDATE")).
+				WithArgs(true).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
+			mock.ExpectExec(regexp.QuoteMeta("UPDATE \"work_categories\" SET \"sort_order\"=$1,\"updated_at\"=$2 WHERE \"work_categories\".\"id\" = $3")).
+				WithArgs(10, sqlmock.AnyArg(), 2).
+				WillReturnError(fmt.Errorf("db error"))
+			mock.ExpectRollback()
+
+			err := repo.Reorder(ctx, []int{2, 1})
+			require.Error(t, err)
+			assert.Equal(t, "db error", err.Error())
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	})
+}
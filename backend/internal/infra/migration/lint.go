@@ -0,0 +1,239 @@
+package migration
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// AllowDestructiveAnnotation 破壊的な文の直前のコメントに書くと、その文を許可する注記。後ろに理由を書く
+//
+//	-- migrate:allow-destructive 旧テーブルのデータは000010で移行済み
+//	DROP TABLE old_reports;
+const AllowDestructiveAnnotation = "migrate:allow-destructive"
+
+// destructiveStatements アップマイグレーションで既存のデータを失う文
+var destructiveStatements = []struct {
+	name    string
+	pattern *regexp.Regexp
+}{
+	{"DROP TABLE", regexp.MustCompile(`^DROP TABLE\b`)},
+	{"DROP SCHEMA", regexp.MustCompile(`^DROP SCHEMA\b`)},
+	{"DROP DATABASE", regexp.MustCompile(`^DROP DATABASE\b`)},
+	{"TRUNCATE", regexp.MustCompile(`^TRUNCATE\b`)},
+	{"DELETE", regexp.MustCompile(`^DELETE FROM\b`)},
+}
+
+var (
+	alterTable = regexp.MustCompile(`^ALTER TABLE\b`)
+	// alterTableDrop ALTER TABLE の DROP 句と、その次の語
+	alterTableDrop = regexp.MustCompile(`\bDROP ("?[A-Z_][A-Z0-9_$]*)`)
+)
+
+// nonColumnDrops ALTER TABLE ... DROP の後に続いても列の削除ではない語
+// 列の削除は DROP [COLUMN] 列名 のようにCOLUMNを省略できるため、これ以外はすべて列の削除として扱う
+var nonColumnDrops = map[string]bool{
+	"CONSTRAINT": true,
+	"DEFAULT":    true,
+	"NOT":        true,
+	"IDENTITY":   true,
+	"EXPRESSION": true,
+}
+
+// LintError アップマイグレーションの破壊的な文
+type LintError struct {
+	Version   uint64
+	Name      string
+	Line      int
+	Statement string
+	Message   string
+}
+
+func (e *LintError) Error() string {
+	return fmt.Sprintf("バージョン %d (%s) %d行目: %s: %s", e.Version, e.Name, e.Line, e.Message, e.Statement)
+}
+
+// Lint アップマイグレーションに注記のない破壊的な文があればエラーを返す
+// 再実行や適用済みのデータベースへの適用で本番のデータを消さないように、データを失う文は理由の注記を必須にする
+func Lint(m *Migration) error {
+	var errs []error
+	for _, stmt := range splitStatements(m.Up) {
+		name := destructiveStatement(stmt.normalized)
+		if name == "" {
+			continue
+		}
+
+		newLintError := func(message string) error {
+			return &LintError{Version: m.Version, Name: m.Name, Line: stmt.line, Statement: stmt.normalized, Message: message}
+		}
+
+		reason, annotated := annotationReason(stmt.comments)
+		switch {
+		case !annotated:
+			errs = append(errs, newLintError(fmt.Sprintf("%s は既存のデータを削除します。意図している場合は直前に -- %s 理由 を書いてください", name, AllowDestructiveAnnotation)))
+		case reason == "":
+			errs = append(errs, newLintError(fmt.Sprintf("-- %s の後に理由を書いてください", AllowDestructiveAnnotation)))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// destructiveStatement 破壊的な文の種類。破壊的でない場合は空文字を返す
+func destructiveStatement(normalized string) string {
+	upper := strings.ToUpper(normalized)
+	for _, s := range destructiveStatements {
+		if s.pattern.MatchString(upper) {
+			return s.name
+		}
+	}
+
+	if alterTable.MatchString(upper) {
+		for _, match := range alterTableDrop.FindAllStringSubmatch(upper, -1) {
+			if !nonColumnDrops[match[1]] {
+				return "DROP COLUMN"
+			}
+		}
+	}
+
+	return ""
+}
+
+// annotationReason 文の直前のコメントにある注記の理由
+func annotationReason(comments []string) (string, bool) {
+	for _, c := range comments {
+		if rest, ok := strings.CutPrefix(strings.TrimSpace(c), AllowDestructiveAnnotation); ok {
+			return strings.TrimSpace(rest), true
+		}
+	}
+
+	return "", false
+}
+
+// statement SQLの1文
+type statement struct {
+	// line 文の開始行（1始まり）
+	line int
+	// normalized コメントを除き、文字列リテラルの中身を空にして空白を詰めた文（末尾の;は含まない）
+	normalized string
+	// comments 前の文との間にあるコメント
+	comments []string
+}
+
+var whitespace = regexp.MustCompile(`\s+`)
+
+// splitStatements SQLを;で文に分割する。文字列リテラル、引用符付きの識別子、ドル引用符、コメントの中の;では分割しない
+func splitStatements(sql string) []*statement {
+	var (
+		statements []*statement
+		body       strings.Builder
+		comments   []string
+		line       = 1
+		startLine  = 0
+	)
+
+	flush := func() {
+		normalized := strings.TrimSpace(whitespace.ReplaceAllString(body.String(), " "))
+		if normalized != "" {
+			statements = append(statements, &statement{line: startLine, normalized: normalized, comments: comments})
+		}
+		body.Reset()
+		comments = nil
+		startLine = 0
+	}
+
+	write := func(s string) {
+		if startLine == 0 && strings.TrimSpace(s) != "" {
+			startLine = line
+		}
+		body.WriteString(s)
+	}
+
+	for i := 0; i < len(sql); {
+		rest := sql[i:]
+		switch {
+		case strings.HasPrefix(rest, "--"):
+			end := strings.IndexByte(rest, '\n')
+			if end < 0 {
+				end = len(rest)
+			}
+			comments = append(comments, rest[2:end])
+			i += end
+		case strings.HasPrefix(rest, "/*"):
+			end := strings.Index(rest[2:], "*/")
+			if end < 0 {
+				end = len(rest) - 4
+			}
+			comment := rest[2 : end+2]
+			comments = append(comments, comment)
+			line += strings.Count(comment, "\n")
+			body.WriteString(" ")
+			i += end + 4
+		case rest[0] == '\'' || rest[0] == '"':
+			end := closingQuote(rest, rest[0])
+			if rest[0] == '\'' {
+				// 文字列リテラルの中身は判定に使わない
+				write("''")
+			} else {
+				write(rest[:end])
+			}
+			line += strings.Count(rest[:end], "\n")
+			i += end
+		case rest[0] == '$':
+			tag := dollarQuoteTag(rest)
+			if tag == "" {
+				write("$")
+				i++
+
+				continue
+			}
+			end := strings.Index(rest[len(tag):], tag)
+			if end < 0 {
+				end = len(rest) - 2*len(tag)
+			}
+			// 関数の本体は別の文として判定しない
+			write("$$")
+			line += strings.Count(rest[:end+2*len(tag)], "\n")
+			i += end + 2*len(tag)
+		case rest[0] == ';':
+			flush()
+			i++
+		default:
+			if rest[0] == '\n' {
+				line++
+			}
+			write(rest[:1])
+			i++
+		}
+	}
+	flush()
+
+	return statements
+}
+
+// closingQuote 引用符で始まる文字列の、閉じ引用符の次の位置。引用符の重ね書きはエスケープとして扱う
+func closingQuote(s string, quote byte) int {
+	for i := 1; i < len(s); i++ {
+		if s[i] != quote {
+			continue
+		}
+
+		if i+1 < len(s) && s[i+1] == quote {
+			i++
+
+			continue
+		}
+
+		return i + 1
+	}
+
+	return len(s)
+}
+
+var dollarQuote = regexp.MustCompile(`^\$([A-Za-z_][A-Za-z0-9_]*)?\$`)
+
+// dollarQuoteTag ドル引用符（$$ や $body$）のタグ。ドル引用符でない場合（$1 など）は空文字を返す
+func dollarQuoteTag(s string) string {
+	return dollarQuote.FindString(s)
+}
@@ -0,0 +1,122 @@
+package migration_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/infra/migration"
+	"g_gen/migrations"
+)
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		name string
+		up   string
+		// want 期待するエラーの行と文（エラーがない場合は空）
+		want []*migration.LintError
+	}{
+		{
+			name: "Success/破壊的な文がない",
+			up: `CREATE TABLE IF NOT EXISTS a (id BIGINT);
+ALTER TABLE a ADD COLUMN IF NOT EXISTS name TEXT;
+ALTER TABLE a DROP CONSTRAINT a_name_key, ALTER COLUMN name DROP NOT NULL, ALTER COLUMN name DROP DEFAULT;
+DROP INDEX IF EXISTS idx_a_name;
+DROP TRIGGER IF EXISTS trg_a ON a;
+UPDATE a SET name = 'DROP TABLE a;';`,
+		},
+		{
+			name: "Success/関数の本体とコメントは対象外",
+			up: `-- DROP TABLE a;
+/* TRUNCATE a; */
+CREATE FUNCTION f() RETURNS TRIGGER AS
+$$
+BEGIN
+    DELETE FROM a WHERE id = OLD.id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;`,
+		},
+		{
+			name: "Success/理由を注記した文は許可",
+			up: `-- 000010で new_a に移行済み
+-- migrate:allow-destructive 旧テーブルのデータは移行済み
+DROP TABLE a;`,
+		},
+		{
+			name: "failure/破壊的な文",
+			up: `DROP TABLE IF EXISTS a CASCADE;
+CREATE TABLE a (id BIGINT);
+drop schema legacy;
+TRUNCATE a;
+DELETE FROM a WHERE id = 1;
+ALTER TABLE a
+    DROP COLUMN name;
+ALTER TABLE a ADD COLUMN code TEXT, DROP IF EXISTS name;`,
+			want: []*migration.LintError{
+				{Line: 1, Statement: "DROP TABLE IF EXISTS a CASCADE"},
+				{Line: 3, Statement: "drop schema legacy"},
+				{Line: 4, Statement: "TRUNCATE a"},
+				{Line: 5, Statement: "DELETE FROM a WHERE id = 1"},
+				{Line: 6, Statement: "ALTER TABLE a DROP COLUMN name"},
+				{Line: 8, Statement: "ALTER TABLE a ADD COLUMN code TEXT, DROP IF EXISTS name"},
+			},
+		},
+		{
+			name: "failure/注記は直前の文だけに効く",
+			up: `-- migrate:allow-destructive 不要になった
+DROP TABLE a;
+DROP TABLE b;`,
+			want: []*migration.LintError{
+				{Line: 3, Statement: "DROP TABLE b"},
+			},
+		},
+		{
+			name: "failure/注記に理由がない",
+			up: `-- migrate:allow-destructive
+DROP TABLE a;`,
+			want: []*migration.LintError{
+				{Line: 2, Statement: "DROP TABLE a", Message: "-- migrate:allow-destructive の後に理由を書いてください"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := migration.Lint(&migration.Migration{Version: 1, Name: "test", Up: tt.up})
+			if len(tt.want) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			joined, ok := err.(interface{ Unwrap() []error })
+			require.True(t, ok)
+
+			errs := joined.Unwrap()
+			require.Len(t, errs, len(tt.want))
+			for i, want := range tt.want {
+				var got *migration.LintError
+				require.ErrorAs(t, errs[i], &got)
+				assert.Equal(t, uint64(1), got.Version)
+				assert.Equal(t, "test", got.Name)
+				assert.Equal(t, want.Line, got.Line)
+				assert.Equal(t, want.Statement, got.Statement)
+				if want.Message != "" {
+					assert.Equal(t, want.Message, got.Message)
+				}
+			}
+		})
+	}
+
+	t.Run("Success/埋め込んだマイグレーションファイル", func(t *testing.T) {
+		got, err := migration.Load(migrations.FS)
+		require.NoError(t, err)
+
+		for _, m := range got {
+			assert.NoError(t, migration.Lint(m))
+			assert.True(t, m.HasDown, "バージョン %d (%s) のダウンファイルがありません", m.Version, m.Name)
+		}
+	})
+}
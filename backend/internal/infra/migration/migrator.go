@@ -0,0 +1,347 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"g_gen/internal/infra/db"
+	"g_gen/internal/infra/logger"
+)
+
+// lockKey マイグレーション中に取得するアドバイザリロックのキー（"ggen"）
+// 同じデータベースに対して複数のインスタンスが同時にマイグレーションしないようにする
+const lockKey int64 = 0x6767656e
+
+// VersionTable 適用済みのバージョンを記録するテーブル
+// golang-migrateと同じ形式のため、golang-migrateで適用済みのデータベースはそのまま引き継げる
+const VersionTable = "schema_migrations"
+
+// Status 適用状況
+type Status struct {
+	// Version 適用済みの最新のバージョン（未適用の場合は0）
+	Version uint64 `json:"version"`
+	// Dirty 前回のマイグレーションが途中で失敗した
+	Dirty      bool               `json:"dirty"`
+	Migrations []*MigrationStatus `json:"migrations"`
+}
+
+// MigrationStatus マイグレーションごとの適用状況
+type MigrationStatus struct {
+	Version uint64 `json:"version"`
+	Name    string `json:"name"`
+	Applied bool   `json:"applied"`
+}
+
+// Migrator 埋め込んだマイグレーションファイルをデータベースに適用する
+type Migrator struct {
+	client     db.Client
+	logger     *logger.Logger
+	migrations []*Migration
+}
+
+// New fsys直下のマイグレーションファイルを読み込んでMigratorを作成する
+func New(client db.Client, fsys fs.FS, l *logger.Logger) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		client:     client,
+		logger:     l,
+		migrations: migrations,
+	}, nil
+}
+
+// Up 未適用のマイグレーションをすべて適用する
+func (m *Migrator) Up(ctx context.Context) error {
+	var latest uint64
+	if len(m.migrations) > 0 {
+		latest = m.migrations[len(m.migrations)-1].Version
+	}
+
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		current, err := m.currentVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		if current >= latest {
+			m.logger.Info("適用するマイグレーションはありません", "version", current)
+
+			return nil
+		}
+
+		return m.migrate(ctx, conn, current, latest)
+	})
+}
+
+// Down 適用済みのマイグレーションを新しいものから steps 件ロールバックする
+func (m *Migrator) Down(ctx context.Context, steps int) error {
+	if steps < 1 {
+		return fmt.Errorf("ロールバックする件数は1以上にしてください: %d", steps)
+	}
+
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		current, err := m.currentVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		if current == 0 {
+			m.logger.Info("ロールバックするマイグレーションはありません")
+
+			return nil
+		}
+
+		index, err := m.indexOf(current)
+		if err != nil {
+			return err
+		}
+
+		var target uint64
+		if index-steps >= 0 {
+			target = m.migrations[index-steps].Version
+		}
+
+		return m.migrate(ctx, conn, current, target)
+	})
+}
+
+// Goto 指定したバージョンまで適用またはロールバックする。0の場合はすべてロールバックする
+func (m *Migrator) Goto(ctx context.Context, version uint64) error {
+	if version != 0 {
+		if _, err := m.indexOf(version); err != nil {
+			return err
+		}
+	}
+
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		current, err := m.currentVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		if current == version {
+			m.logger.Info("すでに指定したバージョンです", "version", version)
+
+			return nil
+		}
+
+		return m.migrate(ctx, conn, current, version)
+	})
+}
+
+// Force SQLを実行せずに適用済みのバージョンを記録し、途中で失敗した状態を解除する
+// マイグレーションの失敗を手で直した場合や、バージョンを記録せずに作成したデータベースを引き継ぐ場合に使う
+func (m *Migrator) Force(ctx context.Context, version uint64) error {
+	if version != 0 {
+		if _, err := m.indexOf(version); err != nil {
+			return err
+		}
+	}
+
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		if err := m.apply(ctx, conn, "", version); err != nil {
+			return err
+		}
+		m.logger.Info("適用済みのバージョンを記録しました", "version", version)
+
+		return nil
+	})
+}
+
+// Status 現在のバージョンとマイグレーションごとの適用状況を返す
+func (m *Migrator) Status(ctx context.Context) (*Status, error) {
+	status := &Status{Migrations: make([]*MigrationStatus, len(m.migrations))}
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		version, dirty, err := readVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		status.Version = version
+		status.Dirty = dirty
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, migration := range m.migrations {
+		status.Migrations[i] = &MigrationStatus{
+			Version: migration.Version,
+			Name:    migration.Name,
+			Applied: migration.Version <= status.Version,
+		}
+	}
+
+	return status, nil
+}
+
+// withLock 専用の接続でアドバイザリロックを取得し、バージョンテーブルを用意してからfnを実行する
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	sqlDB, err := m.client.Conn(ctx).DB()
+	if err != nil {
+		return err
+	}
+
+	// セッション単位のロックのため、ロックの取得から解放までを同じ接続で行う
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockKey); err != nil {
+		return fmt.Errorf("マイグレーションのロックを取得できませんでした: %w", err)
+	}
+	defer func() {
+		if _, err := conn.ExecContext(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock($1)", lockKey); err != nil {
+			m.logger.Error("マイグレーションのロックを解放できませんでした", "error", err)
+		}
+	}()
+
+	createTable := "CREATE TABLE IF NOT EXISTS " + VersionTable + " (version BIGINT NOT NULL PRIMARY KEY, dirty BOOLEAN NOT NULL)"
+	if _, err := conn.ExecContext(ctx, createTable); err != nil {
+		return fmt.Errorf("バージョンテーブルを作成できませんでした: %w", err)
+	}
+
+	return fn(conn)
+}
+
+// currentVersion 適用済みのバージョンを返す。前回のマイグレーションが途中で失敗している場合はエラーにする
+func (m *Migrator) currentVersion(ctx context.Context, conn *sql.Conn) (uint64, error) {
+	version, dirty, err := readVersion(ctx, conn)
+	if err != nil {
+		return 0, err
+	}
+
+	if dirty {
+		return 0, fmt.Errorf("バージョン %d のマイグレーションが途中で失敗しています。データベースを確認して ggen migrate force で解除してください", version)
+	}
+
+	return version, nil
+}
+
+func readVersion(ctx context.Context, conn *sql.Conn) (uint64, bool, error) {
+	var (
+		version int64
+		dirty   bool
+	)
+	err := conn.QueryRowContext(ctx, "SELECT version, dirty FROM "+VersionTable+" LIMIT 1").Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("適用済みのバージョンを取得できませんでした: %w", err)
+	}
+
+	return uint64(version), dirty, nil
+}
+
+// indexOf バージョンのマイグレーションの位置
+func (m *Migrator) indexOf(version uint64) (int, error) {
+	for i, migration := range m.migrations {
+		if migration.Version == version {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("バージョン %d のマイグレーションがありません", version)
+}
+
+// migrate currentからtargetまで、1バージョンずつトランザクションで適用またはロールバックする
+func (m *Migrator) migrate(ctx context.Context, conn *sql.Conn, current, target uint64) error {
+	if current != 0 {
+		if _, err := m.indexOf(current); err != nil {
+			return fmt.Errorf("適用済みのバージョン %d がマイグレーションファイルにありません", current)
+		}
+	}
+
+	if target > current {
+		// 途中で止まらないように、適用を始める前に適用するマイグレーションをすべて検査する
+		var lintErrs []error
+		for _, migration := range m.migrations {
+			if migration.Version > current && migration.Version <= target {
+				lintErrs = append(lintErrs, Lint(migration))
+			}
+		}
+		if err := errors.Join(lintErrs...); err != nil {
+			return fmt.Errorf("アップマイグレーションに破壊的な文があります:\n%w", err)
+		}
+
+		for _, migration := range m.migrations {
+			if migration.Version <= current || migration.Version > target {
+				continue
+			}
+
+			if err := m.apply(ctx, conn, migration.Up, migration.Version); err != nil {
+				return fmt.Errorf("バージョン %d (%s) の適用に失敗しました: %w", migration.Version, migration.Name, err)
+			}
+			m.logger.Info("マイグレーションを適用しました", "version", migration.Version, "name", migration.Name)
+		}
+
+		return nil
+	}
+
+	// 途中で止まらないように、ロールバックを始める前にダウンファイルがそろっているか確認する
+	for _, migration := range m.migrations {
+		if migration.Version <= current && migration.Version > target && !migration.HasDown {
+			return fmt.Errorf("バージョン %d (%s) のダウンファイルがありません", migration.Version, migration.Name)
+		}
+	}
+
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		migration := m.migrations[i]
+		if migration.Version > current || migration.Version <= target {
+			continue
+		}
+
+		var previous uint64
+		if i > 0 {
+			previous = m.migrations[i-1].Version
+		}
+
+		if err := m.apply(ctx, conn, migration.Down, previous); err != nil {
+			return fmt.Errorf("バージョン %d (%s) のロールバックに失敗しました: %w", migration.Version, migration.Name, err)
+		}
+		m.logger.Info("マイグレーションをロールバックしました", "version", migration.Version, "name", migration.Name)
+	}
+
+	return nil
+}
+
+// apply SQLの実行とバージョンの記録を1つのトランザクションで行う。versionが0の場合は記録を消す
+// queryが空の場合はバージョンの記録だけを行う
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, query string, version uint64) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	// コミット後のロールバックは何もしない
+	defer func() { _ = tx.Rollback() }()
+
+	if query != "" {
+		if _, err := tx.ExecContext(ctx, query); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM "+VersionTable); err != nil {
+		return err
+	}
+
+	if version != 0 {
+		insert := "INSERT INTO " + VersionTable + " (version, dirty) VALUES ($1, false)"
+		if _, err := tx.ExecContext(ctx, insert, int64(version)); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
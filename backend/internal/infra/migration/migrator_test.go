@@ -0,0 +1,231 @@
+package migration_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"testing/fstest"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/infra/logger"
+	"g_gen/internal/infra/migration"
+	"g_gen/tests/testutils"
+)
+
+var testMigrations = fstest.MapFS{
+	"000001_create_a.up.sql":   {Data: []byte("CREATE TABLE a ();")},
+	"000001_create_a.down.sql": {Data: []byte("DROP TABLE a;")},
+	"000002_create_b.up.sql":   {Data: []byte("CREATE TABLE b ();")},
+	"000002_create_b.down.sql": {Data: []byte("DROP TABLE b;")},
+	"000003_create_c.up.sql":   {Data: []byte("CREATE TABLE c ();")},
+}
+
+// expectLock ロックの取得とバージョンテーブルの作成、適用済みのバージョンの取得を期待する
+func expectLock(mock sqlmock.Sqlmock, version int64, dirty bool) {
+	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_lock($1)")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS schema_migrations")).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	rows := sqlmock.NewRows([]string{"version", "dirty"})
+	if version != 0 {
+		rows.AddRow(version, dirty)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT version, dirty FROM schema_migrations LIMIT 1")).WillReturnRows(rows)
+}
+
+func expectUnlock(mock sqlmock.Sqlmock) {
+	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_unlock($1)")).WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+// expectApply SQLの実行とバージョンの記録を期待する
+func expectApply(mock sqlmock.Sqlmock, query string, version int64) {
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(query)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM schema_migrations")).WillReturnResult(sqlmock.NewResult(0, 1))
+	if version != 0 {
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO schema_migrations (version, dirty) VALUES ($1, false)")).
+			WithArgs(version).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectCommit()
+}
+
+func TestMigrator(t *testing.T) {
+	tests := []struct {
+		name    string
+		run     func(ctx context.Context, m *migration.Migrator) error
+		expect  func(mock sqlmock.Sqlmock)
+		wantErr string
+	}{
+		{
+			name: "Success/Up 未適用のマイグレーションをすべて適用",
+			run:  func(ctx context.Context, m *migration.Migrator) error { return m.Up(ctx) },
+			expect: func(mock sqlmock.Sqlmock) {
+				expectLock(mock, 1, false)
+				expectApply(mock, "CREATE TABLE b ();", 2)
+				expectApply(mock, "CREATE TABLE c ();", 3)
+				expectUnlock(mock)
+			},
+		},
+		{
+			name: "Success/Up 適用済み",
+			run:  func(ctx context.Context, m *migration.Migrator) error { return m.Up(ctx) },
+			expect: func(mock sqlmock.Sqlmock) {
+				expectLock(mock, 3, false)
+				expectUnlock(mock)
+			},
+		},
+		{
+			name: "Success/Down 1件ロールバック",
+			run:  func(ctx context.Context, m *migration.Migrator) error { return m.Down(ctx, 1) },
+			expect: func(mock sqlmock.Sqlmock) {
+				expectLock(mock, 2, false)
+				expectApply(mock, "DROP TABLE b;", 1)
+				expectUnlock(mock)
+			},
+		},
+		{
+			name: "Success/Down 件数が適用済みより多い場合はすべてロールバック",
+			run:  func(ctx context.Context, m *migration.Migrator) error { return m.Down(ctx, 5) },
+			expect: func(mock sqlmock.Sqlmock) {
+				expectLock(mock, 2, false)
+				expectApply(mock, "DROP TABLE b;", 1)
+				expectApply(mock, "DROP TABLE a;", 0)
+				expectUnlock(mock)
+			},
+		},
+		{
+			name: "Success/Goto 新しいバージョンへ適用",
+			run:  func(ctx context.Context, m *migration.Migrator) error { return m.Goto(ctx, 2) },
+			expect: func(mock sqlmock.Sqlmock) {
+				expectLock(mock, 0, false)
+				expectApply(mock, "CREATE TABLE a ();", 1)
+				expectApply(mock, "CREATE TABLE b ();", 2)
+				expectUnlock(mock)
+			},
+		},
+		{
+			name: "Success/Force SQLを実行せずにバージョンを記録",
+			run:  func(ctx context.Context, m *migration.Migrator) error { return m.Force(ctx, 3) },
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_lock($1)")).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS schema_migrations")).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM schema_migrations")).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO schema_migrations (version, dirty) VALUES ($1, false)")).
+					WithArgs(int64(3)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+				expectUnlock(mock)
+			},
+		},
+		{
+			name:    "failure/Down ダウンファイルがない",
+			run:     func(ctx context.Context, m *migration.Migrator) error { return m.Down(ctx, 1) },
+			wantErr: "バージョン 3 (create_c) のダウンファイルがありません",
+			expect: func(mock sqlmock.Sqlmock) {
+				expectLock(mock, 3, false)
+				expectUnlock(mock)
+			},
+		},
+		{
+			name:    "failure/Goto 存在しないバージョン",
+			run:     func(ctx context.Context, m *migration.Migrator) error { return m.Goto(ctx, 9) },
+			wantErr: "バージョン 9 のマイグレーションがありません",
+			expect:  func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			name:    "failure/前回のマイグレーションが途中で失敗",
+			run:     func(ctx context.Context, m *migration.Migrator) error { return m.Up(ctx) },
+			wantErr: "バージョン 2 のマイグレーションが途中で失敗しています。データベースを確認して ggen migrate force で解除してください",
+			expect: func(mock sqlmock.Sqlmock) {
+				expectLock(mock, 2, true)
+				expectUnlock(mock)
+			},
+		},
+		{
+			name:    "failure/SQLのエラーはロールバックしてバージョンを記録しない",
+			run:     func(ctx context.Context, m *migration.Migrator) error { return m.Up(ctx) },
+			wantErr: "バージョン 3 (create_c) の適用に失敗しました: syntax error",
+			expect: func(mock sqlmock.Sqlmock) {
+				expectLock(mock, 2, false)
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE c ();")).WillReturnError(errors.New("syntax error"))
+				mock.ExpectRollback()
+				expectUnlock(mock)
+			},
+		},
+		{
+			name:    "failure/ロックを取得できない",
+			run:     func(ctx context.Context, m *migration.Migrator) error { return m.Up(ctx) },
+			wantErr: "マイグレーションのロックを取得できませんでした: canceling statement due to statement timeout",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_lock($1)")).
+					WillReturnError(errors.New("canceling statement due to statement timeout"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock := testutils.NewTestClient(t)
+			tt.expect(mock)
+
+			m, err := migration.New(client, testMigrations, logger.New(logger.DefaultConfig()))
+			require.NoError(t, err)
+
+			err = tt.run(context.Background(), m)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMigrator_Status(t *testing.T) {
+	client, mock := testutils.NewTestClient(t)
+	expectLock(mock, 2, false)
+	expectUnlock(mock)
+
+	m, err := migration.New(client, testMigrations, logger.New(logger.DefaultConfig()))
+	require.NoError(t, err)
+
+	got, err := m.Status(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &migration.Status{
+		Version: 2,
+		Migrations: []*migration.MigrationStatus{
+			{Version: 1, Name: "create_a", Applied: true},
+			{Version: 2, Name: "create_b", Applied: true},
+			{Version: 3, Name: "create_c", Applied: false},
+		},
+	}, got)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrator_UpLint(t *testing.T) {
+	client, mock := testutils.NewTestClient(t)
+	// 破壊的な文があれば、前のバージョンも含めて何も適用しない
+	expectLock(mock, 0, false)
+	expectUnlock(mock)
+
+	fsys := fstest.MapFS{
+		"000001_create_a.up.sql":   {Data: []byte("CREATE TABLE a ();")},
+		"000002_recreate_a.up.sql": {Data: []byte("DROP TABLE IF EXISTS a CASCADE;\nCREATE TABLE a ();")},
+	}
+	m, err := migration.New(client, fsys, logger.New(logger.DefaultConfig()))
+	require.NoError(t, err)
+
+	err = m.Up(context.Background())
+	var lintErr *migration.LintError
+	require.ErrorAs(t, err, &lintErr)
+	assert.Equal(t, uint64(2), lintErr.Version)
+	assert.Equal(t, 1, lintErr.Line)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
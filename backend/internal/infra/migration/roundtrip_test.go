@@ -0,0 +1,83 @@
+package migration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/infra/db"
+	"g_gen/internal/infra/logger"
+	"g_gen/internal/infra/migration"
+	"g_gen/migrations"
+	"g_gen/tests/testutils"
+)
+
+// schemaQueries スキーマの比較に使う定義（バージョンテーブルは除く）
+var schemaQueries = []string{
+	`SELECT 'column ' || table_name || '.' || column_name || ' ' || data_type || ' nullable=' || is_nullable || ' default=' || COALESCE(column_default, '')
+	   FROM information_schema.columns WHERE table_schema = 'public' AND table_name <> 'schema_migrations'`,
+	`SELECT 'index ' || indexdef FROM pg_indexes WHERE schemaname = 'public' AND tablename <> 'schema_migrations'`,
+	`SELECT 'constraint ' || conrelid::regclass || ' ' || pg_get_constraintdef(oid)
+	   FROM pg_constraint WHERE connamespace = 'public'::regnamespace AND conrelid <> 'schema_migrations'::regclass`,
+	`SELECT 'trigger ' || event_object_table || '.' || trigger_name || ' ' || event_manipulation
+	   FROM information_schema.triggers WHERE trigger_schema = 'public'`,
+	`SELECT 'function ' || routine_name FROM information_schema.routines WHERE routine_schema = 'public'`,
+}
+
+// schemaSnapshot スキーマの定義を並べ替えて返す
+func schemaSnapshot(t *testing.T, client db.Client) []string {
+	t.Helper()
+
+	var snapshot []string
+	for _, query := range schemaQueries {
+		var rows []string
+		require.NoError(t, client.Conn(context.Background()).Raw(query+" ORDER BY 1").Scan(&rows).Error)
+		snapshot = append(snapshot, rows...)
+	}
+
+	return snapshot
+}
+
+// TestMigrator_RoundTrip 埋め込んだマイグレーションを1つずつ適用してからロールバックし、各バージョンのスキーマが元に戻ることを確認する
+func TestMigrator_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	client := testutils.SetupEmptyTestDB(t)
+
+	m, err := migration.New(client, migrations.FS, logger.New(logger.DefaultConfig()))
+	require.NoError(t, err)
+	all, err := migration.Load(migrations.FS)
+	require.NoError(t, err)
+
+	// 空のデータベースにバージョンテーブルを作成してから比較を始める
+	require.NoError(t, m.Goto(ctx, 0))
+	snapshots := [][]string{schemaSnapshot(t, client)}
+	for _, mig := range all {
+		require.NoError(t, m.Goto(ctx, mig.Version), "バージョン %d (%s) の適用", mig.Version, mig.Name)
+		snapshots = append(snapshots, schemaSnapshot(t, client))
+	}
+
+	for i := len(all) - 1; i >= 0; i-- {
+		require.NoError(t, m.Down(ctx, 1), "バージョン %d (%s) のロールバック", all[i].Version, all[i].Name)
+		if diff := cmp.Diff(snapshots[i], schemaSnapshot(t, client)); diff != "" {
+			t.Errorf("バージョン %d (%s) のロールバック後のスキーマが適用前と異なります (-want +got):\n%s", all[i].Version, all[i].Name, diff)
+		}
+	}
+
+	// すべてロールバックした後にもう一度適用できる
+	require.NoError(t, m.Up(ctx))
+	if diff := cmp.Diff(snapshots[len(snapshots)-1], schemaSnapshot(t, client)); diff != "" {
+		t.Errorf("再適用後のスキーマが異なります (-want +got):\n%s", diff)
+	}
+
+	// 適用済みのマイグレーションをもう一度実行しても、データは消えない
+	require.NoError(t, client.Conn(ctx).Exec("UPDATE prefectures SET name = '北海道（更新）' WHERE code = '01'").Error)
+	require.NoError(t, m.Force(ctx, 0))
+	require.NoError(t, m.Goto(ctx, all[0].Version))
+	var name string
+	require.NoError(t, client.Conn(ctx).Raw("SELECT name FROM prefectures WHERE code = '01'").Scan(&name).Error)
+	if diff := cmp.Diff("北海道（更新）", name); diff != "" {
+		t.Errorf("再実行で都道府県マスタのデータが変わりました (-want +got):\n%s", diff)
+	}
+}
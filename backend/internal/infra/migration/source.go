@@ -0,0 +1,89 @@
+package migration
+
+import (
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// Migration 1つのバージョンのマイグレーション
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	// Down ロールバックのSQL。ダウンファイルがない場合はHasDownがfalseになる
+	Down    string
+	HasDown bool
+}
+
+// fileNamePattern マイグレーションファイル名（golang-migrateと同じ {バージョン}_{名前}.{up|down}.sql）
+var fileNamePattern = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// Load fsys直下のマイグレーションファイルを読み込み、バージョン順に返す
+func Load(fsys fs.FS) ([]*Migration, error) {
+	files, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[uint64]*Migration, len(files))
+	upFiles := make(map[uint64]string, len(files))
+	downs := make(map[uint64]string)
+	for _, file := range files {
+		matches := fileNamePattern.FindStringSubmatch(file)
+		if matches == nil {
+			return nil, fmt.Errorf("マイグレーションファイル名が正しくありません: %s", file)
+		}
+
+		version, err := strconv.ParseUint(matches[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("マイグレーションファイル名が正しくありません: %s", file)
+		}
+
+		if version == 0 {
+			return nil, fmt.Errorf("バージョンは1以上にしてください: %s", file)
+		}
+
+		content, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+
+		if matches[3] == "down" {
+			if _, ok := downs[version]; ok {
+				return nil, fmt.Errorf("バージョン %d のダウンファイルが重複しています: %s", version, file)
+			}
+			downs[version] = string(content)
+
+			continue
+		}
+
+		if upFile, ok := upFiles[version]; ok {
+			return nil, fmt.Errorf("バージョン %d のマイグレーションが重複しています: %s, %s", version, upFile, file)
+		}
+		upFiles[version] = file
+		byVersion[version] = &Migration{Version: version, Name: matches[2], Up: string(content)}
+	}
+
+	for version, down := range downs {
+		m, ok := byVersion[version]
+		if !ok {
+			return nil, fmt.Errorf("バージョン %d のアップファイルがありません", version)
+		}
+
+		m.Down = down
+		m.HasDown = true
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		migrations = append(migrations, m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
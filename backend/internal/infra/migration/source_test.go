@@ -0,0 +1,78 @@
+package migration_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/infra/migration"
+	"g_gen/migrations"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		fsys    fstest.MapFS
+		want    []*migration.Migration
+		wantErr string
+	}{
+		{
+			name: "Success/バージョン順に読み込む",
+			fsys: fstest.MapFS{
+				"000002_create_b.up.sql":   {Data: []byte("CREATE TABLE b ();")},
+				"000001_create_a.up.sql":   {Data: []byte("CREATE TABLE a ();")},
+				"000001_create_a.down.sql": {Data: []byte("DROP TABLE a;")},
+				"README.md":                {Data: []byte("対象外")},
+			},
+			want: []*migration.Migration{
+				{Version: 1, Name: "create_a", Up: "CREATE TABLE a ();", Down: "DROP TABLE a;", HasDown: true},
+				{Version: 2, Name: "create_b", Up: "CREATE TABLE b ();"},
+			},
+		},
+		{
+			name:    "failure/ファイル名が正しくない",
+			fsys:    fstest.MapFS{"create_a.up.sql": {}},
+			wantErr: "マイグレーションファイル名が正しくありません: create_a.up.sql",
+		},
+		{
+			name:    "failure/バージョンが0",
+			fsys:    fstest.MapFS{"000000_init.up.sql": {}},
+			wantErr: "バージョンは1以上にしてください: 000000_init.up.sql",
+		},
+		{
+			name: "failure/バージョンが重複",
+			fsys: fstest.MapFS{
+				"000001_create_a.up.sql": {},
+				"1_create_b.up.sql":      {},
+			},
+			wantErr: "バージョン 1 のマイグレーションが重複しています: 000001_create_a.up.sql, 1_create_b.up.sql",
+		},
+		{
+			name:    "failure/アップファイルがない",
+			fsys:    fstest.MapFS{"000001_create_a.down.sql": {}},
+			wantErr: "バージョン 1 のアップファイルがありません",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := migration.Load(tt.fsys)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("Success/埋め込んだマイグレーションファイル", func(t *testing.T) {
+		got, err := migration.Load(migrations.FS)
+		require.NoError(t, err)
+		require.NotEmpty(t, got)
+		assert.Equal(t, uint64(1), got[0].Version)
+	})
+}
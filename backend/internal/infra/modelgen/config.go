@@ -0,0 +1,67 @@
+package modelgen
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config 生成の設定ファイル
+type Config struct {
+	// Relations 外部キーから導出したリレーションの上書き・追加
+	Relations []*Relation `yaml:"relations"`
+}
+
+// LoadConfig 設定ファイルを読み込む
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &Config{}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("設定ファイルを読み込めませんでした: %s: %w", path, err)
+	}
+
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("設定ファイルが正しくありません: %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+func (c *Config) validate() error {
+	var errs []error
+	seen := make(map[string]bool, len(c.Relations))
+	for i, r := range c.Relations {
+		if r.Table == "" || r.Field == "" {
+			errs = append(errs, fmt.Errorf("relations[%d]: table と field を指定してください", i))
+
+			continue
+		}
+
+		if seen[r.key()] {
+			errs = append(errs, fmt.Errorf("relations[%d]: %s が重複しています", i, r.key()))
+		}
+		seen[r.key()] = true
+
+		if r.Skip {
+			continue
+		}
+
+		if _, ok := relationshipTypes[r.Type]; !ok {
+			errs = append(errs, fmt.Errorf("relations[%d]: %s の type が正しくありません: %q (belongs_to, has_one, has_many, many_to_many)", i, r.key(), r.Type))
+		}
+		if r.Related == "" {
+			errs = append(errs, fmt.Errorf("relations[%d]: %s の related を指定してください", i, r.key()))
+		}
+		if r.Type == ManyToMany && r.JoinTable == "" {
+			errs = append(errs, fmt.Errorf("relations[%d]: %s の join_table を指定してください", i, r.key()))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,103 @@
+package modelgen_test
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/infra/modelgen"
+)
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    *modelgen.Config
+		wantErr string
+	}{
+		{
+			name: "Success/リレーションの上書きと除外",
+			content: `relations:
+  - table: municipalities
+    field: Prefecture
+    type: belongs_to
+    related: prefectures
+    foreign_key: PrefectureCode
+    references: Code
+  - table: regions
+    field: Prefectures
+    skip: true
+`,
+			want: &modelgen.Config{Relations: []*modelgen.Relation{
+				{Table: "municipalities", Field: "Prefecture", Type: modelgen.BelongsTo, Related: "prefectures", ForeignKey: "PrefectureCode", References: "Code"},
+				{Table: "regions", Field: "Prefectures", Skip: true},
+			}},
+		},
+		{
+			name:    "Success/空のファイル",
+			content: "",
+			want:    &modelgen.Config{},
+		},
+		{
+			name: "failure/項目が正しくない",
+			content: `relations:
+  - table: municipalities
+    type: belongs_to
+  - table: prefectures
+    field: Region
+    type: belongs
+  - table: damage_reports
+    field: WorkCategories
+    type: many_to_many
+    related: work_categories
+  - table: prefectures
+    field: Region
+    skip: true
+`,
+			wantErr: "relations[0]: table と field を指定してください\n" +
+				"relations[1]: prefectures.Region の type が正しくありません: \"belongs\" (belongs_to, has_one, has_many, many_to_many)\n" +
+				"relations[1]: prefectures.Region の related を指定してください\n" +
+				"relations[2]: damage_reports.WorkCategories の join_table を指定してください\n" +
+				"relations[3]: prefectures.Region が重複しています",
+		},
+		{
+			name:    "failure/YAMLが正しくない",
+			content: "relations: {",
+			wantErr: "設定ファイルを読み込めませんでした",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "gormgen.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+
+			got, err := modelgen.LoadConfig(path)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("LoadConfig() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("failure/ファイルがない", func(t *testing.T) {
+		_, err := modelgen.LoadConfig(filepath.Join(t.TempDir(), "gormgen.yaml"))
+		assert.ErrorIs(t, err, fs.ErrNotExist)
+	})
+
+	t.Run("Success/リポジトリの設定ファイル", func(t *testing.T) {
+		_, err := modelgen.LoadConfig("../../../gormgen.yaml")
+		assert.NoError(t, err)
+	})
+}
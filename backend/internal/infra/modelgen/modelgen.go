@@ -0,0 +1,72 @@
+package modelgen
+
+import (
+	"fmt"
+	"slices"
+
+	"gorm.io/gen"
+	"gorm.io/gorm"
+
+	"g_gen/internal/infra/migration"
+)
+
+// Generate バージョンテーブルを除くすべてのテーブルのモデルとクエリを、外部キーから導出したリレーション付きで生成する
+// cfgのリレーションは導出したリレーションより優先する（cfgがnilの場合は導出したリレーションだけを使う）
+func Generate(conn *gorm.DB, outPath string, cfg *Config) error {
+	tables, err := conn.Migrator().GetTables()
+	if err != nil {
+		return err
+	}
+	tables = slices.DeleteFunc(tables, func(table string) bool {
+		return table == migration.VersionTable
+	})
+	slices.Sort(tables)
+
+	fks, err := ForeignKeys(conn)
+	if err != nil {
+		return err
+	}
+
+	var overrides []*Relation
+	if cfg != nil {
+		overrides = cfg.Relations
+	}
+	relations := MergeRelations(DeriveRelations(fks), overrides)
+
+	for _, r := range relations {
+		if !slices.Contains(tables, r.Table) {
+			return fmt.Errorf("リレーション %s のテーブルがありません", r.key())
+		}
+		if !slices.Contains(tables, r.Related) {
+			return fmt.Errorf("リレーション %s の関連先のテーブルがありません: %s", r.key(), r.Related)
+		}
+	}
+
+	g := gen.NewGenerator(gen.Config{
+		OutPath:           outPath,
+		Mode:              gen.WithoutContext | gen.WithDefaultQuery | gen.WithQueryInterface,
+		FieldWithIndexTag: true,
+		FieldWithTypeTag:  true,
+		FieldNullable:     true,
+	})
+	g.UseDB(conn)
+
+	models := make([]any, 0, len(tables))
+	for _, table := range tables {
+		var opts []gen.ModelOpt
+		for _, r := range relations {
+			if r.Table != table {
+				continue
+			}
+
+			// 関連先はリレーションなしのモデルを参照し、モデルどうしの循環を避ける
+			opts = append(opts, gen.FieldRelate(relationshipTypes[r.Type], r.Field, g.GenerateModel(r.Related), r.relateConfig()))
+		}
+		models = append(models, g.GenerateModel(table, opts...))
+	}
+
+	g.ApplyBasic(models...)
+	g.Execute()
+
+	return nil
+}
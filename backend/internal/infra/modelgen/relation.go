@@ -0,0 +1,218 @@
+package modelgen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jinzhu/inflection"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// RelationType リレーションの種類
+type RelationType string
+
+const (
+	BelongsTo  RelationType = "belongs_to"
+	HasOne     RelationType = "has_one"
+	HasMany    RelationType = "has_many"
+	ManyToMany RelationType = "many_to_many"
+)
+
+// relationshipTypes gorm.io/genのリレーションの種類
+var relationshipTypes = map[RelationType]field.RelationshipType{
+	BelongsTo:  field.BelongsTo,
+	HasOne:     field.HasOne,
+	HasMany:    field.HasMany,
+	ManyToMany: field.Many2Many,
+}
+
+// ForeignKey データベースの外部キー制約
+type ForeignKey struct {
+	Name  string
+	Table string
+	// Columns 外部キーの列（複合キーの場合は定義順）
+	Columns         []string
+	ReferencedTable string
+	// ReferencedColumns 参照先の列（Columnsと同じ順）
+	ReferencedColumns []string
+}
+
+// Relation モデルに追加するリレーションのフィールド
+type Relation struct {
+	// Table フィールドを追加するモデルのテーブル
+	Table string `yaml:"table"`
+	// Field フィールド名
+	Field string       `yaml:"field"`
+	Type  RelationType `yaml:"type"`
+	// Related 関連先のモデルのテーブル
+	Related string `yaml:"related"`
+	// ForeignKey gormタグのforeignKey（Goのフィールド名。複合キーはカンマ区切り）
+	ForeignKey string `yaml:"foreign_key"`
+	// References gormタグのreferences（Goのフィールド名。複合キーはカンマ区切り）
+	References string `yaml:"references"`
+	// JoinTable many_to_many の中間テーブル
+	JoinTable string `yaml:"join_table"`
+	// Skip 外部キーから導出したリレーションを生成しない
+	Skip bool `yaml:"skip"`
+}
+
+// relateConfig gorm.io/genのリレーションの設定
+func (r *Relation) relateConfig() *field.RelateConfig {
+	tag := field.GormTag{}
+	if r.ForeignKey != "" {
+		tag.Set("foreignKey", r.ForeignKey)
+	}
+	if r.References != "" {
+		tag.Set("references", r.References)
+	}
+	if r.JoinTable != "" {
+		tag.Set("many2many", r.JoinTable)
+	}
+
+	return &field.RelateConfig{GORMTag: tag}
+}
+
+func (r *Relation) key() string {
+	return r.Table + "." + r.Field
+}
+
+// foreignKeysQuery 現在のスキーマの外部キー制約の列と参照先の列
+const foreignKeysQuery = `
+SELECT kcu.constraint_name, kcu.table_name, kcu.column_name,
+       rku.table_name AS referenced_table, rku.column_name AS referenced_column
+FROM information_schema.referential_constraints rc
+         JOIN information_schema.key_column_usage kcu
+              ON kcu.constraint_schema = rc.constraint_schema AND kcu.constraint_name = rc.constraint_name
+         JOIN information_schema.key_column_usage rku
+              ON rku.constraint_schema = rc.unique_constraint_schema AND rku.constraint_name = rc.unique_constraint_name
+                  AND rku.ordinal_position = kcu.position_in_unique_constraint
+WHERE rc.constraint_schema = current_schema()
+ORDER BY kcu.table_name, kcu.constraint_name, kcu.ordinal_position`
+
+// ForeignKeys information_schemaから外部キー制約を読み込む
+func ForeignKeys(conn *gorm.DB) ([]*ForeignKey, error) {
+	var rows []struct {
+		ConstraintName   string
+		TableName        string
+		ColumnName       string
+		ReferencedTable  string
+		ReferencedColumn string
+	}
+	if err := conn.Raw(foreignKeysQuery).Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("外部キー制約を取得できませんでした: %w", err)
+	}
+
+	var fks []*ForeignKey
+	for _, row := range rows {
+		if len(fks) == 0 || fks[len(fks)-1].Name != row.ConstraintName || fks[len(fks)-1].Table != row.TableName {
+			fks = append(fks, &ForeignKey{Name: row.ConstraintName, Table: row.TableName, ReferencedTable: row.ReferencedTable})
+		}
+
+		fk := fks[len(fks)-1]
+		fk.Columns = append(fk.Columns, row.ColumnName)
+		fk.ReferencedColumns = append(fk.ReferencedColumns, row.ReferencedColumn)
+	}
+
+	return fks, nil
+}
+
+// namer gorm.io/genと同じ命名規則（テーブル名から構造体名、列名からフィールド名を作る）
+var namer = schema.NamingStrategy{}
+
+// DeriveRelations 外部キー制約からリレーションを導出する
+// 外部キーを持つテーブルには参照先へのbelongs_to（列名から _id・_code を除いた名前）、
+// 参照先のテーブルには外部キーを持つテーブルへのhas_many（構造体名の複数形）を追加する
+// 同じテーブルから同じ参照先への外部キーが複数ある場合、has_manyの名前の前にbelongs_toの名前を付けて区別する
+func DeriveRelations(fks []*ForeignKey) []*Relation {
+	pairs := make(map[string]int, len(fks))
+	for _, fk := range fks {
+		pairs[fk.Table+"->"+fk.ReferencedTable]++
+	}
+
+	var relations []*Relation
+	for _, fk := range fks {
+		foreignKey := fieldNames(fk.Columns)
+		references := fieldNames(fk.ReferencedColumns)
+
+		belongsTo := belongsToName(fk)
+		relations = append(relations, &Relation{
+			Table:      fk.Table,
+			Field:      belongsTo,
+			Type:       BelongsTo,
+			Related:    fk.ReferencedTable,
+			ForeignKey: foreignKey,
+			References: references,
+		})
+
+		hasMany := inflection.Plural(namer.SchemaName(fk.Table))
+		if pairs[fk.Table+"->"+fk.ReferencedTable] > 1 {
+			hasMany = belongsTo + hasMany
+		}
+		relations = append(relations, &Relation{
+			Table:      fk.ReferencedTable,
+			Field:      hasMany,
+			Type:       HasMany,
+			Related:    fk.Table,
+			ForeignKey: foreignKey,
+			References: references,
+		})
+	}
+
+	return relations
+}
+
+// belongsToName 外部キーの列名から _id・_code を除いた名前。複合キーや除いた結果が空の場合は参照先の構造体名
+func belongsToName(fk *ForeignKey) string {
+	if len(fk.Columns) == 1 {
+		for _, suffix := range []string{"_id", "_code"} {
+			if name, ok := strings.CutSuffix(fk.Columns[0], suffix); ok && name != "" {
+				return namer.SchemaName(name)
+			}
+		}
+	}
+
+	return namer.SchemaName(fk.ReferencedTable)
+}
+
+func fieldNames(columns []string) string {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		names[i] = namer.SchemaName(column)
+	}
+
+	return strings.Join(names, ",")
+}
+
+// MergeRelations 導出したリレーションに設定ファイルのリレーションを適用する
+// テーブルとフィールド名が同じリレーションは設定ファイルで置き換え（skipの場合は除き）、それ以外は追加する
+func MergeRelations(derived, overrides []*Relation) []*Relation {
+	byKey := make(map[string]*Relation, len(derived)+len(overrides))
+	for _, r := range derived {
+		byKey[r.key()] = r
+	}
+	for _, r := range overrides {
+		if r.Skip {
+			delete(byKey, r.key())
+
+			continue
+		}
+		byKey[r.key()] = r
+	}
+
+	relations := make([]*Relation, 0, len(byKey))
+	for _, r := range byKey {
+		relations = append(relations, r)
+	}
+	sort.Slice(relations, func(i, j int) bool {
+		if relations[i].Table != relations[j].Table {
+			return relations[i].Table < relations[j].Table
+		}
+
+		return relations[i].Field < relations[j].Field
+	})
+
+	return relations
+}
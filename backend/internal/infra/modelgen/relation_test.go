@@ -0,0 +1,141 @@
+package modelgen_test
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/infra/modelgen"
+	"g_gen/tests/testutils"
+)
+
+func TestForeignKeys(t *testing.T) {
+	columns := []string{"constraint_name", "table_name", "column_name", "referenced_table", "referenced_column"}
+
+	t.Run("Success/制約ごとに列をまとめる", func(t *testing.T) {
+		client, mock := testutils.NewTestClient(t)
+		mock.ExpectQuery(regexp.QuoteMeta("FROM information_schema.referential_constraints rc")).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow("municipalities_prefecture_code_fkey", "municipalities", "prefecture_code", "prefectures", "code").
+				AddRow("reports_area_fkey", "reports", "prefecture_code", "areas", "prefecture_code").
+				AddRow("reports_area_fkey", "reports", "area_code", "areas", "code"))
+
+		got, err := modelgen.ForeignKeys(client.Conn(t.Context()))
+		require.NoError(t, err)
+		want := []*modelgen.ForeignKey{
+			{
+				Name:              "municipalities_prefecture_code_fkey",
+				Table:             "municipalities",
+				Columns:           []string{"prefecture_code"},
+				ReferencedTable:   "prefectures",
+				ReferencedColumns: []string{"code"},
+			},
+			{
+				Name:              "reports_area_fkey",
+				Table:             "reports",
+				Columns:           []string{"prefecture_code", "area_code"},
+				ReferencedTable:   "areas",
+				ReferencedColumns: []string{"prefecture_code", "code"},
+			},
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("ForeignKeys() mismatch (-want +got):\n%s", diff)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("failure/DBエラー", func(t *testing.T) {
+		client, mock := testutils.NewTestClient(t)
+		mock.ExpectQuery(regexp.QuoteMeta("FROM information_schema.referential_constraints rc")).
+			WillReturnError(errors.New("connection refused"))
+
+		_, err := modelgen.ForeignKeys(client.Conn(t.Context()))
+		assert.EqualError(t, err, "外部キー制約を取得できませんでした: connection refused")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestDeriveRelations(t *testing.T) {
+	tests := []struct {
+		name string
+		fks  []*modelgen.ForeignKey
+		want []*modelgen.Relation
+	}{
+		{
+			name: "Success/belongs_toとhas_manyを導出",
+			fks: []*modelgen.ForeignKey{
+				{Table: "municipalities", Columns: []string{"prefecture_code"}, ReferencedTable: "prefectures", ReferencedColumns: []string{"code"}},
+				{Table: "municipality_successions", Columns: []string{"successor_code"}, ReferencedTable: "municipalities", ReferencedColumns: []string{"organization_code"}},
+				{Table: "prefectures", Columns: []string{"region_id"}, ReferencedTable: "regions", ReferencedColumns: []string{"id"}},
+			},
+			want: []*modelgen.Relation{
+				{Table: "municipalities", Field: "Prefecture", Type: modelgen.BelongsTo, Related: "prefectures", ForeignKey: "PrefectureCode", References: "Code"},
+				{Table: "prefectures", Field: "Municipalities", Type: modelgen.HasMany, Related: "municipalities", ForeignKey: "PrefectureCode", References: "Code"},
+				{Table: "municipality_successions", Field: "Successor", Type: modelgen.BelongsTo, Related: "municipalities", ForeignKey: "SuccessorCode", References: "OrganizationCode"},
+				{Table: "municipalities", Field: "MunicipalitySuccessions", Type: modelgen.HasMany, Related: "municipality_successions", ForeignKey: "SuccessorCode", References: "OrganizationCode"},
+				{Table: "prefectures", Field: "Region", Type: modelgen.BelongsTo, Related: "regions", ForeignKey: "RegionID", References: "ID"},
+				{Table: "regions", Field: "Prefectures", Type: modelgen.HasMany, Related: "prefectures", ForeignKey: "RegionID", References: "ID"},
+			},
+		},
+		{
+			name: "Success/同じ参照先への外部キーが複数ある場合はhas_manyの名前で区別",
+			fks: []*modelgen.ForeignKey{
+				{Table: "transfers", Columns: []string{"from_municipality_code"}, ReferencedTable: "municipalities", ReferencedColumns: []string{"organization_code"}},
+				{Table: "transfers", Columns: []string{"to_municipality_code"}, ReferencedTable: "municipalities", ReferencedColumns: []string{"organization_code"}},
+			},
+			want: []*modelgen.Relation{
+				{Table: "transfers", Field: "FromMunicipality", Type: modelgen.BelongsTo, Related: "municipalities", ForeignKey: "FromMunicipalityCode", References: "OrganizationCode"},
+				{Table: "municipalities", Field: "FromMunicipalityTransfers", Type: modelgen.HasMany, Related: "transfers", ForeignKey: "FromMunicipalityCode", References: "OrganizationCode"},
+				{Table: "transfers", Field: "ToMunicipality", Type: modelgen.BelongsTo, Related: "municipalities", ForeignKey: "ToMunicipalityCode", References: "OrganizationCode"},
+				{Table: "municipalities", Field: "ToMunicipalityTransfers", Type: modelgen.HasMany, Related: "transfers", ForeignKey: "ToMunicipalityCode", References: "OrganizationCode"},
+			},
+		},
+		{
+			name: "Success/複合キーは参照先の構造体名",
+			fks: []*modelgen.ForeignKey{
+				{Table: "reports", Columns: []string{"prefecture_code", "area_code"}, ReferencedTable: "areas", ReferencedColumns: []string{"prefecture_code", "code"}},
+			},
+			want: []*modelgen.Relation{
+				{Table: "reports", Field: "Area", Type: modelgen.BelongsTo, Related: "areas", ForeignKey: "PrefectureCode,AreaCode", References: "PrefectureCode,Code"},
+				{Table: "areas", Field: "Reports", Type: modelgen.HasMany, Related: "reports", ForeignKey: "PrefectureCode,AreaCode", References: "PrefectureCode,Code"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := modelgen.DeriveRelations(tt.fks)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("DeriveRelations() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMergeRelations(t *testing.T) {
+	derived := []*modelgen.Relation{
+		{Table: "prefectures", Field: "Region", Type: modelgen.BelongsTo, Related: "regions", ForeignKey: "RegionID", References: "ID"},
+		{Table: "regions", Field: "Prefectures", Type: modelgen.HasMany, Related: "prefectures", ForeignKey: "RegionID", References: "ID"},
+		{Table: "municipalities", Field: "Prefecture", Type: modelgen.BelongsTo, Related: "prefectures", ForeignKey: "PrefectureCode", References: "Code"},
+	}
+	overrides := []*modelgen.Relation{
+		{Table: "regions", Field: "Prefectures", Skip: true},
+		{Table: "municipalities", Field: "Prefecture", Type: modelgen.HasOne, Related: "prefectures", ForeignKey: "Code", References: "PrefectureCode"},
+		{Table: "damage_reports", Field: "WorkCategories", Type: modelgen.ManyToMany, Related: "work_categories", JoinTable: "damage_report_work_categories"},
+	}
+
+	got := modelgen.MergeRelations(derived, overrides)
+	want := []*modelgen.Relation{
+		{Table: "damage_reports", Field: "WorkCategories", Type: modelgen.ManyToMany, Related: "work_categories", JoinTable: "damage_report_work_categories"},
+		{Table: "municipalities", Field: "Prefecture", Type: modelgen.HasOne, Related: "prefectures", ForeignKey: "Code", References: "PrefectureCode"},
+		{Table: "prefectures", Field: "Region", Type: modelgen.BelongsTo, Related: "regions", ForeignKey: "RegionID", References: "ID"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("MergeRelations() mismatch (-want +got):\n%s", diff)
+	}
+}
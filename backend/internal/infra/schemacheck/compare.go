@@ -0,0 +1,359 @@
+package schemacheck
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gorm.io/gorm/schema"
+)
+
+// typeAliases gormタグの型名とPostgreSQLの型名（udt_name）の対応
+var typeAliases = map[string]string{
+	"smallint":                    "int2",
+	"integer":                     "int4",
+	"int":                         "int4",
+	"bigint":                      "int8",
+	"character varying":           "varchar",
+	"character":                   "bpchar",
+	"char":                        "bpchar",
+	"boolean":                     "bool",
+	"timestamp with time zone":    "timestamptz",
+	"timestamp without time zone": "timestamp",
+	"real":                        "float4",
+	"double precision":            "float8",
+	"decimal":                     "numeric",
+}
+
+// typeNames 差異の表示に使うPostgreSQLの型名
+var typeNames = map[string]string{
+	"int2":        "smallint",
+	"int4":        "integer",
+	"int8":        "bigint",
+	"varchar":     "character varying",
+	"bpchar":      "character",
+	"bool":        "boolean",
+	"timestamptz": "timestamp with time zone",
+	"timestamp":   "timestamp without time zone",
+	"float4":      "real",
+	"float8":      "double precision",
+}
+
+// goTypes PostgreSQLの型に対応するGoの型。ここにない型はGoの型を比較しない
+var goTypes = map[string][]string{
+	"int2":        {"int16"},
+	"int4":        {"int32"},
+	"int8":        {"int64"},
+	"varchar":     {"string"},
+	"bpchar":      {"string"},
+	"text":        {"string"},
+	"uuid":        {"string"},
+	"bool":        {"bool"},
+	"timestamptz": {"time.Time"},
+	"timestamp":   {"time.Time"},
+	"date":        {"time.Time"},
+	"float4":      {"float32"},
+	"float8":      {"float64"},
+	"numeric":     {"float64", "string"},
+	"bytea":       {"[]uint8"},
+}
+
+// queryFieldTypes gorm.io/gen/field の型に対応するGoの型。ここにない型（field.Field など）は型を比較しない
+var queryFieldTypes = map[string]string{
+	"Int16":   "int16",
+	"Int32":   "int32",
+	"Int64":   "int64",
+	"String":  "string",
+	"Bool":    "bool",
+	"Time":    "time.Time",
+	"Float32": "float32",
+	"Float64": "float64",
+	"Bytes":   "[]uint8",
+}
+
+const queryFieldPkgPath = "gorm.io/gen/field"
+
+var (
+	typeWithLength = regexp.MustCompile(`^([a-z ]+?)\s*\((\d+)\)$`)
+	scannerType    = reflect.TypeFor[sql.Scanner]()
+)
+
+// Compare モデルとクエリの定義をデータベースのテーブルと比較し、差異を返す
+// 列の有無・型・NULL許容・主キー、主キー以外のインデックスの有無・列・一意性、クエリのフィールドの有無・型を比較する
+func Compare(target Target, actual *Table) ([]*Difference, error) {
+	sch, err := parseModel(target.Model)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &comparison{table: sch.Table}
+	columns := make(map[string]*Column, len(actual.Columns))
+	for _, column := range actual.Columns {
+		columns[column.Name] = column
+	}
+
+	for _, name := range sch.DBNames {
+		f := sch.FieldsByDBName[name]
+		column, ok := columns[name]
+		if !ok {
+			c.add("列 "+name, "", "あり", "なし")
+
+			continue
+		}
+		c.compareColumn(f, column)
+	}
+
+	for _, column := range actual.Columns {
+		if _, ok := sch.FieldsByDBName[column.Name]; !ok {
+			c.add("列 "+column.Name, "", "なし", "あり")
+		}
+	}
+
+	c.compareIndexes(modelIndexes(sch), actual.Indexes)
+
+	if target.Query != nil {
+		c.compareQuery(sch, target.Query, columns)
+	}
+
+	return c.diffs, nil
+}
+
+// comparison 1つのテーブルの比較結果
+type comparison struct {
+	table string
+	diffs []*Difference
+}
+
+func (c *comparison) add(object, item, model, database string) {
+	c.diffs = append(c.diffs, &Difference{Table: c.table, Object: object, Item: item, Model: model, Database: database})
+}
+
+func (c *comparison) compareColumn(f *schema.Field, column *Column) {
+	object := "列 " + f.DBName
+
+	if tag, ok := f.TagSettings["TYPE"]; ok {
+		typ, length := parseType(tag)
+		if typ != column.Type || (isCharacterType(typ) && length != column.Length) {
+			c.add(object, "型", tag, typeName(column.Type, column.Length))
+		}
+	}
+
+	goType := f.FieldType
+	if goType.Kind() == reflect.Pointer {
+		goType = goType.Elem()
+	}
+	if !compatible(goType, column.Type) {
+		c.add(object, "Goの型", goType.String(), typeName(column.Type, column.Length))
+	}
+
+	modelNullable := !f.NotNull && !f.PrimaryKey
+	if modelNullable != column.Nullable {
+		c.add(object, "NULL許容", nullability(modelNullable), nullability(column.Nullable))
+	}
+
+	// NULLを読み込むとエラーになるため、NULL許容の列はポインタかsql.Scannerにする
+	if column.Nullable && f.FieldType.Kind() != reflect.Pointer && !reflect.PointerTo(f.FieldType).Implements(scannerType) {
+		c.add(object, "Goの型", f.FieldType.String()+"（NULLを扱えない）", "NULL許容")
+	}
+
+	if f.PrimaryKey != column.PrimaryKey {
+		c.add(object, "主キー", yesNo(f.PrimaryKey), yesNo(column.PrimaryKey))
+	}
+}
+
+func (c *comparison) compareIndexes(model, actual []*Index) {
+	byName := make(map[string]*Index, len(actual))
+	for _, idx := range actual {
+		byName[idx.Name] = idx
+	}
+
+	for _, want := range model {
+		object := "インデックス " + want.Name
+		got, ok := byName[want.Name]
+		if !ok {
+			c.add(object, "", "あり", "なし")
+
+			continue
+		}
+		delete(byName, want.Name)
+
+		if !slices.Equal(want.Columns, got.Columns) {
+			c.add(object, "列", strings.Join(want.Columns, ", "), strings.Join(got.Columns, ", "))
+		}
+		if want.Unique != got.Unique {
+			c.add(object, "一意", yesNo(want.Unique), yesNo(got.Unique))
+		}
+	}
+
+	for _, idx := range actual {
+		if _, ok := byName[idx.Name]; ok {
+			c.add("インデックス "+idx.Name, "", "なし", "あり")
+		}
+	}
+}
+
+func (c *comparison) compareQuery(sch *schema.Schema, query any, columns map[string]*Column) {
+	fields := queryFields(query)
+	for _, name := range sch.DBNames {
+		if _, ok := fields[name]; !ok {
+			c.add("列 "+name, "クエリのフィールド", "あり", "なし")
+		}
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		column, ok := columns[name]
+		if !ok {
+			// モデルにある列はモデルの比較で報告済み
+			if _, inModel := sch.FieldsByDBName[name]; !inModel {
+				c.add("列 "+name, "", "クエリのフィールドのみ", "なし")
+			}
+
+			continue
+		}
+
+		typ := fields[name]
+		goType, ok := queryFieldTypes[typ.Name()]
+		if !ok {
+			continue
+		}
+
+		if want, ok := goTypes[column.Type]; ok && !slices.Contains(want, goType) {
+			c.add("列 "+name, "クエリのフィールドの型", "field."+typ.Name(), typeName(column.Type, column.Length))
+		}
+	}
+}
+
+// parseModel gormのタグからモデルの定義を読み込む
+func parseModel(model any) (*schema.Schema, error) {
+	sch, err := schema.Parse(model, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		return nil, fmt.Errorf("モデルを読み込めませんでした: %w", err)
+	}
+
+	return sch, nil
+}
+
+func tableName(model any) (string, error) {
+	sch, err := parseModel(model)
+	if err != nil {
+		return "", err
+	}
+
+	return sch.Table, nil
+}
+
+// modelIndexes モデルのindex・uniqueIndexタグのインデックス（名前順）
+func modelIndexes(sch *schema.Schema) []*Index {
+	var indexes []*Index
+	for _, idx := range sch.ParseIndexes() {
+		columns := make([]string, 0, len(idx.Fields))
+		for _, f := range idx.Fields {
+			columns = append(columns, f.DBName)
+		}
+		sort.Strings(columns)
+		indexes = append(indexes, &Index{Name: idx.Name, Columns: columns, Unique: idx.Class == "UNIQUE"})
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		return indexes[i].Name < indexes[j].Name
+	})
+
+	return indexes
+}
+
+// queryFields クエリの gorm.io/gen/field の型のフィールドを列名ごとに返す（リレーションとALLは除く）
+func queryFields(query any) map[string]reflect.Type {
+	v := reflect.Indirect(reflect.ValueOf(query))
+	fields := make(map[string]reflect.Type, v.NumField())
+	for i := range v.NumField() {
+		sf := v.Type().Field(i)
+		if !sf.IsExported() || sf.Type.PkgPath() != queryFieldPkgPath {
+			continue
+		}
+
+		method := v.Field(i).MethodByName("ColumnName")
+		if !method.IsValid() {
+			continue
+		}
+
+		name := method.Call(nil)[0].String()
+		if name == "*" {
+			continue
+		}
+		fields[name] = sf.Type
+	}
+
+	return fields
+}
+
+// parseType gormタグの型をPostgreSQLの型名と文字列の長さに変換する（character varying(10) は varchar と 10）
+func parseType(tag string) (string, int64) {
+	typ := strings.ToLower(strings.TrimSpace(tag))
+
+	var length int64
+	if m := typeWithLength.FindStringSubmatch(typ); m != nil {
+		typ = m[1]
+		length, _ = strconv.ParseInt(m[2], 10, 64)
+	}
+
+	if alias, ok := typeAliases[typ]; ok {
+		typ = alias
+	}
+
+	return typ, length
+}
+
+// typeName 差異の表示に使う型名
+func typeName(typ string, length int64) string {
+	name := typ
+	if n, ok := typeNames[typ]; ok {
+		name = n
+	}
+
+	if length > 0 {
+		name += "(" + strconv.FormatInt(length, 10) + ")"
+	}
+
+	return name
+}
+
+func isCharacterType(typ string) bool {
+	return typ == "varchar" || typ == "bpchar"
+}
+
+// compatible Goの型でPostgreSQLの型の値を読み書きできるか。独自の型（sql.Scanner）と対応表にない型は比較しない
+func compatible(goType reflect.Type, typ string) bool {
+	want, ok := goTypes[typ]
+	if !ok || reflect.PointerTo(goType).Implements(scannerType) {
+		return true
+	}
+
+	return slices.Contains(want, goType.String())
+}
+
+func nullability(nullable bool) string {
+	if nullable {
+		return "NULL"
+	}
+
+	return "NOT NULL"
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "あり"
+	}
+
+	return "なし"
+}
@@ -0,0 +1,144 @@
+package schemacheck_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gen/field"
+
+	"g_gen/internal/domain/model"
+	"g_gen/internal/domain/query"
+	"g_gen/internal/infra/schemacheck"
+	"g_gen/tests/testutils"
+)
+
+// prefectureTable 生成したモデルと一致する都道府県マスタの定義
+func prefectureTable() *schemacheck.Table {
+	return &schemacheck.Table{
+		Name: "prefectures",
+		Columns: []*schemacheck.Column{
+			{Name: "id", Type: "int8", PrimaryKey: true},
+			{Name: "code", Type: "varchar", Length: 2},
+			{Name: "name", Type: "varchar", Length: 10},
+			{Name: "region_id", Type: "int8"},
+			{Name: "updated_at", Type: "timestamptz"},
+		},
+		Indexes: []*schemacheck.Index{
+			{Name: "idx_prefectures_code", Columns: []string{"code"}},
+			{Name: "idx_prefectures_name", Columns: []string{"name"}},
+			{Name: "idx_prefectures_region_id", Columns: []string{"region_id"}},
+		},
+	}
+}
+
+func TestCompare(t *testing.T) {
+	client, _ := testutils.NewTestClient(t)
+	q := query.Use(client.Conn(context.Background()))
+
+	tests := []struct {
+		name   string
+		query  any
+		modify func(table *schemacheck.Table)
+		want   []*schemacheck.Difference
+	}{
+		{
+			name:   "Success/差異なし",
+			query:  q.Prefecture,
+			modify: func(table *schemacheck.Table) {},
+		},
+		{
+			name:  "Success/列の型が異なる",
+			query: q.Prefecture,
+			modify: func(table *schemacheck.Table) {
+				table.Columns[0].Type = "int4"
+				table.Columns[2].Length = 20
+			},
+			want: []*schemacheck.Difference{
+				{Table: "prefectures", Object: "列 id", Item: "型", Model: "bigint", Database: "integer"},
+				{Table: "prefectures", Object: "列 id", Item: "Goの型", Model: "int64", Database: "integer"},
+				{Table: "prefectures", Object: "列 name", Item: "型", Model: "character varying(10)", Database: "character varying(20)"},
+				{Table: "prefectures", Object: "列 id", Item: "クエリのフィールドの型", Model: "field.Int64", Database: "integer"},
+			},
+		},
+		{
+			name:  "Success/NULL許容と主キーが異なる",
+			query: q.Prefecture,
+			modify: func(table *schemacheck.Table) {
+				table.Columns[0].PrimaryKey = false
+				table.Columns[2].Nullable = true
+			},
+			want: []*schemacheck.Difference{
+				{Table: "prefectures", Object: "列 id", Item: "主キー", Model: "あり", Database: "なし"},
+				{Table: "prefectures", Object: "列 name", Item: "NULL許容", Model: "NOT NULL", Database: "NULL"},
+				{Table: "prefectures", Object: "列 name", Item: "Goの型", Model: "string（NULLを扱えない）", Database: "NULL許容"},
+			},
+		},
+		{
+			name:  "Success/列の有無が異なる",
+			query: q.Prefecture,
+			modify: func(table *schemacheck.Table) {
+				table.Columns = append(table.Columns[:4], &schemacheck.Column{Name: "deleted_at", Type: "timestamptz", Nullable: true})
+			},
+			want: []*schemacheck.Difference{
+				{Table: "prefectures", Object: "列 updated_at", Model: "あり", Database: "なし"},
+				{Table: "prefectures", Object: "列 deleted_at", Model: "なし", Database: "あり"},
+			},
+		},
+		{
+			name:  "Success/インデックスが異なる",
+			query: q.Prefecture,
+			modify: func(table *schemacheck.Table) {
+				table.Indexes = []*schemacheck.Index{
+					{Name: "idx_prefectures_code", Columns: []string{"code"}, Unique: true},
+					{Name: "idx_prefectures_code_name", Columns: []string{"code", "name"}},
+					{Name: "idx_prefectures_region_id", Columns: []string{"code", "region_id"}},
+				}
+			},
+			want: []*schemacheck.Difference{
+				{Table: "prefectures", Object: "インデックス idx_prefectures_code", Item: "一意", Model: "なし", Database: "あり"},
+				{Table: "prefectures", Object: "インデックス idx_prefectures_name", Model: "あり", Database: "なし"},
+				{Table: "prefectures", Object: "インデックス idx_prefectures_region_id", Item: "列", Model: "region_id", Database: "code, region_id"},
+				{Table: "prefectures", Object: "インデックス idx_prefectures_code_name", Model: "なし", Database: "あり"},
+			},
+		},
+		{
+			name: "Success/クエリのフィールドが異なる",
+			query: struct {
+				ALL       field.Asterisk
+				ID        field.Int32
+				Code      field.String
+				Name      field.String
+				RegionID  field.Int64
+				DeletedAt field.Time
+			}{
+				ALL:       field.NewAsterisk("prefectures"),
+				ID:        field.NewInt32("prefectures", "id"),
+				Code:      field.NewString("prefectures", "code"),
+				Name:      field.NewString("prefectures", "name"),
+				RegionID:  field.NewInt64("prefectures", "region_id"),
+				DeletedAt: field.NewTime("prefectures", "deleted_at"),
+			},
+			modify: func(table *schemacheck.Table) {},
+			want: []*schemacheck.Difference{
+				{Table: "prefectures", Object: "列 updated_at", Item: "クエリのフィールド", Model: "あり", Database: "なし"},
+				{Table: "prefectures", Object: "列 deleted_at", Model: "クエリのフィールドのみ", Database: "なし"},
+				{Table: "prefectures", Object: "列 id", Item: "クエリのフィールドの型", Model: "field.Int32", Database: "bigint"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := prefectureTable()
+			tt.modify(table)
+
+			got, err := schemacheck.Compare(schemacheck.Target{Model: &model.Prefecture{}, Query: tt.query}, table)
+			require.NoError(t, err)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Compare() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package schemacheck
+
+import (
+	"fmt"
+	"io"
+)
+
+// Report 比較結果
+type Report struct {
+	// Tables 比較したモデルの数
+	Tables      int
+	Differences []*Difference
+}
+
+// Difference モデル・クエリとデータベースの1件の差異
+type Difference struct {
+	Table string
+	// Object 差異のある列・インデックス（テーブルの有無の差異では空）
+	Object string
+	// Item 比較した項目（有無の差異では空）
+	Item     string
+	Model    string
+	Database string
+}
+
+// Write 差異をテーブルごとにまとめ、モデルを - 、データベースを + で書き出す
+func (r *Report) Write(w io.Writer) error {
+	if len(r.Differences) == 0 {
+		_, err := fmt.Fprintf(w, "%d件のモデルとデータベースに差異はありません\n", r.Tables)
+
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, "--- モデル\n+++ データベース\n"); err != nil {
+		return err
+	}
+
+	var table string
+	for _, d := range r.Differences {
+		if d.Table != table {
+			table = d.Table
+			if _, err := fmt.Fprintf(w, "\n%s\n", table); err != nil {
+				return err
+			}
+		}
+
+		title := d.Object
+		switch {
+		case title == "":
+			title = d.Item
+		case d.Item != "":
+			title += " の" + d.Item
+		}
+
+		if _, err := fmt.Fprintf(w, "  %s\n  - %s\n  + %s\n", title, d.Model, d.Database); err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprintf(w, "\n%d件の差異があります\n", len(r.Differences))
+
+	return err
+}
@@ -0,0 +1,64 @@
+package schemacheck_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/infra/schemacheck"
+)
+
+func TestReport_Write(t *testing.T) {
+	tests := []struct {
+		name   string
+		report *schemacheck.Report
+		want   string
+	}{
+		{
+			name:   "Success/差異なし",
+			report: &schemacheck.Report{Tables: 5},
+			want:   "5件のモデルとデータベースに差異はありません\n",
+		},
+		{
+			name: "Success/テーブルごとにまとめて表示",
+			report: &schemacheck.Report{
+				Tables: 2,
+				Differences: []*schemacheck.Difference{
+					{Table: "prefectures", Object: "列 id", Item: "型", Model: "integer", Database: "bigint"},
+					{Table: "prefectures", Object: "インデックス idx_prefectures_name", Model: "あり", Database: "なし"},
+					{Table: "damage_reports", Item: "テーブル", Model: "なし", Database: "あり"},
+				},
+			},
+			want: `--- モデル
++++ データベース
+
+prefectures
+  列 id の型
+  - integer
+  + bigint
+  インデックス idx_prefectures_name
+  - あり
+  + なし
+
+damage_reports
+  テーブル
+  - なし
+  + あり
+
+3件の差異があります
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, tt.report.Write(&buf))
+			if diff := cmp.Diff(tt.want, buf.String()); diff != "" {
+				t.Errorf("Write() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
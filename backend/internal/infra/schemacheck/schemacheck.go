@@ -0,0 +1,133 @@
+package schemacheck
+
+import (
+	"slices"
+	"sort"
+
+	"gorm.io/gorm"
+
+	"g_gen/internal/infra/migration"
+)
+
+// Target 比較するモデルと、gorm.io/genで生成したそのテーブルのクエリ
+type Target struct {
+	// Model model パッケージの構造体のポインタ
+	Model any
+	// Query query パッケージのテーブルのクエリ（query.Use(db).Prefecture など）。nilの場合はクエリを比較しない
+	Query any
+}
+
+// Table データベースのテーブルの定義
+type Table struct {
+	Name    string
+	Columns []*Column
+	Indexes []*Index
+}
+
+// Column データベースの列の定義
+type Column struct {
+	Name string
+	// Type PostgreSQLの型名（int8, varchar など information_schema.columns.udt_name の値）
+	Type string
+	// Length 文字列型の最大長（制限がない場合は0）
+	Length     int64
+	Nullable   bool
+	PrimaryKey bool
+}
+
+// Index 主キー・一意制約以外のインデックスの定義
+type Index struct {
+	Name string
+	// Columns インデックスの列（名前順）
+	Columns []string
+	Unique  bool
+}
+
+// Check 対象のモデル・クエリとデータベースのテーブルを比較する
+// 対象のどのモデルにも対応しないテーブル（バージョンテーブルを除く）も差異として返す
+func Check(conn *gorm.DB, targets []Target) (*Report, error) {
+	tables, err := conn.Migrator().GetTables()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{Tables: len(targets)}
+	checked := make(map[string]bool, len(targets))
+	for _, target := range targets {
+		name, err := tableName(target.Model)
+		if err != nil {
+			return nil, err
+		}
+		checked[name] = true
+
+		if !slices.Contains(tables, name) {
+			report.Differences = append(report.Differences, &Difference{Table: name, Item: "テーブル", Model: "あり", Database: "なし"})
+
+			continue
+		}
+
+		actual, err := Inspect(conn, name)
+		if err != nil {
+			return nil, err
+		}
+
+		diffs, err := Compare(target, actual)
+		if err != nil {
+			return nil, err
+		}
+		report.Differences = append(report.Differences, diffs...)
+	}
+
+	sort.Strings(tables)
+	for _, name := range tables {
+		if name == migration.VersionTable || checked[name] {
+			continue
+		}
+
+		report.Differences = append(report.Differences, &Difference{Table: name, Item: "テーブル", Model: "なし", Database: "あり"})
+	}
+
+	return report, nil
+}
+
+// Inspect gormのMigratorでデータベースのテーブルの定義を取得する
+func Inspect(conn *gorm.DB, name string) (*Table, error) {
+	columnTypes, err := conn.Migrator().ColumnTypes(name)
+	if err != nil {
+		return nil, err
+	}
+
+	table := &Table{Name: name}
+	for _, ct := range columnTypes {
+		column := &Column{Name: ct.Name(), Type: ct.DatabaseTypeName()}
+		if isCharacterType(column.Type) {
+			if length, ok := ct.Length(); ok {
+				column.Length = length
+			}
+		}
+		column.Nullable, _ = ct.Nullable()
+		column.PrimaryKey, _ = ct.PrimaryKey()
+		table.Columns = append(table.Columns, column)
+	}
+
+	indexes, err := conn.Migrator().GetIndexes(name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, idx := range indexes {
+		if primary, _ := idx.PrimaryKey(); primary {
+			continue
+		}
+
+		unique, _ := idx.Unique()
+		columns := slices.Clone(idx.Columns())
+		sort.Strings(columns)
+		table.Indexes = append(table.Indexes, &Index{Name: idx.Name(), Columns: columns, Unique: unique})
+	}
+	sort.Slice(table.Indexes, func(i, j int) bool {
+		return table.Indexes[i].Name < table.Indexes[j].Name
+	})
+
+	return table, nil
+}
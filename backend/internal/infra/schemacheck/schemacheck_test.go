@@ -0,0 +1,37 @@
+package schemacheck_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/domain/query"
+	"g_gen/internal/infra/logger"
+	"g_gen/internal/infra/migration"
+	"g_gen/internal/infra/schemacheck"
+	"g_gen/migrations"
+	"g_gen/tests/testutils"
+)
+
+// TestCheck_Migrated すべてのマイグレーションを適用したデータベースと、生成したモデル・クエリに差異がないことを確認する
+// マイグレーションを変更したときに ggen generate で生成し直していなければ失敗する
+func TestCheck_Migrated(t *testing.T) {
+	ctx := context.Background()
+	client := testutils.SetupEmptyTestDB(t)
+
+	m, err := migration.New(client, migrations.FS, logger.New(logger.DefaultConfig()))
+	require.NoError(t, err)
+	require.NoError(t, m.Up(ctx))
+
+	conn := client.Conn(ctx)
+	report, err := schemacheck.Check(conn, schemacheck.Targets(query.Use(conn)))
+	require.NoError(t, err)
+
+	if len(report.Differences) > 0 {
+		var buf bytes.Buffer
+		require.NoError(t, report.Write(&buf))
+		t.Errorf("モデルとデータベースのスキーマが異なります。ggen generate で生成し直してください\n%s", buf.String())
+	}
+}
@@ -0,0 +1,21 @@
+package schemacheck
+
+import (
+	"g_gen/internal/domain/model"
+	"g_gen/internal/domain/query"
+)
+
+// Targets ggen generate で生成するすべてのモデルと、そのテーブルのクエリ
+func Targets(q *query.Query) []Target {
+	return []Target{
+		{Model: &model.Prefecture{}, Query: q.Prefecture},
+		{Model: &model.Municipality{}, Query: q.Municipality},
+		{Model: &model.MunicipalitySuccession{}, Query: q.MunicipalitySuccession},
+		{Model: &model.Region{}, Query: q.Region},
+		{Model: &model.WorkCategory{}, Query: q.WorkCategory},
+		{Model: &model.DamageReport{}, Query: q.DamageReport},
+		{Model: &model.SupportApplication{}, Query: q.SupportApplication},
+		{Model: &model.SupportApplicationTransition{}, Query: q.SupportApplicationTransition},
+		{Model: &model.DamageReportAttachment{}, Query: q.DamageReportAttachment},
+	}
+}
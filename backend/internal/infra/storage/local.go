@@ -0,0 +1,86 @@
+// Package storage 添付ファイルなどのファイル本体の保存先（domain.FileStorage）の実装
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	domain "g_gen/internal/domain/repository"
+)
+
+// Local ローカルのファイルシステムのディレクトリに保存する。開発環境や単一サーバーでの運用向け
+type Local struct {
+	dir string
+}
+
+var _ domain.FileStorage = (*Local)(nil)
+
+// NewLocal dirを保存先にする。dirがない場合は最初の保存時に作成する
+func NewLocal(dir string) *Local {
+	return &Local{dir: dir}
+}
+
+// Put 書き込み途中のファイルを読まれないよう、一時ファイルに書き込んでから置き換える
+func (l *Local) Put(_ context.Context, key string, body io.ReadSeeker, _ int64, _ string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (l *Local) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.Open(path)
+}
+
+func (l *Local) Delete(_ context.Context, key string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// path キーを保存先のディレクトリ内のパスにする。ディレクトリの外を指すキーはエラーにする
+func (l *Local) path(key string) (string, error) {
+	name := filepath.FromSlash(key)
+	if !filepath.IsLocal(name) {
+		return "", fmt.Errorf("invalid storage key: %q", key)
+	}
+
+	return filepath.Join(l.dir, name), nil
+}
@@ -0,0 +1,42 @@
+package storage_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/infra/storage"
+)
+
+func TestLocal(t *testing.T) {
+	dir := t.TempDir()
+	testFileStorage(t, storage.NewLocal(dir))
+
+	t.Run("Success/キーのディレクトリに保存し、一時ファイルを残さない", func(t *testing.T) {
+		entries, err := os.ReadDir(filepath.Join(dir, "damage-reports", "1"))
+		require.NoError(t, err)
+
+		names := make([]string, len(entries))
+		for i, e := range entries {
+			names[i] = e.Name()
+		}
+		assert.Equal(t, []string{"a.jpg", "b.pdf"}, names)
+	})
+
+	t.Run("failure/保存先のディレクトリの外を指すキー", func(t *testing.T) {
+		s := storage.NewLocal(dir)
+		ctx := context.Background()
+
+		for _, key := range []string{"../outside.jpg", "/etc/passwd", "damage-reports/../../outside.jpg"} {
+			assert.Error(t, s.Put(ctx, key, strings.NewReader("x"), 1, "image/jpeg"), key)
+
+			_, err := s.Get(ctx, key)
+			assert.Error(t, err, key)
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"context"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	domain "g_gen/internal/domain/repository"
+)
+
+// S3Config S3互換のストレージの接続設定
+type S3Config struct {
+	// Endpoint MinIOなどS3互換のストレージのURL。空の場合はAWSのS3に接続する
+	Endpoint string
+	Region   string
+	Bucket   string
+	// AccessKeyID・SecretAccessKey 静的なアクセスキー
+	AccessKeyID     string
+	SecretAccessKey string
+	// UsePathStyle バケット名をホスト名ではなくパスに含める（MinIOなど、仮想ホスト形式に対応しないストレージ向け）
+	UsePathStyle bool
+}
+
+// S3 S3互換のオブジェクトストレージのバケットに保存する
+type S3 struct {
+	client *s3.Client
+	bucket string
+}
+
+var _ domain.FileStorage = (*S3)(nil)
+
+// NewS3 設定のバケットを保存先にする。バケットは作成済みであること
+func NewS3(config S3Config) *S3 {
+	options := s3.Options{
+		Region:       config.Region,
+		Credentials:  credentials.NewStaticCredentialsProvider(config.AccessKeyID, config.SecretAccessKey, ""),
+		UsePathStyle: config.UsePathStyle,
+		// S3互換のストレージには追加のチェックサムに対応しないものがあるため、必須の操作でのみ付与・検証する
+		RequestChecksumCalculation: aws.RequestChecksumCalculationWhenRequired,
+		ResponseChecksumValidation: aws.ResponseChecksumValidationWhenRequired,
+	}
+	if config.Endpoint != "" {
+		options.BaseEndpoint = aws.String(config.Endpoint)
+	}
+
+	return &S3{
+		client: s3.New(options),
+		bucket: config.Bucket,
+	}
+}
+
+// Put シーク可能なボディを渡すことで、TLSのないエンドポイントでもペイロードの署名を計算できるようにする
+func (s *S3) Put(ctx context.Context, key string, body io.ReadSeeker, size int64, contentType string) error {
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(s.bucket),
+		Key:           aws.String(key),
+		Body:          body,
+		ContentLength: aws.Int64(size),
+		ContentType:   aws.String(contentType),
+	})
+
+	return err
+}
+
+func (s *S3) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Body, nil
+}
+
+// Delete S3・MinIOはキーがない場合も成功を返す
+func (s *S3) Delete(ctx context.Context, key string) error {
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+
+	return err
+}
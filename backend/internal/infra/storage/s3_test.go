@@ -0,0 +1,90 @@
+package storage_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"g_gen/internal/infra/storage"
+)
+
+// fakeS3 パス形式のPUT・GET・DELETEだけに応答する、MinIOの代わりのS3互換サーバー
+type fakeS3 struct {
+	bucket string
+
+	mu      sync.Mutex
+	objects map[string]fakeObject
+}
+
+type fakeObject struct {
+	body        []byte
+	contentType string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 ") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	key, ok := strings.CutPrefix(r.URL.Path, "/"+f.bucket+"/")
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		body, err := io.ReadAll(r.Body)
+		if err != nil || int64(len(body)) != r.ContentLength {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.objects[key] = fakeObject{body: body, contentType: r.Header.Get("Content-Type")}
+		w.Header().Set("ETag", `"etag"`)
+	case http.MethodGet:
+		object, ok := f.objects[key]
+		if !ok {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>not found</Message></Error>`)
+			return
+		}
+		w.Header().Set("Content-Type", object.contentType)
+		w.Write(object.body)
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestS3(t *testing.T) {
+	fake := &fakeS3{bucket: "attachments", objects: map[string]fakeObject{}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	testFileStorage(t, storage.NewS3(storage.S3Config{
+		Endpoint:        server.URL,
+		Region:          "ap-northeast-1",
+		Bucket:          "attachments",
+		AccessKeyID:     "minioadmin",
+		SecretAccessKey: "minioadmin",
+		UsePathStyle:    true,
+	}))
+
+	t.Run("Success/MIMEタイプを付けてバケットに保存する", func(t *testing.T) {
+		object, ok := fake.objects["damage-reports/1/a.jpg"]
+		assert.True(t, ok)
+		assert.Equal(t, "image/jpeg", object.contentType)
+	})
+}
@@ -0,0 +1,52 @@
+package storage_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domain "g_gen/internal/domain/repository"
+)
+
+// testFileStorage 保存先の実装によらず満たすべき振る舞いを検証する
+func testFileStorage(t *testing.T, s domain.FileStorage) {
+	ctx := context.Background()
+
+	get := func(t *testing.T, key string) string {
+		r, err := s.Get(ctx, key)
+		require.NoError(t, err)
+		defer r.Close()
+
+		body, err := io.ReadAll(r)
+		require.NoError(t, err)
+
+		return string(body)
+	}
+
+	t.Run("Success/保存したファイルを取得できる", func(t *testing.T) {
+		require.NoError(t, s.Put(ctx, "damage-reports/1/a.jpg", strings.NewReader("photo"), 5, "image/jpeg"))
+		assert.Equal(t, "photo", get(t, "damage-reports/1/a.jpg"))
+	})
+
+	t.Run("Success/同じキーは上書きする", func(t *testing.T) {
+		require.NoError(t, s.Put(ctx, "damage-reports/1/b.pdf", strings.NewReader("old"), 3, "application/pdf"))
+		require.NoError(t, s.Put(ctx, "damage-reports/1/b.pdf", strings.NewReader("new pdf"), 7, "application/pdf"))
+		assert.Equal(t, "new pdf", get(t, "damage-reports/1/b.pdf"))
+	})
+
+	t.Run("Success/削除したファイルは取得できない", func(t *testing.T) {
+		require.NoError(t, s.Put(ctx, "damage-reports/2/c.png", strings.NewReader("png"), 3, "image/png"))
+		require.NoError(t, s.Delete(ctx, "damage-reports/2/c.png"))
+
+		_, err := s.Get(ctx, "damage-reports/2/c.png")
+		assert.Error(t, err)
+	})
+
+	t.Run("Success/存在しないファイルの削除はエラーにしない", func(t *testing.T) {
+		assert.NoError(t, s.Delete(ctx, "damage-reports/3/missing.jpg"))
+	})
+}
@@ -0,0 +1,126 @@
+// Package kana はカナ表記の正規化と半角カナへの変換を提供する
+package kana
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	halfWidthKatakana = "ｦｧｨｩｪｫｬｭｮｯｰｱｲｳｴｵｶｷｸｹｺｻｼｽｾｿﾀﾁﾂﾃﾄﾅﾆﾇﾈﾉﾊﾋﾌﾍﾎﾏﾐﾑﾒﾓﾔﾕﾖﾗﾘﾙﾚﾛﾜﾝ･"
+	fullWidthKatakana = "ヲァィゥェォャュョッーアイウエオカキクケコサシスセソタチツテトナニヌネノハヒフヘホマミムメモヤユヨラリルレロワン・"
+
+	// 濁点・半濁点を付けられる全角カタカナと、付けた後の文字
+	voicedBase     = "ウカキクケコサシスセソタチツテトハヒフヘホ"
+	voicedKatakana = "ヴガギグゲゴザジズゼゾダヂヅデドバビブベボ"
+	semiVoicedBase = "ハヒフヘホ"
+	semiVoiced     = "パピプペポ"
+
+	halfWidthVoicedMark     = 'ﾞ'
+	halfWidthSemiVoicedMark = 'ﾟ'
+
+	hiraganaStart = 'ぁ'
+	hiraganaEnd   = 'ゖ'
+	// ひらがなとカタカナのコードポイントの差
+	hiraganaToKatakanaOffset = 'ァ' - 'ぁ'
+)
+
+var (
+	toFullWidth    = zipRunes(halfWidthKatakana, fullWidthKatakana)
+	toHalfWidth    = zipRunes(fullWidthKatakana, halfWidthKatakana)
+	toVoiced       = zipRunes(voicedBase, voicedKatakana)
+	toSemiVoiced   = zipRunes(semiVoicedBase, semiVoiced)
+	fromVoiced     = zipRunes(voicedKatakana, voicedBase)
+	fromSemiVoiced = zipRunes(semiVoiced, semiVoicedBase)
+	spaceRemover   = strings.NewReplacer("　", "", " ", "")
+)
+
+func zipRunes(from, to string) map[rune]rune {
+	f, t := []rune(from), []rune(to)
+	m := make(map[rune]rune, len(f))
+	for i := range f {
+		m[f[i]] = t[i]
+	}
+
+	return m
+}
+
+// Normalize 検索用に文字列を正規化する
+// 空白を除去し、半角カナを全角カナ（濁点・半濁点は合成済み）に、ひらがなをカタカナに変換する
+func Normalize(s string) string {
+	src := []rune(spaceRemover.Replace(s))
+	dst := make([]rune, 0, len(src))
+
+	for _, r := range src {
+		switch {
+		case r == halfWidthVoicedMark || r == halfWidthSemiVoicedMark:
+			if n := len(dst); n > 0 {
+				table := toVoiced
+				if r == halfWidthSemiVoicedMark {
+					table = toSemiVoiced
+				}
+
+				if composed, ok := table[dst[n-1]]; ok {
+					dst[n-1] = composed
+
+					continue
+				}
+			}
+
+			// 合成できない濁点・半濁点は全角の記号として残す
+			if r == halfWidthVoicedMark {
+				dst = append(dst, '゛')
+			} else {
+				dst = append(dst, '゜')
+			}
+		case r >= hiraganaStart && r <= hiraganaEnd:
+			dst = append(dst, r+hiraganaToKatakanaOffset)
+		default:
+			if full, ok := toFullWidth[r]; ok {
+				dst = append(dst, full)
+			} else {
+				dst = append(dst, r)
+			}
+		}
+	}
+
+	return string(dst)
+}
+
+// ToHalfWidth 全角カタカナを半角カナに変換する
+// 濁点・半濁点付きの文字は半角の濁点・半濁点を後置した2文字に分解する
+func ToHalfWidth(s string) string {
+	var b strings.Builder
+
+	for _, r := range s {
+		switch {
+		case fromVoiced[r] != 0:
+			b.WriteRune(toHalfWidth[fromVoiced[r]])
+			b.WriteRune(halfWidthVoicedMark)
+		case fromSemiVoiced[r] != 0:
+			b.WriteRune(toHalfWidth[fromSemiVoiced[r]])
+			b.WriteRune(halfWidthSemiVoicedMark)
+		case toHalfWidth[r] != 0:
+			b.WriteRune(toHalfWidth[r])
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// IsKatakana 文字列が全角カタカナ（長音記号・中点を含む）のみで構成されているか判定する
+func IsKatakana(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for _, r := range s {
+		if !unicode.Is(unicode.Katakana, r) && r != 'ー' && r != '・' {
+			return false
+		}
+	}
+
+	return true
+}
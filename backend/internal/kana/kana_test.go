@@ -0,0 +1,62 @@
+package kana_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"g_gen/internal/kana"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "ひらがなをカタカナに変換", input: "さっぽろし", want: "サッポロシ"},
+		{name: "半角カナを全角カナに変換", input: "ｻｯﾎﾟﾛｼ", want: "サッポロシ"},
+		{name: "半角カナの濁点を合成", input: "ﾁﾖﾀﾞｸ", want: "チヨダク"},
+		{name: "半角カナのヴを合成", input: "ｳﾞ", want: "ヴ"},
+		{name: "半角の長音記号", input: "ｾｰ", want: "セー"},
+		{name: "全角カナはそのまま", input: "ミナトク", want: "ミナトク"},
+		{name: "漢字はそのまま", input: "千代田区", want: "千代田区"},
+		{name: "漢字とひらがなの混在", input: "さいたま市", want: "サイタマ市"},
+		{name: "空白を除去", input: " 港　区 ", want: "港区"},
+		{name: "合成できない濁点は記号として残す", input: "ｱﾞ", want: "ア゛"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, kana.Normalize(tt.input))
+		})
+	}
+}
+
+func TestToHalfWidth(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "濁点・半濁点を分解", input: "サッポロシ", want: "ｻｯﾎﾟﾛｼ"},
+		{name: "濁点", input: "チヨダク", want: "ﾁﾖﾀﾞｸ"},
+		{name: "長音記号", input: "セー", want: "ｾｰ"},
+		{name: "カタカナ以外はそのまま", input: "港区", want: "港区"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, kana.ToHalfWidth(tt.input))
+			assert.Equal(t, kana.Normalize(tt.want), kana.Normalize(tt.input))
+		})
+	}
+}
+
+func TestIsKatakana(t *testing.T) {
+	assert.True(t, kana.IsKatakana("サッポロシ"))
+	assert.True(t, kana.IsKatakana("ヴォー"))
+	assert.False(t, kana.IsKatakana("サイタマ市"))
+	assert.False(t, kana.IsKatakana("さっぽろ"))
+	assert.False(t, kana.IsKatakana(""))
+}
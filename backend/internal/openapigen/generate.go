@@ -0,0 +1,554 @@
+package openapigen
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"go/format"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/jinzhu/inflection"
+)
+
+//go:embed templates/openapi.go.tmpl
+var fileTemplate string
+
+var tmpl = template.Must(template.New("openapi.go.tmpl").Parse(fileTemplate))
+
+// Options 生成するファイルの設定
+type Options struct {
+	// Package 出力先のパッケージ名
+	Package string
+	// Source 生成元のドキュメントのパス（ファイルの先頭のコメントに記載する）
+	Source string
+}
+
+// file 生成するファイル
+type file struct {
+	Source  string
+	Package string
+	// Imports 標準ライブラリのインポート
+	Imports []string
+	// ThirdPartyImports x-go-import で指定した標準ライブラリ以外のインポート
+	ThirdPartyImports []string
+	Structs           []*structDef
+	Handlers          []*handlerDef
+}
+
+type structDef struct {
+	Name   string
+	Doc    string
+	Fields []*fieldDef
+}
+
+type fieldDef struct {
+	Name string
+	Type string
+	Tag  string
+	Doc  string
+	// Import 型が参照するパッケージ（x-go-import）
+	Import string
+}
+
+// handlerDef タグごとのハンドラーのインターフェース
+type handlerDef struct {
+	Name string
+	// Register ルートを登録する関数名（RegisterPrefectureRoutes など）
+	Register   string
+	Tag        string
+	Doc        string
+	Operations []*operationDef
+}
+
+type operationDef struct {
+	Name    string
+	Method  string
+	Path    string
+	GinPath string
+	Summary string
+}
+
+var pathParamPattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+// generator 参照の解決とリクエストのスキーマの判定を行う
+type generator struct {
+	doc *Document
+	// requests リクエストボディから参照されるスキーマ。bindingタグとjaタグを付ける
+	requests map[string]bool
+}
+
+// Generate ドキュメントからリクエスト・レスポンスの型とハンドラーのインターフェースを生成し、gofmtで整形したソースを返す
+func Generate(doc *Document, opts Options) ([]byte, error) {
+	g := &generator{doc: doc, requests: make(map[string]bool)}
+
+	handlers, params, err := g.operations()
+	if err != nil {
+		return nil, err
+	}
+
+	f := &file{Source: opts.Source, Package: opts.Package, Handlers: handlers}
+	for _, e := range doc.Components.Schemas {
+		if e.Value.GoType != "" {
+			continue
+		}
+
+		s, err := g.schemaStruct(e.Name, e.Value)
+		if err != nil {
+			return nil, err
+		}
+		f.Structs = append(f.Structs, s)
+	}
+	f.Structs = append(f.Structs, params...)
+
+	names := make(map[string]bool)
+	for _, s := range f.Structs {
+		if names[s.Name] {
+			return nil, fmt.Errorf("型 %s が重複しています", s.Name)
+		}
+		names[s.Name] = true
+
+		for _, field := range s.Fields {
+			if strings.Contains(field.Type, "time.Time") {
+				f.addImport("time")
+			}
+			if field.Import != "" {
+				f.addImport(field.Import)
+			}
+		}
+	}
+	slices.Sort(f.Imports)
+	slices.Sort(f.ThirdPartyImports)
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, f); err != nil {
+		return nil, err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("生成したコードを整形できませんでした: %w", err)
+	}
+
+	return src, nil
+}
+
+// addImport インポートを追加する。先頭の要素にドットがなければ標準ライブラリとみなす
+func (f *file) addImport(path string) {
+	imports := &f.ThirdPartyImports
+	if first, _, _ := strings.Cut(path, "/"); !strings.Contains(first, ".") {
+		imports = &f.Imports
+	}
+
+	if !slices.Contains(*imports, path) {
+		*imports = append(*imports, path)
+	}
+}
+
+// operations タグごとのハンドラーと、操作のパス・クエリパラメータの構造体を求める
+// リクエストボディが参照するスキーマを g.requests に記録する
+func (g *generator) operations() ([]*handlerDef, []*structDef, error) {
+	var handlers []*handlerDef
+	byTag := make(map[string]*handlerDef)
+	for _, t := range g.doc.Tags {
+		h := newHandlerDef(t)
+		handlers = append(handlers, h)
+		byTag[t.Name] = h
+	}
+
+	var params []*structDef
+	ids := make(map[string]bool)
+	for _, p := range g.doc.Paths {
+		for _, o := range p.Value.operations() {
+			op := o.op
+			where := fmt.Sprintf("%s %s", o.method, p.Name)
+			if op.OperationID == "" {
+				return nil, nil, fmt.Errorf("%s: operationId がありません", where)
+			}
+			if ids[op.OperationID] {
+				return nil, nil, fmt.Errorf("%s: operationId %s が重複しています", where, op.OperationID)
+			}
+			ids[op.OperationID] = true
+			if len(op.Tags) != 1 {
+				return nil, nil, fmt.Errorf("%s: タグは1つである必要があります（%d個）", where, len(op.Tags))
+			}
+
+			h, ok := byTag[op.Tags[0]]
+			if !ok {
+				h = newHandlerDef(&Tag{Name: op.Tags[0]})
+				handlers = append(handlers, h)
+				byTag[h.Tag] = h
+			}
+			h.Operations = append(h.Operations, &operationDef{
+				Name:    op.OperationID,
+				Method:  o.method,
+				Path:    p.Name,
+				GinPath: pathParamPattern.ReplaceAllString(p.Name, ":$1"),
+				Summary: op.Summary,
+			})
+
+			structs, err := g.paramStructs(where, p.Name, op)
+			if err != nil {
+				return nil, nil, err
+			}
+			params = append(params, structs...)
+
+			if err := g.markRequestBody(where, op.RequestBody); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	return handlers, params, nil
+}
+
+// paramStructs 操作のパスパラメータ（uriタグ）とクエリパラメータ（formタグ）の構造体
+func (g *generator) paramStructs(where, path string, op *Operation) ([]*structDef, error) {
+	pathParams := &structDef{
+		Name: op.OperationID + "PathParams",
+		Doc:  fmt.Sprintf("%s のパスパラメータ", where),
+	}
+	queryParams := &structDef{
+		Name: op.OperationID + "QueryParams",
+		Doc:  fmt.Sprintf("%s のクエリパラメータ", where),
+	}
+
+	var names []string
+	for _, p := range op.Parameters {
+		p, err := g.parameter(p)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", where, err)
+		}
+		if p.Schema == nil {
+			return nil, fmt.Errorf("%s: パラメータ %s にスキーマがありません", where, p.Name)
+		}
+
+		var target *structDef
+		var key string
+		switch p.In {
+		case "path":
+			target, key = pathParams, "uri"
+			names = append(names, p.Name)
+		case "query":
+			target, key = queryParams, "form"
+		default:
+			// ヘッダーやCookieのパラメータはハンドラーで直接読み取る
+			continue
+		}
+
+		field, err := g.field(p.Name, p.GoName, p.Schema, p.Required, key, true)
+		if err != nil {
+			return nil, fmt.Errorf("%s: パラメータ %s: %w", where, p.Name, err)
+		}
+		field.Doc = p.Description
+		target.Fields = append(target.Fields, field)
+	}
+
+	for _, m := range pathParamPattern.FindAllStringSubmatch(path, -1) {
+		if !slices.Contains(names, m[1]) {
+			return nil, fmt.Errorf("%s: パスパラメータ %s の定義がありません", where, m[1])
+		}
+	}
+
+	var structs []*structDef
+	for _, s := range []*structDef{pathParams, queryParams} {
+		if len(s.Fields) > 0 {
+			structs = append(structs, s)
+		}
+	}
+
+	return structs, nil
+}
+
+func (g *generator) parameter(p *Parameter) (*Parameter, error) {
+	if p.Ref == "" {
+		return p, nil
+	}
+
+	name, ok := strings.CutPrefix(p.Ref, "#/components/parameters/")
+	if !ok {
+		return nil, fmt.Errorf("参照先に対応していません: %s", p.Ref)
+	}
+	resolved, ok := g.doc.Components.Parameters.get(name)
+	if !ok {
+		return nil, fmt.Errorf("参照先がありません: %s", p.Ref)
+	}
+
+	return resolved, nil
+}
+
+// markRequestBody JSONのリクエストボディが参照するスキーマを、プロパティの参照先まで含めて記録する
+// JSON以外（multipart/form-dataなど）のボディはハンドラーで直接読み取るため型を生成しない
+func (g *generator) markRequestBody(where string, body *RequestBody) error {
+	if body == nil {
+		return nil
+	}
+
+	media, ok := body.Content["application/json"]
+	if !ok || media.Schema == nil {
+		return nil
+	}
+	if media.Schema.Ref == "" {
+		return fmt.Errorf("%s: JSONのリクエストボディは components.schemas を参照してください", where)
+	}
+
+	return g.markRequest(media.Schema)
+}
+
+func (g *generator) markRequest(s *Schema) error {
+	if s.Items != nil {
+		return g.markRequest(s.Items)
+	}
+	if s.Ref == "" {
+		return nil
+	}
+
+	name, target, err := g.schema(s.Ref)
+	if err != nil {
+		return err
+	}
+	if g.requests[name] || target.GoType != "" {
+		return nil
+	}
+	g.requests[name] = true
+
+	for _, p := range target.Properties {
+		if err := g.markRequest(p.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *generator) schema(ref string) (string, *Schema, error) {
+	name, ok := strings.CutPrefix(ref, "#/components/schemas/")
+	if !ok {
+		return "", nil, fmt.Errorf("参照先に対応していません: %s", ref)
+	}
+	s, ok := g.doc.Components.Schemas.get(name)
+	if !ok {
+		return "", nil, fmt.Errorf("参照先がありません: %s", ref)
+	}
+
+	return name, s, nil
+}
+
+// schemaStruct components.schemas のオブジェクトの構造体
+func (g *generator) schemaStruct(name string, s *Schema) (*structDef, error) {
+	if s.Type != "object" {
+		return nil, fmt.Errorf("スキーマ %s: オブジェクト以外は x-go-type で既存の型を指定してください", name)
+	}
+
+	def := &structDef{Name: name, Doc: s.Description}
+	for _, p := range s.Properties {
+		field, err := g.field(p.Name, p.Value.GoName, p.Value, slices.Contains(s.Required, p.Name), "json", g.requests[name])
+		if err != nil {
+			return nil, fmt.Errorf("スキーマ %s のプロパティ %s: %w", name, p.Name, err)
+		}
+		def.Fields = append(def.Fields, field)
+	}
+
+	return def, nil
+}
+
+// field プロパティ・パラメータのフィールド
+// リクエストの場合はbindingタグとjaタグを付け、必須の数値・真偽値はゼロ値と未指定を区別するためポインタにする
+// レスポンスの場合は必須でないプロパティに omitempty を付ける
+func (g *generator) field(name, goName string, s *Schema, required bool, key string, request bool) (*fieldDef, error) {
+	typ, err := g.goType(s)
+	if err != nil {
+		return nil, err
+	}
+
+	pointer := s.Nullable
+	if request && required && key == "json" {
+		pointer = pointer || s.Type == "integer" || s.Type == "number" || s.Type == "boolean"
+	}
+	if pointer && !strings.HasPrefix(typ, "*") && !strings.HasPrefix(typ, "[]") {
+		typ = "*" + typ
+	}
+
+	tag := fmt.Sprintf(`%s:"%s"`, key, name)
+	if !request && !required {
+		tag = fmt.Sprintf(`%s:"%s,omitempty"`, key, name)
+	}
+	if request {
+		if binding := bindingTag(s, required); binding != "" {
+			tag += fmt.Sprintf(` binding:"%s"`, binding)
+		}
+		if s.Title != "" {
+			tag += fmt.Sprintf(` ja:"%s"`, s.Title)
+		}
+	}
+
+	if goName == "" {
+		goName = GoName(name)
+	}
+
+	return &fieldDef{Name: goName, Type: typ, Tag: tag, Doc: s.Description, Import: s.GoImport}, nil
+}
+
+// goType スキーマのGoの型。オブジェクトは components.schemas の参照のみ対応する
+func (g *generator) goType(s *Schema) (string, error) {
+	if s.Ref != "" {
+		name, target, err := g.schema(s.Ref)
+		if err != nil {
+			return "", err
+		}
+		if target.GoType != "" {
+			return target.GoType, nil
+		}
+
+		return "*" + name, nil
+	}
+	if s.GoType != "" {
+		return s.GoType, nil
+	}
+
+	switch s.Type {
+	case "integer":
+		switch s.Format {
+		case "int32":
+			return "int32", nil
+		case "int64":
+			return "int64", nil
+		default:
+			return "int", nil
+		}
+	case "number":
+		if s.Format == "float" {
+			return "float32", nil
+		}
+
+		return "float64", nil
+	case "string":
+		if s.Format == "date-time" {
+			return "time.Time", nil
+		}
+
+		return "string", nil
+	case "boolean":
+		return "bool", nil
+	case "array":
+		if s.Items == nil {
+			return "", fmt.Errorf("配列に items がありません")
+		}
+		item, err := g.goType(s.Items)
+		if err != nil {
+			return "", err
+		}
+
+		return "[]" + item, nil
+	case "object":
+		return "", fmt.Errorf("インラインのオブジェクトには対応していません。components.schemas に定義して参照してください")
+	default:
+		return "", fmt.Errorf("型 %q に対応していません", s.Type)
+	}
+}
+
+// bindingTag go-playground/validator のbindingタグ
+// 必須の場合は required、必須でない場合は制約があるときに omitempty を先頭に付ける
+func bindingTag(s *Schema, required bool) string {
+	rules := constraints(s)
+	switch {
+	case required:
+		rules = append([]string{"required"}, rules...)
+	case len(rules) > 0:
+		rules = append([]string{"omitempty"}, rules...)
+	}
+
+	return strings.Join(rules, ",")
+}
+
+// constraints スキーマの制約をバリデーションのルールにする。配列の要素の制約は dive の後に続ける
+func constraints(s *Schema) []string {
+	var rules []string
+	bound := func(rule string, v *float64) {
+		if v != nil {
+			rules = append(rules, rule+"="+strconv.FormatFloat(*v, 'f', -1, 64))
+		}
+	}
+	length := func(rule string, v *int) {
+		if v != nil {
+			rules = append(rules, rule+"="+strconv.Itoa(*v))
+		}
+	}
+
+	switch s.Type {
+	case "integer", "number":
+		bound("min", s.Minimum)
+		bound("max", s.Maximum)
+	case "string":
+		length("min", s.MinLength)
+		length("max", s.MaxLength)
+	case "array":
+		length("min", s.MinItems)
+		length("max", s.MaxItems)
+	}
+	if len(s.Enum) > 0 {
+		rules = append(rules, "oneof="+strings.Join(s.Enum, " "))
+	}
+	if s.UniqueItems {
+		rules = append(rules, "unique")
+	}
+	if s.GoBinding != "" {
+		rules = append(rules, s.GoBinding)
+	}
+
+	if s.Items != nil {
+		// 参照先のオブジェクトは要素ごとに検証する
+		if item := constraints(s.Items); len(item) > 0 || s.Items.Ref != "" {
+			rules = append(append(rules, "dive"), item...)
+		}
+	}
+
+	return rules
+}
+
+// newHandlerDef タグのハンドラー
+func newHandlerDef(t *Tag) *handlerDef {
+	name := t.GoName
+	if name == "" {
+		name = HandlerName(t.Name)
+	}
+
+	return &handlerDef{
+		Name:     name,
+		Register: "Register" + strings.TrimSuffix(name, "Handler") + "Routes",
+		Tag:      t.Name,
+		Doc:      t.Description,
+	}
+}
+
+// initialisms すべて大文字にする単語
+var initialisms = []string{"API", "HTTP", "ID", "IP", "JSON", "URI", "URL", "UUID"}
+
+// HandlerName x-go-name を指定しない場合のタグのインターフェース名。タグ名の単数形にする（work-categories は WorkCategoryHandler）
+func HandlerName(tag string) string {
+	return GoName(inflection.Singular(tag)) + "Handler"
+}
+
+// GoName スネークケース・ケバブケースの名前をGoの名前にする（region_id は RegionID、ids は IDs）
+// x-go-name を指定しない場合のフィールド名
+func GoName(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool { return r == '_' || r == '-' || r == ' ' })
+
+	var b strings.Builder
+	for _, w := range words {
+		upper := strings.ToUpper(w)
+		switch {
+		case slices.Contains(initialisms, upper):
+			b.WriteString(upper)
+		case strings.HasSuffix(upper, "S") && slices.Contains(initialisms, upper[:len(upper)-1]):
+			b.WriteString(upper[:len(upper)-1] + "s")
+		default:
+			b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+		}
+	}
+
+	return b.String()
+}
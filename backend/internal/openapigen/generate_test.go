@@ -0,0 +1,272 @@
+package openapigen_test
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/openapigen"
+)
+
+const siteSpec = `openapi: 3.0.3
+tags:
+  - name: inspection-sites
+    description: 点検箇所
+paths:
+  /inspection-sites:
+    get:
+      operationId: ListInspectionSites
+      tags: [inspection-sites]
+      summary: 点検箇所一覧取得
+      parameters:
+        - name: status
+          in: query
+          schema:
+            type: string
+            enum: [open, closed]
+            title: 状態
+        - name: X-Request-ID
+          in: header
+          schema:
+            type: string
+    post:
+      operationId: CreateInspectionSite
+      tags: [inspection-sites]
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: "#/components/schemas/CreateInspectionSiteRequest"
+  /inspection-sites/{site_id}:
+    delete:
+      operationId: DeleteInspectionSite
+      tags: [inspection-sites]
+      parameters:
+        - $ref: "#/components/parameters/SiteID"
+components:
+  parameters:
+    SiteID:
+      name: site_id
+      in: path
+      required: true
+      schema:
+        type: integer
+        format: int64
+        minimum: 1
+        title: 点検箇所ID
+  schemas:
+    InspectionSiteResponse:
+      type: object
+      description: 点検箇所
+      required: [id, inspected_at]
+      properties:
+        id:
+          type: integer
+          format: int64
+        inspected_at:
+          type: string
+          format: date-time
+        memo:
+          type: string
+          nullable: true
+        extra:
+          x-go-type: datatypes.JSON
+          x-go-import: gorm.io/datatypes
+        photos:
+          type: array
+          items:
+            $ref: "#/components/schemas/Photo"
+    CreateInspectionSiteRequest:
+      type: object
+      required: [site_name, priority, active, photos]
+      properties:
+        site_name:
+          type: string
+          minLength: 1
+          maxLength: 50
+          title: 点検箇所名
+        priority:
+          type: integer
+          minimum: 0
+          maximum: 10
+          title: 優先度
+        active:
+          type: boolean
+        score:
+          type: number
+          format: float
+          x-go-name: Rate
+        organization_code:
+          type: string
+          x-go-binding: len=6,numeric
+        urls:
+          type: array
+          maxItems: 3
+          items:
+            type: string
+            maxLength: 200
+        photos:
+          type: array
+          items:
+            $ref: "#/components/schemas/Photo"
+    Photo:
+      type: object
+      required: [file_name]
+      properties:
+        file_name:
+          type: string
+          maxLength: 100
+    ErrorResponse:
+      type: object
+      x-go-type: ErrorResponse
+`
+
+func TestGenerate(t *testing.T) {
+	doc, err := openapigen.Load(writeSpec(t, siteSpec))
+	require.NoError(t, err)
+
+	got, err := openapigen.Generate(doc, openapigen.Options{Package: "handler", Source: "api/openapi.yaml"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		pattern string
+	}{
+		{name: "Success/ヘッダー", pattern: `^// Code generated by ggen openapi from api/openapi.yaml. DO NOT EDIT.\n\npackage handler\n`},
+		{name: "Success/日時の型と指定したパッケージのインポート", pattern: `import \(\n\t"time"\n\n\t"github.com/gin-gonic/gin"\n\t"gorm.io/datatypes"\n\)`},
+		{name: "Success/スキーマの説明", pattern: `// InspectionSiteResponse 点検箇所\ntype InspectionSiteResponse struct`},
+		{name: "Success/レスポンスは必須のプロパティ以外にomitempty", pattern: `ID +int64 +` + "`" + `json:"id"` + "`"},
+		{name: "Success/日時", pattern: `InspectedAt +time.Time +` + "`" + `json:"inspected_at"` + "`"},
+		{name: "Success/nullableはポインタ", pattern: `Memo +\*string +` + "`" + `json:"memo,omitempty"` + "`"},
+		{name: "Success/プロパティに既存の型を指定", pattern: `Extra +datatypes.JSON +` + "`" + `json:"extra,omitempty"` + "`"},
+		{name: "Success/参照の配列", pattern: `Photos +\[\]\*Photo +` + "`" + `json:"photos,omitempty"` + "`"},
+		{name: "Success/文字列の長さ", pattern: `SiteName +string +` + "`" + `json:"site_name" binding:"required,min=1,max=50" ja:"点検箇所名"` + "`"},
+		{name: "Success/必須の数値はポインタ", pattern: `Priority +\*int +` + "`" + `json:"priority" binding:"required,min=0,max=10" ja:"優先度"` + "`"},
+		{name: "Success/必須の真偽値はポインタ", pattern: `Active +\*bool +` + "`" + `json:"active" binding:"required"` + "`"},
+		{name: "Success/制約のない任意の項目", pattern: `Rate +float32 +` + "`" + `json:"score"` + "`"},
+		{name: "Success/追加のバリデーション", pattern: `OrganizationCode +string +` + "`" + `json:"organization_code" binding:"omitempty,len=6,numeric"` + "`"},
+		{name: "Success/要素の制約", pattern: `URLs +\[\]string +` + "`" + `json:"urls" binding:"omitempty,max=3,dive,max=200"` + "`"},
+		{name: "Success/参照の要素を検証", pattern: `Photos +\[\]\*Photo +` + "`" + `json:"photos" binding:"required,dive"` + "`"},
+		{name: "Success/リクエストの参照先", pattern: `FileName +string +` + "`" + `json:"file_name" binding:"required,max=100"` + "`"},
+		{name: "Success/クエリパラメータ", pattern: `// ListInspectionSitesQueryParams GET /inspection-sites のクエリパラメータ\ntype ListInspectionSitesQueryParams struct \{\n\tStatus string ` + "`" + `form:"status" binding:"omitempty,oneof=open closed" ja:"状態"` + "`\n}"},
+		{name: "Success/参照したパスパラメータ", pattern: `SiteID int64 ` + "`" + `uri:"site_id" binding:"required,min=1" ja:"点検箇所ID"` + "`"},
+		{name: "Success/ハンドラーのインターフェース", pattern: `// InspectionSiteHandler 点検箇所のAPI（inspection-sites タグ）\ntype InspectionSiteHandler interface \{\n\t// ListInspectionSites GET /inspection-sites 点検箇所一覧取得\n\tListInspectionSites\(c \*gin.Context\)\n\t// CreateInspectionSite POST /inspection-sites\n`},
+		{name: "Success/ルートの登録", pattern: `func RegisterInspectionSiteRoutes\(r gin.IRoutes, h InspectionSiteHandler\) \{\n\tr.GET\("/inspection-sites", h.ListInspectionSites\)\n\tr.POST\("/inspection-sites", h.CreateInspectionSite\)\n\tr.DELETE\("/inspection-sites/:site_id", h.DeleteInspectionSite\)\n\}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Regexp(t, regexp.MustCompile(tt.pattern), string(got))
+		})
+	}
+
+	t.Run("Success/既存の型を指定したスキーマとヘッダーのパラメータは生成しない", func(t *testing.T) {
+		assert.NotContains(t, string(got), "type ErrorResponse")
+		assert.NotContains(t, string(got), "RequestID")
+	})
+}
+
+func TestGenerate_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr string
+	}{
+		{
+			name:    "failure/operationIdがない",
+			spec:    "openapi: 3.0.3\npaths:\n  /sites:\n    get:\n      tags: [sites]\n",
+			wantErr: "GET /sites: operationId がありません",
+		},
+		{
+			name: "failure/operationIdが重複",
+			spec: "openapi: 3.0.3\npaths:\n  /sites:\n    get: {operationId: ListSites, tags: [sites]}\n" +
+				"    post: {operationId: ListSites, tags: [sites]}\n",
+			wantErr: "POST /sites: operationId ListSites が重複しています",
+		},
+		{
+			name:    "failure/タグが複数",
+			spec:    "openapi: 3.0.3\npaths:\n  /sites:\n    get: {operationId: ListSites, tags: [sites, admin]}\n",
+			wantErr: "GET /sites: タグは1つである必要があります（2個）",
+		},
+		{
+			name:    "failure/パスパラメータの定義がない",
+			spec:    "openapi: 3.0.3\npaths:\n  /sites/{id}:\n    get: {operationId: GetSite, tags: [sites]}\n",
+			wantErr: "GET /sites/{id}: パスパラメータ id の定義がありません",
+		},
+		{
+			name: "failure/パラメータの参照先がない",
+			spec: "openapi: 3.0.3\npaths:\n  /sites/{id}:\n    get:\n      operationId: GetSite\n      tags: [sites]\n" +
+				"      parameters:\n        - $ref: \"#/components/parameters/SiteID\"\n",
+			wantErr: "GET /sites/{id}: 参照先がありません: #/components/parameters/SiteID",
+		},
+		{
+			name: "failure/インラインのリクエストボディ",
+			spec: "openapi: 3.0.3\npaths:\n  /sites:\n    post:\n      operationId: CreateSite\n      tags: [sites]\n" +
+				"      requestBody:\n        content:\n          application/json:\n            schema: {type: object}\n",
+			wantErr: "POST /sites: JSONのリクエストボディは components.schemas を参照してください",
+		},
+		{
+			name: "failure/インラインのオブジェクト",
+			spec: "openapi: 3.0.3\ncomponents:\n  schemas:\n    Site:\n      type: object\n" +
+				"      properties:\n        location: {type: object}\n",
+			wantErr: "スキーマ Site のプロパティ location: インラインのオブジェクトには対応していません",
+		},
+		{
+			name:    "failure/オブジェクト以外のスキーマ",
+			spec:    "openapi: 3.0.3\ncomponents:\n  schemas:\n    Status:\n      type: string\n",
+			wantErr: "スキーマ Status: オブジェクト以外は x-go-type で既存の型を指定してください",
+		},
+		{
+			name: "failure/型名の重複",
+			spec: "openapi: 3.0.3\npaths:\n  /sites:\n    get:\n      operationId: ListSites\n      tags: [sites]\n" +
+				"      parameters:\n        - {name: q, in: query, schema: {type: string}}\n" +
+				"components:\n  schemas:\n    ListSitesQueryParams:\n      type: object\n",
+			wantErr: "型 ListSitesQueryParams が重複しています",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := openapigen.Load(writeSpec(t, tt.spec))
+			require.NoError(t, err)
+
+			_, err = openapigen.Generate(doc, openapigen.Options{Package: "handler", Source: "openapi.yaml"})
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+// TestGenerate_Handler 生成済みのハンドラーの型が api/openapi.yaml と一致することを確認する
+// 一致しない場合は ggen openapi で生成し直す
+func TestGenerate_Handler(t *testing.T) {
+	doc, err := openapigen.Load("../../api/openapi.yaml")
+	require.NoError(t, err)
+
+	got, err := openapigen.Generate(doc, openapigen.Options{Package: "handler", Source: "api/openapi.yaml"})
+	require.NoError(t, err)
+
+	want, err := os.ReadFile("../handler/openapi.gen.go")
+	require.NoError(t, err)
+	assert.Equal(t, string(want), string(got), "internal/handler/openapi.gen.go が api/openapi.yaml と一致しません。ggen openapi で生成し直してください")
+}
+
+func TestHandlerName(t *testing.T) {
+	tests := []struct {
+		name string
+		tag  string
+		want string
+	}{
+		{name: "Success/複数形のタグ", tag: "work-categories", want: "WorkCategoryHandler"},
+		{name: "Success/頭字語", tag: "url-mappings", want: "URLMappingHandler"},
+		{name: "Success/単数形のタグ", tag: "admin", want: "AdminHandler"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, openapigen.HandlerName(tt.tag))
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package openapigen
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Document 生成に使うOpenAPI 3のドキュメントの項目
+type Document struct {
+	OpenAPI    string             `yaml:"openapi"`
+	Tags       []*Tag             `yaml:"tags"`
+	Paths      ordered[*PathItem] `yaml:"paths"`
+	Components Components         `yaml:"components"`
+}
+
+// Tag 操作のタグ。タグごとにハンドラーのインターフェースを生成する
+type Tag struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	// GoName インターフェース名（既定はタグ名の単数形＋Handler）
+	GoName string `yaml:"x-go-name"`
+}
+
+type Components struct {
+	Schemas    ordered[*Schema]    `yaml:"schemas"`
+	Parameters ordered[*Parameter] `yaml:"parameters"`
+}
+
+// PathItem パスの操作
+type PathItem struct {
+	Get    *Operation `yaml:"get"`
+	Post   *Operation `yaml:"post"`
+	Put    *Operation `yaml:"put"`
+	Patch  *Operation `yaml:"patch"`
+	Delete *Operation `yaml:"delete"`
+}
+
+// operations HTTPメソッドと操作（ルートの登録順）
+func (p *PathItem) operations() []struct {
+	method string
+	op     *Operation
+} {
+	all := []struct {
+		method string
+		op     *Operation
+	}{
+		{method: "GET", op: p.Get},
+		{method: "POST", op: p.Post},
+		{method: "PUT", op: p.Put},
+		{method: "PATCH", op: p.Patch},
+		{method: "DELETE", op: p.Delete},
+	}
+
+	ops := all[:0]
+	for _, o := range all {
+		if o.op != nil {
+			ops = append(ops, o)
+		}
+	}
+
+	return ops
+}
+
+type Operation struct {
+	OperationID string       `yaml:"operationId"`
+	Tags        []string     `yaml:"tags"`
+	Summary     string       `yaml:"summary"`
+	Parameters  []*Parameter `yaml:"parameters"`
+	RequestBody *RequestBody `yaml:"requestBody"`
+}
+
+type Parameter struct {
+	Ref         string  `yaml:"$ref"`
+	Name        string  `yaml:"name"`
+	In          string  `yaml:"in"`
+	Required    bool    `yaml:"required"`
+	Description string  `yaml:"description"`
+	Schema      *Schema `yaml:"schema"`
+	// GoName フィールド名（既定はパラメータ名をキャメルケースにしたもの）
+	GoName string `yaml:"x-go-name"`
+}
+
+type RequestBody struct {
+	Required bool                  `yaml:"required"`
+	Content  map[string]*MediaType `yaml:"content"`
+}
+
+type MediaType struct {
+	Schema *Schema `yaml:"schema"`
+}
+
+// Schema スキーマ。生成に使わないキーワード（oneOf、exampleなど）は読み飛ばす
+type Schema struct {
+	Ref         string           `yaml:"$ref"`
+	Type        string           `yaml:"type"`
+	Format      string           `yaml:"format"`
+	Title       string           `yaml:"title"`
+	Description string           `yaml:"description"`
+	Nullable    bool             `yaml:"nullable"`
+	Enum        []string         `yaml:"enum"`
+	Minimum     *float64         `yaml:"minimum"`
+	Maximum     *float64         `yaml:"maximum"`
+	MinLength   *int             `yaml:"minLength"`
+	MaxLength   *int             `yaml:"maxLength"`
+	MinItems    *int             `yaml:"minItems"`
+	MaxItems    *int             `yaml:"maxItems"`
+	UniqueItems bool             `yaml:"uniqueItems"`
+	Required    []string         `yaml:"required"`
+	Properties  ordered[*Schema] `yaml:"properties"`
+	Items       *Schema          `yaml:"items"`
+	// GoType 既存のGoの型を使い、構造体を生成しない。プロパティの場合はフィールドの型にする
+	GoType string `yaml:"x-go-type"`
+	// GoImport プロパティの x-go-type の型が参照するパッケージのインポートパス
+	GoImport string `yaml:"x-go-import"`
+	// GoName フィールド名（既定はプロパティ名をキャメルケースにしたもの）
+	GoName string `yaml:"x-go-name"`
+	// GoBinding bindingタグに追加するバリデーション
+	GoBinding string `yaml:"x-go-binding"`
+}
+
+// entry 順序を保つマップの要素
+type entry[T any] struct {
+	Name  string
+	Value T
+}
+
+// ordered 記述順を保つマップ。構造体のフィールドやルートを仕様の順に生成する
+type ordered[T any] []entry[T]
+
+func (o *ordered[T]) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("%d行目: マップである必要があります", node.Line)
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		var value T
+		if err := node.Content[i+1].Decode(&value); err != nil {
+			return err
+		}
+		*o = append(*o, entry[T]{Name: node.Content[i].Value, Value: value})
+	}
+
+	return nil
+}
+
+func (o ordered[T]) get(name string) (T, bool) {
+	for _, e := range o {
+		if e.Name == name {
+			return e.Value, true
+		}
+	}
+
+	var zero T
+
+	return zero, false
+}
+
+// Load OpenAPIのドキュメントを読み込む
+func Load(path string) (*Document, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := Parse(src)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return doc, nil
+}
+
+// Parse OpenAPIのドキュメントを解析する
+func Parse(src []byte) (*Document, error) {
+	var doc Document
+	if err := yaml.Unmarshal(src, &doc); err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(doc.OpenAPI, "3.") {
+		return nil, fmt.Errorf("OpenAPI 3 のドキュメントではありません: openapi=%q", doc.OpenAPI)
+	}
+
+	return &doc, nil
+}
@@ -0,0 +1,73 @@
+package openapigen_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/openapigen"
+)
+
+// writeSpec ドキュメントを一時ディレクトリに書き込み、そのパスを返す
+func writeSpec(t *testing.T, spec string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "openapi.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(spec), 0o600))
+
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr string
+	}{
+		{
+			name: "Success/プロパティを記述順に読み込む",
+			spec: "openapi: 3.0.3\ncomponents:\n  schemas:\n    Site:\n      type: object\n" +
+				"      properties:\n        name: {type: string}\n        id: {type: integer}\n",
+		},
+		{
+			name:    "failure/OpenAPI 3 ではない",
+			spec:    "swagger: \"2.0\"\n",
+			wantErr: `OpenAPI 3 のドキュメントではありません: openapi=""`,
+		},
+		{
+			name:    "failure/プロパティがマップではない",
+			spec:    "openapi: 3.0.3\ncomponents:\n  schemas:\n    Site:\n      properties: [name]\n",
+			wantErr: "5行目: マップである必要があります",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := openapigen.Load(writeSpec(t, tt.spec))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			site := doc.Components.Schemas[0]
+			assert.Equal(t, "Site", site.Name)
+			assert.Equal(t, "name", site.Value.Properties[0].Name)
+			assert.Equal(t, "id", site.Value.Properties[1].Name)
+		})
+	}
+
+	t.Run("failure/ファイルがない", func(t *testing.T) {
+		_, err := openapigen.Load(filepath.Join(t.TempDir(), "openapi.yaml"))
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("failure/エラーにファイルのパスを含める", func(t *testing.T) {
+		path := writeSpec(t, "swagger: \"2.0\"\n")
+		_, err := openapigen.Load(path)
+		assert.EqualError(t, err, path+`: OpenAPI 3 のドキュメントではありません: openapi=""`)
+	})
+}
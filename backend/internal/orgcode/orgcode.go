@@ -0,0 +1,67 @@
+// Package orgcode 総務省の全国地方公共団体コード（団体コード）を扱う
+//
+// 団体コードは都道府県コード2桁、市区町村コード3桁、チェックディジット1桁の6桁で構成される。
+// チェックディジットは上5桁に6,5,4,3,2の重みを掛けた和を11で割った余りを11から引いた値の下1桁である。
+package orgcode
+
+import "errors"
+
+// Length 団体コードの桁数
+const Length = 6
+
+var (
+	// ErrInvalidFormat 団体コードが6桁の数字でない
+	ErrInvalidFormat = errors.New("団体コードは6桁の数字である必要があります")
+	// ErrInvalidCheckDigit チェックディジットが一致しない
+	ErrInvalidCheckDigit = errors.New("団体コードのチェックディジットが正しくありません")
+	// ErrPrefectureMismatch 団体コードの上2桁が都道府県コードと一致しない
+	ErrPrefectureMismatch = errors.New("団体コードの上2桁が都道府県コードと一致しません")
+)
+
+var weights = [Length - 1]int{6, 5, 4, 3, 2}
+
+// Validate 団体コードの形式とチェックディジットを検証する
+func Validate(code string) error {
+	if len(code) != Length {
+		return ErrInvalidFormat
+	}
+
+	sum := 0
+	for i := 0; i < Length; i++ {
+		if code[i] < '0' || code[i] > '9' {
+			return ErrInvalidFormat
+		}
+
+		if i < len(weights) {
+			sum += int(code[i]-'0') * weights[i]
+		}
+	}
+
+	if int(code[Length-1]-'0') != (11-sum%11)%10 {
+		return ErrInvalidCheckDigit
+	}
+
+	return nil
+}
+
+// ValidateWithPrefecture 団体コードを検証し、上2桁が都道府県コードと一致するかを確認する
+func ValidateWithPrefecture(code, prefectureCode string) error {
+	if err := Validate(code); err != nil {
+		return err
+	}
+
+	if PrefectureCode(code) != prefectureCode {
+		return ErrPrefectureMismatch
+	}
+
+	return nil
+}
+
+// PrefectureCode 団体コードの上2桁（都道府県コード）を返す
+func PrefectureCode(code string) string {
+	if len(code) < 2 {
+		return ""
+	}
+
+	return code[:2]
+}
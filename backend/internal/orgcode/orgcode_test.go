@@ -0,0 +1,53 @@
+package orgcode_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"g_gen/internal/orgcode"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    string
+		wantErr error
+	}{
+		{name: "Success/都道府県", code: "010006"},
+		{name: "Success/札幌市", code: "011002"},
+		{name: "Success/千代田区", code: "131016"},
+		{name: "Success/余りが0の場合は1", code: "999997"},
+		{name: "Success/余りが1の場合は0", code: "011100"},
+		{name: "failure/チェックディジット不一致", code: "011001", wantErr: orgcode.ErrInvalidCheckDigit},
+		{name: "failure/5桁", code: "01100", wantErr: orgcode.ErrInvalidFormat},
+		{name: "failure/数字以外を含む", code: "01100a", wantErr: orgcode.ErrInvalidFormat},
+		{name: "failure/全角数字", code: "０１１００２", wantErr: orgcode.ErrInvalidFormat},
+		{name: "failure/空", code: "", wantErr: orgcode.ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, orgcode.Validate(tt.code), tt.wantErr)
+		})
+	}
+}
+
+func TestValidateWithPrefecture(t *testing.T) {
+	tests := []struct {
+		name           string
+		code           string
+		prefectureCode string
+		wantErr        error
+	}{
+		{name: "Success", code: "131016", prefectureCode: "13"},
+		{name: "failure/都道府県コード不一致", code: "131016", prefectureCode: "01", wantErr: orgcode.ErrPrefectureMismatch},
+		{name: "failure/チェックディジットを優先", code: "131011", prefectureCode: "01", wantErr: orgcode.ErrInvalidCheckDigit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, orgcode.ValidateWithPrefecture(tt.code, tt.prefectureCode), tt.wantErr)
+		})
+	}
+}
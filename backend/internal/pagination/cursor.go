@@ -0,0 +1,72 @@
+package pagination
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// ErrInvalidCursor カーソルの書式が正しくない、または署名が一致しない
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor 直前に取得したページの最後の行のソートキー
+// 次のページはこのソートキーより後ろの行から取得する
+type Cursor struct {
+	// Sort カーソルを発行したときのソート条件（FormatSort形式）
+	Sort string `json:"s"`
+	// Keys ソート条件の各フィールドの値（ソート条件と同じ順）
+	Keys []string `json:"k"`
+}
+
+// CursorCodec カーソルを署名付きの不透明な文字列に変換する
+type CursorCodec struct {
+	secret []byte
+}
+
+// NewCursorCodec 署名に使う鍵を指定してCursorCodecを生成する
+func NewCursorCodec(secret []byte) *CursorCodec {
+	return &CursorCodec{secret: secret}
+}
+
+// Encode カーソルを `本文.署名` 形式の文字列に変換する（いずれもURLセーフなBase64）
+func (c *CursorCodec) Encode(cursor *Cursor) string {
+	payload, _ := json.Marshal(cursor)
+	body := base64.RawURLEncoding.EncodeToString(payload)
+
+	return body + "." + base64.RawURLEncoding.EncodeToString(c.sign(body))
+}
+
+// Decode Encodeで生成した文字列からカーソルを復元する。改ざんされている場合はErrInvalidCursorを返す
+func (c *CursorCodec) Decode(raw string) (*Cursor, error) {
+	body, sig, ok := strings.Cut(raw, ".")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+
+	gotSig, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(gotSig, c.sign(body)) {
+		return nil, ErrInvalidCursor
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(body)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(payload, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
+
+func (c *CursorCodec) sign(body string) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write([]byte(body))
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,46 @@
+package pagination_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/pagination"
+)
+
+func TestCursorCodec(t *testing.T) {
+	codec := pagination.NewCursorCodec([]byte("secret"))
+	cursor := &pagination.Cursor{Sort: "-prefecture_code,organization_code", Keys: []string{"13", "131016"}}
+	encoded := codec.Encode(cursor)
+
+	t.Run("Success/復元できる", func(t *testing.T) {
+		got, err := codec.Decode(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, cursor, got)
+	})
+
+	body, sig, _ := strings.Cut(encoded, ".")
+	forged := pagination.NewCursorCodec([]byte("secret")).Encode(&pagination.Cursor{Sort: cursor.Sort, Keys: []string{"47", "473821"}})
+	forgedBody, _, _ := strings.Cut(forged, ".")
+
+	tests := []struct {
+		name string
+		raw  string
+	}{
+		{name: "failure/空文字", raw: ""},
+		{name: "failure/署名なし", raw: body},
+		{name: "failure/本文の改ざん", raw: forgedBody + "." + sig},
+		{name: "failure/署名の改ざん", raw: body + "." + sig[:len(sig)-2] + "AA"},
+		{name: "failure/別の鍵で署名", raw: pagination.NewCursorCodec([]byte("other")).Encode(cursor)},
+		{name: "failure/Base64でない", raw: "!!!." + sig},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := codec.Decode(tt.raw)
+			assert.ErrorIs(t, err, pagination.ErrInvalidCursor)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Package pagination 一覧取得APIで共通して使うページング・ソート・絞り込み条件を扱う
+package pagination
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+const (
+	// DefaultPerPage 1ページあたりの件数が指定されなかった場合の件数
+	DefaultPerPage = 20
+	// MaxPerPage 1ページあたりの件数の上限
+	MaxPerPage = 100
+)
+
+// ErrInvalidSort ソート指定の書式が正しくない
+var ErrInvalidSort = errors.New("invalid sort expression")
+
+var sortFieldPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// Sort ソート条件
+type Sort struct {
+	Field string
+	Desc  bool
+}
+
+// Params 一覧取得の条件
+type Params struct {
+	// Page 1始まりのページ番号
+	Page int
+	// PerPage 1ページあたりの件数
+	PerPage int
+	// Sorts 優先順のソート条件。空の場合は各リポジトリの既定の並び順とする
+	Sorts []Sort
+	// Filters 絞り込み条件（フィールド名と値）。利用できるフィールドは各リポジトリで定める
+	Filters map[string]string
+	// Cursor 指定した場合はページ番号の代わりにカーソルの位置から取得する
+	Cursor *Cursor
+}
+
+// NewParams 未指定の項目に既定値を補った一覧取得の条件を生成する
+func NewParams(page, perPage int, sorts []Sort, filters map[string]string) Params {
+	if page < 1 {
+		page = 1
+	}
+
+	if perPage < 1 {
+		perPage = DefaultPerPage
+	}
+
+	if perPage > MaxPerPage {
+		perPage = MaxPerPage
+	}
+
+	if filters == nil {
+		filters = map[string]string{}
+	}
+
+	return Params{
+		Page:    page,
+		PerPage: perPage,
+		Sorts:   sorts,
+		Filters: filters,
+	}
+}
+
+// Offset 取得開始位置。カーソルを指定した場合は常に0とする
+func (p Params) Offset() int {
+	if p.Cursor != nil {
+		return 0
+	}
+
+	return (p.Page - 1) * p.PerPage
+}
+
+// ParseSort `field,-field` 形式のソート指定を解析する。先頭に `-` を付けたフィールドは降順とする
+func ParseSort(raw string) ([]Sort, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(raw, ",")
+	sorts := make([]Sort, 0, len(parts))
+	seen := make(map[string]struct{}, len(parts))
+	for _, part := range parts {
+		s := Sort{Field: strings.TrimSpace(part)}
+		if strings.HasPrefix(s.Field, "-") {
+			s.Field = s.Field[1:]
+			s.Desc = true
+		}
+
+		if !sortFieldPattern.MatchString(s.Field) {
+			return nil, ErrInvalidSort
+		}
+
+		if _, ok := seen[s.Field]; ok {
+			return nil, ErrInvalidSort
+		}
+
+		seen[s.Field] = struct{}{}
+		sorts = append(sorts, s)
+	}
+
+	return sorts, nil
+}
+
+// FormatSort ソート条件を `field,-field` 形式の文字列に変換する
+func FormatSort(sorts []Sort) string {
+	parts := make([]string, len(sorts))
+	for i, s := range sorts {
+		parts[i] = s.Field
+		if s.Desc {
+			parts[i] = "-" + s.Field
+		}
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// Meta 一覧取得結果のページ情報
+type Meta struct {
+	// Page ページ番号。カーソルで取得した場合は0とする
+	Page       int
+	PerPage    int
+	TotalCount int64
+	// NextCursor 次のページを取得するためのカーソル。次のページがない場合はnil
+	NextCursor *Cursor
+}
+
+// NewMeta 一覧取得の条件と総件数からページ情報を生成する
+func NewMeta(params Params, totalCount int64, nextCursor *Cursor) Meta {
+	page := params.Page
+	if params.Cursor != nil {
+		page = 0
+	}
+
+	return Meta{
+		Page:       page,
+		PerPage:    params.PerPage,
+		TotalCount: totalCount,
+		NextCursor: nextCursor,
+	}
+}
+
+// TotalPages 総ページ数
+func (m Meta) TotalPages() int {
+	if m.PerPage < 1 || m.TotalCount == 0 {
+		return 0
+	}
+
+	return int((m.TotalCount + int64(m.PerPage) - 1) / int64(m.PerPage))
+}
+
+// HasPrev 前のページが存在するか
+func (m Meta) HasPrev() bool {
+	return m.Page > 1
+}
+
+// HasNext 次のページが存在するか
+func (m Meta) HasNext() bool {
+	return m.NextCursor != nil
+}
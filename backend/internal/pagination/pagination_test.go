@@ -0,0 +1,93 @@
+package pagination_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"g_gen/internal/pagination"
+)
+
+func TestParseSort(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    []pagination.Sort
+		wantErr error
+	}{
+		{name: "Success/未指定", raw: "", want: nil},
+		{
+			name: "Success/昇順と降順",
+			raw:  "prefecture_code,-organization_code",
+			want: []pagination.Sort{
+				{Field: "prefecture_code"},
+				{Field: "organization_code", Desc: true},
+			},
+		},
+		{name: "failure/空のフィールド", raw: "id,", wantErr: pagination.ErrInvalidSort},
+		{name: "failure/不正な文字", raw: "id;drop", wantErr: pagination.ErrInvalidSort},
+		{name: "failure/重複", raw: "id,-id", wantErr: pagination.ErrInvalidSort},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pagination.ParseSort(tt.raw)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewParams(t *testing.T) {
+	p := pagination.NewParams(0, 1000, nil, nil)
+	assert.Equal(t, 1, p.Page)
+	assert.Equal(t, pagination.MaxPerPage, p.PerPage)
+	assert.Equal(t, 0, p.Offset())
+	assert.NotNil(t, p.Filters)
+
+	p = pagination.NewParams(3, 0, nil, nil)
+	assert.Equal(t, pagination.DefaultPerPage, p.PerPage)
+	assert.Equal(t, 40, p.Offset())
+
+	p.Cursor = &pagination.Cursor{}
+	assert.Equal(t, 0, p.Offset())
+	assert.Equal(t, 0, pagination.NewMeta(p, 0, nil).Page)
+}
+
+func TestFormatSort(t *testing.T) {
+	sorts, err := pagination.ParseSort("prefecture_code,-organization_code")
+	assert.NoError(t, err)
+	assert.Equal(t, "prefecture_code,-organization_code", pagination.FormatSort(sorts))
+}
+
+func TestMeta(t *testing.T) {
+	tests := []struct {
+		name           string
+		meta           pagination.Meta
+		wantTotalPages int
+		wantPrev       bool
+		wantNext       bool
+	}{
+		{name: "0件", meta: pagination.Meta{Page: 1, PerPage: 20}, wantTotalPages: 0},
+		{
+			name:           "ちょうど割り切れる",
+			meta:           pagination.Meta{Page: 1, PerPage: 20, TotalCount: 40, NextCursor: &pagination.Cursor{}},
+			wantTotalPages: 2,
+			wantNext:       true,
+		},
+		{name: "最終ページ", meta: pagination.Meta{Page: 3, PerPage: 20, TotalCount: 41}, wantTotalPages: 3, wantPrev: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantTotalPages, tt.meta.TotalPages())
+			assert.Equal(t, tt.wantPrev, tt.meta.HasPrev())
+			assert.Equal(t, tt.wantNext, tt.meta.HasNext())
+		})
+	}
+}
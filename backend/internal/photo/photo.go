@@ -0,0 +1,181 @@
+// Package photo は写真のEXIFの読み取りとサムネイルの生成を提供する
+package photo
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"io"
+	"strings"
+	"time"
+
+	// サムネイルの元にできる写真の形式を登録する
+	_ "image/png"
+
+	"github.com/rwcarlsen/goexif/exif"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// exifDateTimeLayout EXIFの日時の書式
+const exifDateTimeLayout = "2006:01:02 15:04:05"
+
+// thumbnailQuality サムネイルのJPEGの画質
+const thumbnailQuality = 80
+
+// maxSourcePixels サムネイルの元にできる写真の最大の画素数
+// 展開後の大きさはファイルサイズによらないため、小さなファイルで巨大な画像を展開させてメモリを使い果たすことを防ぐ
+const maxSourcePixels = 64 << 20
+
+// jst EXIFの撮影日時はタイムゾーンを持たないため、日本時間として扱う
+var jst = time.FixedZone("JST", 9*60*60)
+
+// Metadata 写真のEXIFから読み取った情報。EXIFにない項目はnil（Orientationは1）とする
+type Metadata struct {
+	// CapturedAt 撮影日時
+	CapturedAt *time.Time
+	// Latitude 撮影位置の緯度
+	Latitude *float64
+	// Longitude 撮影位置の経度
+	Longitude *float64
+	// Orientation 写真の向き（EXIFのOrientation、1〜8）。1はそのままの向き
+	Orientation int
+}
+
+// ReadMetadata JPEGの写真のEXIFから撮影日時・撮影位置・向きを読み取る
+// EXIFがない写真は、すべての項目が空のMetadataを返す
+func ReadMetadata(r io.Reader) (*Metadata, error) {
+	metadata := &Metadata{Orientation: 1}
+
+	x, err := exif.Decode(r)
+	if err != nil {
+		// APP1のセグメントが見つからないまま終端まで読んだ場合はEXIFがない
+		if errors.Is(err, io.EOF) {
+			return metadata, nil
+		}
+
+		// 一部のIFDだけが壊れている場合は、読めた項目を使う
+		if x == nil || exif.IsCriticalError(err) {
+			return nil, err
+		}
+	}
+
+	if tag, err := x.Get(exif.DateTimeOriginal); err == nil {
+		if s, err := tag.StringVal(); err == nil {
+			// 時計が未設定のカメラは "0000:00:00 00:00:00" を書き込むため、読めない日時はないものとする
+			if t, err := time.ParseInLocation(exifDateTimeLayout, strings.TrimRight(s, "\x00 "), jst); err == nil {
+				metadata.CapturedAt = &t
+			}
+		}
+	}
+
+	if lat, lng, err := x.LatLong(); err == nil && lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180 {
+		metadata.Latitude = &lat
+		metadata.Longitude = &lng
+	}
+
+	if tag, err := x.Get(exif.Orientation); err == nil {
+		if o, err := tag.Int(0); err == nil && o >= 1 && o <= 8 {
+			metadata.Orientation = o
+		}
+	}
+
+	return metadata, nil
+}
+
+// Thumbnail 写真（JPEG・PNG・WebP）から width×height のJPEGのサムネイルを作成して w に書き込む
+// 縦横比を保ったまま中央を切り抜き、orientation（EXIFのOrientation）に従って正しい向きに直す
+// 展開する前に画素数を確認するため、r は2回読む
+func Thumbnail(w io.Writer, r io.ReadSeeker, orientation, width, height int) error {
+	config, _, err := image.DecodeConfig(r)
+	if err != nil {
+		return err
+	}
+
+	if config.Width*config.Height > maxSourcePixels {
+		return fmt.Errorf("photo: %dx%d image is too large to make a thumbnail", config.Width, config.Height)
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	src, _, err := image.Decode(r)
+	if err != nil {
+		return err
+	}
+
+	// 向きを直す前の写真で切り抜くため、90度回転する向きでは幅と高さを入れ替える
+	scaledWidth, scaledHeight := width, height
+	if orientation >= 5 && orientation <= 8 {
+		scaledWidth, scaledHeight = height, width
+	}
+
+	// JPEGは透過できないため、透過した部分は白にする
+	scaled := image.NewRGBA(image.Rect(0, 0, scaledWidth, scaledHeight))
+	draw.Draw(scaled, scaled.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(scaled, scaled.Bounds(), src, coverRect(src.Bounds(), scaledWidth, scaledHeight), draw.Over, nil)
+
+	return jpeg.Encode(w, orient(scaled, orientation), &jpeg.Options{Quality: thumbnailQuality})
+}
+
+// coverRect 縦横比を width:height にそろえるため、b の中央から切り抜く範囲
+func coverRect(b image.Rectangle, width, height int) image.Rectangle {
+	w, h := b.Dx(), b.Dy()
+
+	// 指定より横長の写真は左右を、縦長の写真は上下を切り落とす
+	if w*height > h*width {
+		cropped := max(h*width/height, 1)
+		x := b.Min.X + (w-cropped)/2
+
+		return image.Rect(x, b.Min.Y, x+cropped, b.Max.Y)
+	}
+
+	cropped := max(w*height/width, 1)
+	y := b.Min.Y + (h-cropped)/2
+
+	return image.Rect(b.Min.X, y, b.Max.X, y+cropped)
+}
+
+// orient EXIFのOrientationに従って、写真を回転・反転して正しい向きに直す
+func orient(src *image.RGBA, orientation int) image.Image {
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+
+	// 直した後の座標 (x, y) に対応する元の座標
+	var at func(x, y int) (int, int)
+
+	switch orientation {
+	case 2: // 左右反転
+		at = func(x, y int) (int, int) { return w - 1 - x, y }
+	case 3: // 180度回転
+		at = func(x, y int) (int, int) { return w - 1 - x, h - 1 - y }
+	case 4: // 上下反転
+		at = func(x, y int) (int, int) { return x, h - 1 - y }
+	case 5: // 左上と右下を結ぶ線で反転
+		at = func(x, y int) (int, int) { return y, x }
+	case 6: // 時計回りに90度回転
+		at = func(x, y int) (int, int) { return y, h - 1 - x }
+	case 7: // 右上と左下を結ぶ線で反転
+		at = func(x, y int) (int, int) { return w - 1 - y, h - 1 - x }
+	case 8: // 反時計回りに90度回転
+		at = func(x, y int) (int, int) { return w - 1 - y, x }
+	default:
+		return src
+	}
+
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := range dh {
+		for x := range dw {
+			sx, sy := at(x, y)
+			dst.SetRGBA(x, y, src.RGBAAt(sx, sy))
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,389 @@
+package photo_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/photo"
+)
+
+// EXIFのタグと型
+const (
+	tagOrientation      = 0x0112
+	tagExifIFDPointer   = 0x8769
+	tagGPSIFDPointer    = 0x8825
+	tagDateTimeOriginal = 0x9003
+	tagGPSLatitudeRef   = 0x0001
+	tagGPSLatitude      = 0x0002
+	tagGPSLongitudeRef  = 0x0003
+	tagGPSLongitude     = 0x0004
+
+	typeASCII    = 2
+	typeShort    = 3
+	typeLong     = 4
+	typeRational = 5
+)
+
+type tiffEntry struct {
+	tag   uint16
+	typ   uint16
+	count uint32
+	value []byte
+}
+
+func asciiEntry(tag uint16, s string) tiffEntry {
+	value := append([]byte(s), 0)
+
+	return tiffEntry{tag: tag, typ: typeASCII, count: uint32(len(value)), value: value}
+}
+
+func shortEntry(tag, v uint16) tiffEntry {
+	return tiffEntry{tag: tag, typ: typeShort, count: 1, value: binary.LittleEndian.AppendUint16(nil, v)}
+}
+
+// degreesEntry 度・分・秒を有理数で表すGPSの座標
+func degreesEntry(tag uint16, degrees, minutes, seconds uint32) tiffEntry {
+	var value []byte
+	for _, v := range []uint32{degrees, 1, minutes, 1, seconds, 100} {
+		value = binary.LittleEndian.AppendUint32(value, v)
+	}
+
+	return tiffEntry{tag: tag, typ: typeRational, count: 3, value: value}
+}
+
+// buildTIFF IFD0と、空でなければExif IFD・GPS IFDを持つ、リトルエンディアンのTIFFを組み立てる
+func buildTIFF(ifd0, exifIFD, gpsIFD []tiffEntry) []byte {
+	ifdSize := func(entries []tiffEntry) int { return 2 + 12*len(entries) + 4 }
+
+	// サブIFDへのポインタの値はIFDの位置が決まってから入れる
+	ifd0 = append([]tiffEntry{}, ifd0...)
+	if len(exifIFD) > 0 {
+		ifd0 = append(ifd0, tiffEntry{tag: tagExifIFDPointer, typ: typeLong, count: 1})
+	}
+
+	if len(gpsIFD) > 0 {
+		ifd0 = append(ifd0, tiffEntry{tag: tagGPSIFDPointer, typ: typeLong, count: 1})
+	}
+
+	exifOffset := 8 + ifdSize(ifd0)
+	gpsOffset := exifOffset
+	if len(exifIFD) > 0 {
+		gpsOffset += ifdSize(exifIFD)
+	}
+
+	dataOffset := gpsOffset
+	if len(gpsIFD) > 0 {
+		dataOffset += ifdSize(gpsIFD)
+	}
+
+	for i, e := range ifd0 {
+		switch e.tag {
+		case tagExifIFDPointer:
+			ifd0[i].value = binary.LittleEndian.AppendUint32(nil, uint32(exifOffset))
+		case tagGPSIFDPointer:
+			ifd0[i].value = binary.LittleEndian.AppendUint32(nil, uint32(gpsOffset))
+		}
+	}
+
+	// 4バイトを超える値はIFDの後ろにまとめて置き、IFDには位置を書く
+	var data []byte
+
+	writeIFD := func(b []byte, entries []tiffEntry) []byte {
+		b = binary.LittleEndian.AppendUint16(b, uint16(len(entries)))
+		for _, e := range entries {
+			b = binary.LittleEndian.AppendUint16(b, e.tag)
+			b = binary.LittleEndian.AppendUint16(b, e.typ)
+			b = binary.LittleEndian.AppendUint32(b, e.count)
+
+			if len(e.value) <= 4 {
+				b = append(b, e.value...)
+				b = append(b, make([]byte, 4-len(e.value))...)
+
+				continue
+			}
+
+			b = binary.LittleEndian.AppendUint32(b, uint32(dataOffset+len(data)))
+			data = append(data, e.value...)
+			if len(data)%2 == 1 {
+				data = append(data, 0)
+			}
+		}
+
+		return binary.LittleEndian.AppendUint32(b, 0)
+	}
+
+	b := []byte("II")
+	b = binary.LittleEndian.AppendUint16(b, 42)
+	b = binary.LittleEndian.AppendUint32(b, 8)
+	b = writeIFD(b, ifd0)
+
+	if len(exifIFD) > 0 {
+		b = writeIFD(b, exifIFD)
+	}
+
+	if len(gpsIFD) > 0 {
+		b = writeIFD(b, gpsIFD)
+	}
+
+	return append(b, data...)
+}
+
+// encodeJPEG 左半分が赤、右半分が青の width×height のJPEG。tiff が空でなければEXIFとして埋め込む
+func encodeJPEG(t *testing.T, width, height int, tiff []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, halves(width, height), &jpeg.Options{Quality: 100}))
+
+	if len(tiff) == 0 {
+		return buf.Bytes()
+	}
+
+	// SOIの直後にEXIFのAPP1セグメントを入れる
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	app1 := binary.BigEndian.AppendUint16([]byte{0xFF, 0xE1}, uint16(len(segment)+2))
+
+	jpg := buf.Bytes()
+
+	return append(append(append([]byte{}, jpg[:2]...), append(app1, segment...)...), jpg[2:]...)
+}
+
+func halves(width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			if x < width/2 {
+				img.SetRGBA(x, y, color.RGBA{R: 255, A: 255})
+			} else {
+				img.SetRGBA(x, y, color.RGBA{B: 255, A: 255})
+			}
+		}
+	}
+
+	return img
+}
+
+// hugePNG 画素数だけが大きいPNG。展開すると数GBになる
+func hugePNG(t *testing.T) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, 1, 1))))
+
+	// 先頭のIHDRチャンクの幅・高さを書き換え、CRCを計算し直す
+	b := buf.Bytes()
+	binary.BigEndian.PutUint32(b[16:], 50000)
+	binary.BigEndian.PutUint32(b[20:], 50000)
+	binary.BigEndian.PutUint32(b[29:], crc32.ChecksumIEEE(b[12:29]))
+
+	return b
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestReadMetadata(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+
+	tests := []struct {
+		name    string
+		jpg     []byte
+		want    *photo.Metadata
+		wantErr bool
+	}{
+		{
+			name: "Success/撮影日時・撮影位置・向きを読み取る",
+			jpg: encodeJPEG(t, 8, 8, buildTIFF(
+				[]tiffEntry{shortEntry(tagOrientation, 6)},
+				[]tiffEntry{asciiEntry(tagDateTimeOriginal, "2024:07:01 09:30:15")},
+				[]tiffEntry{
+					asciiEntry(tagGPSLatitudeRef, "N"),
+					degreesEntry(tagGPSLatitude, 35, 40, 3600),
+					asciiEntry(tagGPSLongitudeRef, "E"),
+					degreesEntry(tagGPSLongitude, 139, 45, 0),
+				},
+			)),
+			want: &photo.Metadata{
+				CapturedAt:  ptr(time.Date(2024, 7, 1, 9, 30, 15, 0, jst)),
+				Latitude:    ptr(35 + 40.0/60 + 36.0/3600),
+				Longitude:   ptr(139.75),
+				Orientation: 6,
+			},
+		},
+		{
+			name: "Success/南緯・西経は負の値",
+			jpg: encodeJPEG(t, 8, 8, buildTIFF(
+				nil,
+				nil,
+				[]tiffEntry{
+					asciiEntry(tagGPSLatitudeRef, "S"),
+					degreesEntry(tagGPSLatitude, 33, 30, 0),
+					asciiEntry(tagGPSLongitudeRef, "W"),
+					degreesEntry(tagGPSLongitude, 70, 15, 0),
+				},
+			)),
+			want: &photo.Metadata{
+				Latitude:    ptr(-33.5),
+				Longitude:   ptr(-70.25),
+				Orientation: 1,
+			},
+		},
+		{
+			name: "Success/時計が未設定の撮影日時はないものとする",
+			jpg: encodeJPEG(t, 8, 8, buildTIFF(
+				[]tiffEntry{shortEntry(tagOrientation, 3)},
+				[]tiffEntry{asciiEntry(tagDateTimeOriginal, "0000:00:00 00:00:00")},
+				nil,
+			)),
+			want: &photo.Metadata{Orientation: 3},
+		},
+		{
+			name: "Success/範囲外の向きは1",
+			jpg:  encodeJPEG(t, 8, 8, buildTIFF([]tiffEntry{shortEntry(tagOrientation, 9)}, nil, nil)),
+			want: &photo.Metadata{Orientation: 1},
+		},
+		{
+			name: "Success/EXIFがない",
+			jpg:  encodeJPEG(t, 8, 8, nil),
+			want: &photo.Metadata{Orientation: 1},
+		},
+		{
+			name:    "failure/EXIFが壊れている",
+			jpg:     encodeJPEG(t, 8, 8, []byte("II*\x00\xff\xff\xff\xff")),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := photo.ReadMetadata(bytes.NewReader(tt.jpg))
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			// 度・分・秒から度への換算の誤差は許容する
+			if diff := cmp.Diff(tt.want, got, cmpopts.EquateApprox(0, 1e-9)); diff != "" {
+				t.Errorf("ReadMetadata() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestThumbnail(t *testing.T) {
+	var pngImage bytes.Buffer
+	require.NoError(t, png.Encode(&pngImage, halves(400, 100)))
+
+	tests := []struct {
+		name        string
+		src         []byte
+		orientation int
+		width       int
+		height      int
+		// 元の写真の左半分（赤）・右半分（青）が来るはずの位置
+		wantRed, wantBlue image.Point
+		wantErr           bool
+	}{
+		{
+			name:        "Success/そのままの向き",
+			src:         encodeJPEG(t, 200, 100, nil),
+			orientation: 1,
+			width:       100,
+			height:      100,
+			wantRed:     image.Pt(2, 50),
+			wantBlue:    image.Pt(97, 50),
+		},
+		{
+			name:        "Success/180度回転",
+			src:         encodeJPEG(t, 200, 100, nil),
+			orientation: 3,
+			width:       100,
+			height:      100,
+			wantRed:     image.Pt(97, 50),
+			wantBlue:    image.Pt(2, 50),
+		},
+		{
+			name:        "Success/時計回りに90度回転",
+			src:         encodeJPEG(t, 200, 100, nil),
+			orientation: 6,
+			width:       80,
+			height:      120,
+			wantRed:     image.Pt(40, 2),
+			wantBlue:    image.Pt(40, 117),
+		},
+		{
+			name:        "Success/反時計回りに90度回転",
+			src:         encodeJPEG(t, 200, 100, nil),
+			orientation: 8,
+			width:       80,
+			height:      120,
+			wantRed:     image.Pt(40, 117),
+			wantBlue:    image.Pt(40, 2),
+		},
+		{
+			name:        "Success/PNGから作成",
+			src:         pngImage.Bytes(),
+			orientation: 1,
+			width:       320,
+			height:      240,
+			wantRed:     image.Pt(2, 120),
+			wantBlue:    image.Pt(317, 120),
+		},
+		{
+			name:    "failure/画素数が多すぎる",
+			src:     hugePNG(t),
+			width:   100,
+			height:  100,
+			wantErr: true,
+		},
+		{
+			name:    "failure/画像ではない",
+			src:     []byte("%PDF-1.7"),
+			width:   100,
+			height:  100,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := photo.Thumbnail(&buf, bytes.NewReader(tt.src), tt.orientation, tt.width, tt.height)
+			if tt.wantErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			got, err := jpeg.Decode(&buf)
+			require.NoError(t, err)
+			assert.Equal(t, image.Rect(0, 0, tt.width, tt.height), got.Bounds())
+
+			isRed := func(p image.Point) bool {
+				r, _, b, _ := got.At(p.X, p.Y).RGBA()
+
+				return r > b
+			}
+			assert.True(t, isRed(tt.wantRed), "red at %v", tt.wantRed)
+			assert.False(t, isRed(tt.wantBlue), "blue at %v", tt.wantBlue)
+		})
+	}
+}
@@ -0,0 +1,310 @@
+package scaffold
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// 登録先の既存のファイル（backendディレクトリからの相対パス）
+const (
+	errorsFile        = "internal/errors/error.go"
+	errorResponseFile = "internal/handler/error_response.go"
+	providerFile      = "internal/di/provider.go"
+	routeFile         = "internal/server/route.go"
+)
+
+// insertion ファイルのオフセットに挿入するテキスト
+type insertion struct {
+	offset int
+	text   string
+}
+
+// editor 既存のファイルに縦割りを登録する挿入位置を求める。登録済みの場合はnilを返す
+type editor func(src []byte, fset *token.FileSet, file *ast.File, s *Slice) ([]insertion, error)
+
+var editors = []struct {
+	path string
+	edit editor
+}{
+	{path: errorsFile, edit: editErrors},
+	{path: errorResponseFile, edit: editErrorResponse},
+	{path: providerFile, edit: editProvider},
+	{path: routeFile, edit: editRoute},
+}
+
+// applyEdit ファイルを読み込んで挿入し、整形した内容を返す。登録済みの場合はnilを返す
+func applyEdit(path string, src []byte, edit editor, s *Slice) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, src, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	insertions, err := edit(src, fset, file, s)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if len(insertions) == 0 {
+		return nil, nil
+	}
+
+	// 後ろから挿入し、前の挿入位置がずれないようにする
+	slices.SortStableFunc(insertions, func(a, b insertion) int { return b.offset - a.offset })
+	out := slices.Clone(src)
+	for _, ins := range insertions {
+		out = slices.Insert(out, ins.offset, []byte(ins.text)...)
+	}
+
+	formatted, err := format.Source(out)
+	if err != nil {
+		return nil, fmt.Errorf("%s: 挿入後のコードを整形できませんでした: %w", path, err)
+	}
+
+	return formatted, nil
+}
+
+// editErrors エラーコードとメッセージの定数を、既存のコードの最大値の次の番号で追加する
+func editErrors(src []byte, fset *token.FileSet, file *ast.File, s *Slice) ([]insertion, error) {
+	codes := valueSpecs(file, "ErrorCode")
+	messages := valueSpecs(file, "ErrorMessage")
+	if len(codes) == 0 || len(messages) == 0 {
+		return nil, fmt.Errorf("ErrorCode と ErrorMessage の定数がありません")
+	}
+
+	maxCode := 0
+	for _, spec := range codes {
+		if spec.Names[0].Name == s.NotFoundError() {
+			return nil, nil
+		}
+
+		lit, ok := spec.Values[0].(*ast.BasicLit)
+		if !ok {
+			continue
+		}
+		value, _ := strconv.Unquote(lit.Value)
+		if n, err := strconv.Atoi(strings.TrimPrefix(value, "E")); err == nil {
+			maxCode = max(maxCode, n)
+		}
+	}
+
+	return []insertion{
+		{
+			offset: lineEnd(src, fset, codes[len(codes)-1].End()),
+			text:   fmt.Sprintf("\n\t%s ErrorCode = \"E%06d\" // %sが存在しないエラー", s.NotFoundError(), maxCode+1, s.Label),
+		},
+		{
+			offset: lineEnd(src, fset, messages[len(messages)-1].End()),
+			text:   fmt.Sprintf("\n\t%sMessage ErrorMessage = \"%sは存在しません\"", s.NotFoundError(), s.Label),
+		},
+	}, nil
+}
+
+// editErrorResponse CreateErrResponse で404を返すエラーコードに追加する
+func editErrorResponse(_ []byte, _ *token.FileSet, file *ast.File, s *Slice) ([]insertion, error) {
+	fn := funcDecl(file, "CreateErrResponse")
+	if fn == nil {
+		return nil, fmt.Errorf("CreateErrResponse がありません")
+	}
+
+	var notFound *ast.CaseClause
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		clause, ok := n.(*ast.CaseClause)
+		if !ok {
+			return true
+		}
+
+		for _, expr := range clause.List {
+			if sel, ok := expr.(*ast.SelectorExpr); ok && strings.HasSuffix(sel.Sel.Name, "NotFoundError") {
+				notFound = clause
+			}
+		}
+
+		return notFound == nil
+	})
+	if notFound == nil {
+		return nil, fmt.Errorf("CreateErrResponse に NotFoundError の case がありません")
+	}
+
+	for _, expr := range notFound.List {
+		if sel, ok := expr.(*ast.SelectorExpr); ok && sel.Sel.Name == s.NotFoundError() {
+			return nil, nil
+		}
+	}
+
+	return []insertion{{
+		offset: offset(file, notFound.List[len(notFound.List)-1].End()),
+		text:   ",\n\tmyerrors." + s.NotFoundError(),
+	}}, nil
+}
+
+// editProvider プロバイダーの関数を Core の前に追加し、Provider の fx.Provide に登録する
+func editProvider(_ []byte, _ *token.FileSet, file *ast.File, s *Slice) ([]insertion, error) {
+	repository := "Provide" + s.Model + "Repository"
+	if funcDecl(file, repository) != nil {
+		return nil, nil
+	}
+
+	core := funcDecl(file, "Core")
+	provider := funcDecl(file, "Provider")
+	if core == nil || provider == nil {
+		return nil, fmt.Errorf("Core と Provider がありません")
+	}
+
+	var provide *ast.CallExpr
+	ast.Inspect(provider.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if ok && isSelector(call.Fun, "fx", "Provide") && len(call.Args) > 0 {
+			provide = call
+		}
+
+		return true
+	})
+	if provide == nil {
+		return nil, fmt.Errorf("Provider に fx.Provide がありません")
+	}
+
+	funcs, err := render("provider.snippet.tmpl", s)
+	if err != nil {
+		return nil, err
+	}
+
+	pos := core.Pos()
+	if core.Doc != nil {
+		pos = core.Doc.Pos()
+	}
+
+	return []insertion{
+		{offset: offset(file, pos), text: string(funcs)},
+		{
+			offset: offset(file, provide.Args[len(provide.Args)-1].End()),
+			text:   fmt.Sprintf(",\n%[1]sRepository,\n%[1]sUseCase,\n%[1]sHandler", "Provide"+s.Model),
+		},
+	}, nil
+}
+
+// editRoute RegisterRoutes にハンドラーの引数を追加し、最後のハンドラーのルートの後にルートを登録する
+func editRoute(src []byte, fset *token.FileSet, file *ast.File, s *Slice) ([]insertion, error) {
+	fn := funcDecl(file, "RegisterRoutes")
+	if fn == nil {
+		return nil, fmt.Errorf("RegisterRoutes がありません")
+	}
+
+	handlerName := s.Var() + "Handler"
+	var engine string
+	handlers := make(map[string]bool)
+	for _, field := range fn.Type.Params.List {
+		for _, name := range field.Names {
+			if name.Name == handlerName {
+				return nil, nil
+			}
+
+			switch t := field.Type.(type) {
+			case *ast.StarExpr:
+				if isSelector(t.X, "gin", "Engine") {
+					engine = name.Name
+				}
+			case *ast.SelectorExpr:
+				if pkg, ok := t.X.(*ast.Ident); ok && pkg.Name == "handler" {
+					handlers[name.Name] = true
+				}
+			}
+		}
+	}
+	if engine == "" {
+		return nil, fmt.Errorf("RegisterRoutes に *gin.Engine の引数がありません")
+	}
+
+	// ハンドラーを参照する最後の文の後にルートを追加する
+	var last ast.Stmt
+	for _, stmt := range fn.Body.List {
+		ast.Inspect(stmt, func(n ast.Node) bool {
+			if ident, ok := n.(*ast.Ident); ok && handlers[ident.Name] {
+				last = stmt
+			}
+
+			return true
+		})
+	}
+	if last == nil {
+		return nil, fmt.Errorf("RegisterRoutes にハンドラーのルートがありません")
+	}
+
+	routes, err := render("route.snippet.tmpl", struct {
+		*Slice
+		Engine string
+	}{Slice: s, Engine: engine})
+	if err != nil {
+		return nil, err
+	}
+
+	params := fn.Type.Params.List
+
+	return []insertion{
+		{
+			offset: offset(file, params[len(params)-1].End()),
+			text:   fmt.Sprintf(",\n%s handler.%sHandler", handlerName, s.Model),
+		},
+		{offset: lineEnd(src, fset, last.End()), text: strings.TrimSuffix(string(routes), "\n")},
+	}, nil
+}
+
+// valueSpecs 型を指定した定数の宣言
+func valueSpecs(file *ast.File, typeName string) []*ast.ValueSpec {
+	var specs []*ast.ValueSpec
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			if ident, ok := vs.Type.(*ast.Ident); ok && ident.Name == typeName && len(vs.Names) == 1 && len(vs.Values) == 1 {
+				specs = append(specs, vs)
+			}
+		}
+	}
+
+	return specs
+}
+
+func funcDecl(file *ast.File, name string) *ast.FuncDecl {
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fn.Name.Name == name {
+			return fn
+		}
+	}
+
+	return nil
+}
+
+func isSelector(expr ast.Expr, pkg, name string) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := sel.X.(*ast.Ident)
+
+	return ok && ident.Name == pkg && sel.Sel.Name == name
+}
+
+func offset(file *ast.File, pos token.Pos) int {
+	return int(pos - file.FileStart)
+}
+
+// lineEnd 行末のコメントの後に挿入するため、posの行の改行の位置を返す
+func lineEnd(src []byte, fset *token.FileSet, pos token.Pos) int {
+	off := fset.Position(pos).Offset
+	if i := bytes.IndexByte(src[off:], '\n'); i >= 0 {
+		return off + i
+	}
+
+	return len(src)
+}
@@ -0,0 +1,267 @@
+package scaffold
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/jinzhu/inflection"
+)
+
+// modelDir ggen generate がモデルを出力するディレクトリ（backendディレクトリからの相対パス）
+const modelDir = "internal/domain/model"
+
+// integerTypes 主キーに対応している型。パスパラメータのIDはintで受け取り、この型に変換する
+// 一覧のソートには int32SortColumn・int64SortColumn を使う
+var integerTypes = []string{"int32", "int64"}
+
+// Slice 1テーブル分の縦割り（リポジトリ・データストア・ユースケース・ハンドラー）を生成するための情報
+type Slice struct {
+	// Table テーブル名（damage_reports など）
+	Table string
+	// Label APIドキュメントやエラーメッセージに使う日本語名（被害報告 など）
+	Label string
+	// Model モデルの構造体名（DamageReport など）
+	Model string
+	// PrimaryKey 主キーの列
+	PrimaryKey *Field
+	// Fields レスポンスに含める列（更新日時を除く）
+	Fields []*Field
+	// HasUpdatedAt 更新日時の列があり、詳細取得でLast-Modifiedを返せるか
+	HasUpdatedAt bool
+}
+
+// Field モデルの列のフィールド
+type Field struct {
+	Name   string
+	Type   string
+	Column string
+	JSON   string
+	// Import 型が参照するパッケージのインポートパス（datatypes.JSON の gorm.io/datatypes など）
+	Import string
+}
+
+// Load ggen generate で生成したテーブルのモデルを読み込む
+func Load(dir, table, label string) (*Slice, error) {
+	path := filepath.Join(dir, modelDir, table+".gen.go")
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, fmt.Errorf("モデルを読み込めませんでした。ggen generate でモデルを生成してください: %w", err)
+	}
+
+	return parseModel(file, table, label)
+}
+
+func parseModel(file *ast.File, table, label string) (*Slice, error) {
+	name := modelName(file, table)
+	if name == "" {
+		return nil, fmt.Errorf("テーブル %s のモデルがありません", table)
+	}
+
+	spec := structType(file, name)
+	if spec == nil {
+		return nil, fmt.Errorf("モデル %s の構造体がありません", name)
+	}
+
+	imports := make(map[string]string, len(file.Imports))
+	for _, imp := range file.Imports {
+		p, _ := strconv.Unquote(imp.Path.Value)
+		alias := p[strings.LastIndex(p, "/")+1:]
+		if imp.Name != nil {
+			alias = imp.Name.Name
+		}
+		imports[alias] = p
+	}
+
+	s := &Slice{Table: table, Label: label, Model: name}
+	var primaryKeys []*Field
+	for _, f := range spec.Fields.List {
+		if f.Tag == nil || len(f.Names) != 1 {
+			continue
+		}
+
+		tag, _ := strconv.Unquote(f.Tag.Value)
+		column, primaryKey := parseGormTag(reflect.StructTag(tag).Get("gorm"))
+		if column == "" {
+			// 列のないフィールドはリレーションのためレスポンスに含めない
+			continue
+		}
+
+		field := &Field{
+			Name:   f.Names[0].Name,
+			Type:   types.ExprString(f.Type),
+			Column: column,
+			JSON:   strings.Split(reflect.StructTag(tag).Get("json"), ",")[0],
+		}
+		if field.JSON == "" {
+			field.JSON = column
+		}
+
+		for _, pkg := range referencedPackages(f.Type) {
+			if p, ok := imports[pkg]; ok {
+				field.Import = p
+			}
+		}
+
+		if primaryKey {
+			primaryKeys = append(primaryKeys, field)
+		}
+
+		if field.Name == "UpdatedAt" && field.Type == "time.Time" {
+			s.HasUpdatedAt = true
+
+			continue
+		}
+
+		s.Fields = append(s.Fields, field)
+	}
+
+	if len(primaryKeys) != 1 {
+		return nil, fmt.Errorf("テーブル %s の主キーは1列である必要があります（%d列）", table, len(primaryKeys))
+	}
+	s.PrimaryKey = primaryKeys[0]
+	if !slices.Contains(integerTypes, s.PrimaryKey.Type) {
+		return nil, fmt.Errorf("テーブル %s の主キー %s は整数である必要があります: %s", table, s.PrimaryKey.Column, s.PrimaryKey.Type)
+	}
+
+	return s, nil
+}
+
+// modelName テーブル名の定数（const TableNameDamageReport = "damage_reports"）から構造体名を求める
+func modelName(file *ast.File, table string) string {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			for i, n := range vs.Names {
+				if i >= len(vs.Values) || !strings.HasPrefix(n.Name, "TableName") {
+					continue
+				}
+
+				if lit, ok := vs.Values[i].(*ast.BasicLit); ok && lit.Value == strconv.Quote(table) {
+					return strings.TrimPrefix(n.Name, "TableName")
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+func structType(file *ast.File, name string) *ast.StructType {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			if st, ok := ts.Type.(*ast.StructType); ok && ts.Name.Name == name {
+				return st
+			}
+		}
+	}
+
+	return nil
+}
+
+func parseGormTag(tag string) (column string, primaryKey bool) {
+	for _, setting := range strings.Split(tag, ";") {
+		key, value, _ := strings.Cut(setting, ":")
+		switch strings.ToLower(key) {
+		case "column":
+			column = value
+		case "primarykey", "primary_key":
+			primaryKey = true
+		}
+	}
+
+	return column, primaryKey
+}
+
+// referencedPackages 型が参照するパッケージ名（time.Time の time など）
+func referencedPackages(expr ast.Expr) []string {
+	var pkgs []string
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				pkgs = append(pkgs, ident.Name)
+			}
+		}
+
+		return true
+	})
+
+	return pkgs
+}
+
+// Plural 複数形の構造体名（DamageReports など）
+func (s *Slice) Plural() string {
+	return inflection.Plural(s.Model)
+}
+
+// Var 単数形の変数名（damageReport など）
+func (s *Slice) Var() string {
+	return lowerCamel(s.Model)
+}
+
+// PluralVar 複数形の変数名（damageReports など）
+func (s *Slice) PluralVar() string {
+	return lowerCamel(s.Plural())
+}
+
+// FileName 生成するファイル名の元になる単数形の名前（damage_report など）
+func (s *Slice) FileName() string {
+	return inflection.Singular(s.Table)
+}
+
+// Words ログのメッセージに使う単数形の英語名（damage report など）
+func (s *Slice) Words() string {
+	return strings.ReplaceAll(s.FileName(), "_", " ")
+}
+
+// PluralWords ログのメッセージに使う複数形の英語名（damage reports など）
+func (s *Slice) PluralWords() string {
+	return strings.ReplaceAll(s.Table, "_", " ")
+}
+
+// Path ルートのパス・Swaggerのタグに使う名前（damage-reports など）
+func (s *Slice) Path() string {
+	return strings.ReplaceAll(s.Table, "_", "-")
+}
+
+// NotFoundError myerrors のエラーコードの定数名
+func (s *Slice) NotFoundError() string {
+	return s.Model + "NotFoundError"
+}
+
+// lowerCamel 先頭の単語を小文字にする（URLMapping は urlMapping）
+func lowerCamel(name string) string {
+	runes := []rune(name)
+	for i := range runes {
+		if !unicode.IsUpper(runes[i]) {
+			break
+		}
+
+		// 頭字語の後に続く単語の先頭は大文字のまま残す
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
+		}
+		runes[i] = unicode.ToLower(runes[i])
+	}
+
+	return string(runes)
+}
@@ -0,0 +1,164 @@
+package scaffold_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"g_gen/internal/scaffold"
+)
+
+const inspectionSiteModel = `// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package model
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+const TableNameInspectionSite = "inspection_sites"
+
+// InspectionSite mapped from table <inspection_sites>
+type InspectionSite struct {
+	ID          int64          ` + "`" + `gorm:"column:id;type:bigint;primaryKey;autoIncrement:true" json:"id"` + "`" + `
+	SiteName    string         ` + "`" + `gorm:"column:site_name;type:character varying(50);not null" json:"site_name"` + "`" + `
+	InspectedOn *time.Time     ` + "`" + `gorm:"column:inspected_on;type:date" json:"inspected_on"` + "`" + `
+	Extra       datatypes.JSON ` + "`" + `gorm:"column:extra;type:jsonb" json:"extra"` + "`" + `
+	UpdatedAt   time.Time      ` + "`" + `gorm:"column:updated_at;type:timestamp with time zone;not null" json:"updated_at"` + "`" + `
+	Photos      []Photo        ` + "`" + `gorm:"foreignKey:SiteID;references:ID" json:"photos"` + "`" + `
+}
+
+// TableName InspectionSite's table name
+func (*InspectionSite) TableName() string {
+	return TableNameInspectionSite
+}
+`
+
+// writeModel テーブルのモデルをbackendディレクトリと同じ構成で書き込む
+func writeModel(t *testing.T, dir, table, src string) {
+	t.Helper()
+
+	modelDir := filepath.Join(dir, "internal/domain/model")
+	require.NoError(t, os.MkdirAll(modelDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(modelDir, table+".gen.go"), []byte(src), 0o600))
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		table   string
+		model   string
+		want    *scaffold.Slice
+		wantErr string
+	}{
+		{
+			name:  "Success/列と主キーと型のインポートを読み込む",
+			table: "inspection_sites",
+			model: inspectionSiteModel,
+			want: &scaffold.Slice{
+				Table:      "inspection_sites",
+				Label:      "点検箇所",
+				Model:      "InspectionSite",
+				PrimaryKey: &scaffold.Field{Name: "ID", Type: "int64", Column: "id", JSON: "id"},
+				Fields: []*scaffold.Field{
+					{Name: "ID", Type: "int64", Column: "id", JSON: "id"},
+					{Name: "SiteName", Type: "string", Column: "site_name", JSON: "site_name"},
+					{Name: "InspectedOn", Type: "*time.Time", Column: "inspected_on", JSON: "inspected_on", Import: "time"},
+					{Name: "Extra", Type: "datatypes.JSON", Column: "extra", JSON: "extra", Import: "gorm.io/datatypes"},
+				},
+				HasUpdatedAt: true,
+			},
+		},
+		{
+			name:    "failure/テーブル名の定数がない",
+			table:   "inspection_site",
+			model:   inspectionSiteModel,
+			wantErr: "テーブル inspection_site のモデルがありません",
+		},
+		{
+			name:  "failure/複合主キー",
+			table: "areas",
+			model: "package model\n\nconst TableNameArea = \"areas\"\n\ntype Area struct {\n" +
+				"\tPrefectureCode string `gorm:\"column:prefecture_code;primaryKey\"`\n" +
+				"\tCode string `gorm:\"column:code;primaryKey\"`\n}\n",
+			wantErr: "テーブル areas の主キーは1列である必要があります（2列）",
+		},
+		{
+			name:  "failure/intの主キー",
+			table: "areas",
+			model: "package model\n\nconst TableNameArea = \"areas\"\n\ntype Area struct {\n" +
+				"\tID int `gorm:\"column:id;primaryKey\"`\n}\n",
+			wantErr: "テーブル areas の主キー id は整数である必要があります: int",
+		},
+		{
+			name:  "failure/文字列の主キー",
+			table: "prefectures",
+			model: "package model\n\nconst TableNamePrefecture = \"prefectures\"\n\ntype Prefecture struct {\n" +
+				"\tCode string `gorm:\"column:code;primaryKey\"`\n}\n",
+			wantErr: "テーブル prefectures の主キー code は整数である必要があります: string",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeModel(t, dir, tt.table, tt.model)
+
+			got, err := scaffold.Load(dir, tt.table, "点検箇所")
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Load() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("failure/モデルのファイルがない", func(t *testing.T) {
+		_, err := scaffold.Load(t.TempDir(), "inspection_sites", "点検箇所")
+		assert.ErrorContains(t, err, "モデルを読み込めませんでした。ggen generate でモデルを生成してください")
+	})
+}
+
+func TestSlice_Names(t *testing.T) {
+	tests := []struct {
+		name  string
+		slice *scaffold.Slice
+		want  []string
+	}{
+		{
+			name:  "Success/複数の単語",
+			slice: &scaffold.Slice{Table: "damage_reports", Model: "DamageReport"},
+			want:  []string{"DamageReports", "damageReport", "damageReports", "damage_report", "damage report", "damage reports", "damage-reports"},
+		},
+		{
+			name:  "Success/不規則な複数形",
+			slice: &scaffold.Slice{Table: "work_categories", Model: "WorkCategory"},
+			want:  []string{"WorkCategories", "workCategory", "workCategories", "work_category", "work category", "work categories", "work-categories"},
+		},
+		{
+			name:  "Success/頭字語で始まる",
+			slice: &scaffold.Slice{Table: "url_mappings", Model: "URLMapping"},
+			want:  []string{"URLMappings", "urlMapping", "urlMappings", "url_mapping", "url mapping", "url mappings", "url-mappings"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := tt.slice
+			got := []string{s.Plural(), s.Var(), s.PluralVar(), s.FileName(), s.Words(), s.PluralWords(), s.Path()}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("names mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
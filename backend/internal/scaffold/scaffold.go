@@ -0,0 +1,138 @@
+package scaffold
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+var templates = template.Must(template.ParseFS(templateFS, "templates/*.tmpl"))
+
+// Result 生成・更新したファイル（backendディレクトリからの相対パス）
+type Result struct {
+	Created []string
+	Updated []string
+}
+
+// target 生成するファイルとテンプレート
+type target struct {
+	path     string
+	template string
+}
+
+func (s *Slice) targets() []target {
+	name := s.FileName()
+
+	return []target{
+		{path: filepath.Join("internal/domain/repository", name+".go"), template: "repository.go.tmpl"},
+		{path: filepath.Join("internal/infra/datastore", name+"_repository.go"), template: "datastore.go.tmpl"},
+		{path: filepath.Join("internal/infra/datastore", name+"_repository_test.go"), template: "datastore_test.go.tmpl"},
+		{path: filepath.Join("internal/usecase", name+"_usecase.go"), template: "usecase.go.tmpl"},
+		{path: filepath.Join("internal/usecase", name+"_usecase_test.go"), template: "usecase_test.go.tmpl"},
+		{path: filepath.Join("internal/handler", name+"_handler.go"), template: "handler.go.tmpl"},
+		{path: filepath.Join("internal/handler", name+"_handler_test.go"), template: "handler_test.go.tmpl"},
+	}
+}
+
+// Generate テーブルのリポジトリ・データストア・ユースケース・ハンドラーとそのテストを生成し、
+// エラーコード・404のエラーレスポンス・DIのプロバイダー・ルートに登録する
+// 一覧取得と詳細取得のパスはAPIの仕様に追加し、ハンドラーの型とインターフェースを仕様から生成し直す
+// 登録済みのファイルは変更しない。生成するファイルが既にある場合は、forceを指定しない限りエラーにする
+func Generate(dir string, s *Slice, force bool) (*Result, error) {
+	files := make(map[string][]byte)
+	result := &Result{}
+	var exists []string
+	for _, t := range s.targets() {
+		src, err := render(t.template, s)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", t.path, err)
+		}
+
+		if _, err := os.Stat(filepath.Join(dir, t.path)); err == nil {
+			exists = append(exists, t.path)
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+
+		files[t.path] = src
+		result.Created = append(result.Created, t.path)
+	}
+	if len(exists) > 0 && !force {
+		return nil, fmt.Errorf("既にファイルがあります（-force で上書きします）: %v", exists)
+	}
+
+	// 既存のファイルの編集は、すべて成功してから書き込む
+	for _, e := range editors {
+		src, err := os.ReadFile(filepath.Join(dir, e.path))
+		if err != nil {
+			return nil, err
+		}
+
+		out, err := applyEdit(e.path, src, e.edit, s)
+		if err != nil {
+			return nil, err
+		}
+		if out == nil {
+			continue
+		}
+
+		files[e.path] = out
+		result.Updated = append(result.Updated, e.path)
+	}
+
+	spec, generated, err := editOpenAPI(dir, s)
+	if err != nil {
+		return nil, err
+	}
+	if spec != nil {
+		files[specFile] = spec
+		result.Updated = append(result.Updated, specFile)
+	}
+	if generated != nil {
+		files[openAPIFile] = generated
+		result.Updated = append(result.Updated, openAPIFile)
+	}
+
+	for path, src := range files {
+		path = filepath.Join(dir, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return nil, err
+		}
+
+		if err := os.WriteFile(path, src, 0o644); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// render テンプレートを実行し、gofmtで整形する
+func render(name string, data any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := templates.ExecuteTemplate(&buf, name, data); err != nil {
+		return nil, err
+	}
+
+	// 断片のテンプレートは挿入後にファイル全体を整形する
+	if !strings.HasSuffix(name, ".go.tmpl") {
+		return buf.Bytes(), nil
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("生成したコードを整形できませんでした: %w", err)
+	}
+
+	return src, nil
+}
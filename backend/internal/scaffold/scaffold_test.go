@@ -0,0 +1,330 @@
+package scaffold_test
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+
+	"g_gen/internal/scaffold"
+)
+
+// registrationFiles 縦割りを登録する既存のファイル
+var registrationFiles = []string{
+	"internal/errors/error.go",
+	"internal/handler/error_response.go",
+	"internal/di/provider.go",
+	"internal/server/route.go",
+	"api/openapi.yaml",
+	"internal/handler/openapi.gen.go",
+}
+
+// setupBackend 登録先のファイルのフィクスチャを一時ディレクトリに複製する
+// 縦割りを追加しても結果が変わらないよう、リポジトリのファイルではなく testdata/backend を使う
+func setupBackend(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for _, path := range registrationFiles {
+		copyFile(t, filepath.Join("testdata/backend", path), filepath.Join(dir, path))
+	}
+	writeModel(t, dir, "inspection_sites", inspectionSiteModel)
+
+	return dir
+}
+
+// copyBackend 型検査のため、backendディレクトリ全体を一時ディレクトリに複製する
+func copyBackend(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	err := filepath.WalkDir("../..", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel("../..", path)
+		if err != nil {
+			return err
+		}
+		copyFile(t, path, filepath.Join(dir, rel))
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	return dir
+}
+
+func copyFile(t *testing.T, src, dst string) {
+	t.Helper()
+
+	b, err := os.ReadFile(src)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Dir(dst), 0o755))
+	require.NoError(t, os.WriteFile(dst, b, 0o600))
+}
+
+// queryGenerator モデルからクエリを生成するプログラム。%s に生成するモデルを入れる
+const queryGenerator = `package main
+
+import (
+	"gorm.io/gen"
+
+	"g_gen/internal/domain/model"
+)
+
+func main() {
+	g := gen.NewGenerator(gen.Config{
+		OutPath:           "./querygen/query",
+		Mode:              gen.WithoutContext | gen.WithDefaultQuery | gen.WithQueryInterface,
+		FieldWithIndexTag: true,
+		FieldWithTypeTag:  true,
+		FieldNullable:     true,
+	})
+	g.ApplyBasic(%s)
+	g.Execute()
+}
+`
+
+// generateQuery 追加したモデルのクエリを生成する
+// 既存のテーブルのクエリはリレーションの設定によって変わるため、追加したテーブルのクエリと全テーブルを束ねる gen.go だけを置き換える
+func generateQuery(t *testing.T, dir, table string) {
+	t.Helper()
+
+	paths, err := filepath.Glob(filepath.Join(dir, "internal/domain/model/*.gen.go"))
+	require.NoError(t, err)
+
+	var models []string
+	for _, path := range paths {
+		for _, m := range regexp.MustCompile(`(?m)^type (\w+) struct`).FindAllStringSubmatch(readFile(t, "", path), -1) {
+			models = append(models, "model."+m[1]+"{}")
+		}
+	}
+
+	main := filepath.Join(dir, "querygen/main.go")
+	require.NoError(t, os.MkdirAll(filepath.Dir(main), 0o755))
+	require.NoError(t, os.WriteFile(main, fmt.Appendf(nil, queryGenerator, strings.Join(models, ", ")), 0o600))
+
+	cmd := exec.Command("go", "run", "./querygen")
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	for _, name := range []string{"gen.go", table + ".gen.go"} {
+		copyFile(t, filepath.Join(dir, "querygen/query", name), filepath.Join(dir, "internal/domain/query", name))
+	}
+	require.NoError(t, os.RemoveAll(filepath.Join(dir, "querygen")))
+}
+
+func readFile(t *testing.T, dir, path string) string {
+	t.Helper()
+
+	src, err := os.ReadFile(filepath.Join(dir, path))
+	require.NoError(t, err)
+
+	return string(src)
+}
+
+// TestRouteFixture 登録先のルートのフィクスチャがリポジトリの route.go と一致することを確認する
+// 一致しない場合は internal/server/route.go を testdata/backend に複製する
+func TestRouteFixture(t *testing.T) {
+	assert.Equal(t, readFile(t, "../..", "internal/server/route.go"), readFile(t, "testdata/backend", "internal/server/route.go"))
+}
+
+func TestGenerate(t *testing.T) {
+	created := []string{
+		"internal/domain/repository/inspection_site.go",
+		"internal/infra/datastore/inspection_site_repository.go",
+		"internal/infra/datastore/inspection_site_repository_test.go",
+		"internal/usecase/inspection_site_usecase.go",
+		"internal/usecase/inspection_site_usecase_test.go",
+		"internal/handler/inspection_site_handler.go",
+		"internal/handler/inspection_site_handler_test.go",
+	}
+
+	t.Run("Success/縦割りを生成して登録する", func(t *testing.T) {
+		dir := setupBackend(t)
+		s, err := scaffold.Load(dir, "inspection_sites", "点検箇所")
+		require.NoError(t, err)
+
+		got, err := scaffold.Generate(dir, s, false)
+		require.NoError(t, err)
+
+		want := &scaffold.Result{Created: created, Updated: registrationFiles}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Generate() mismatch (-want +got):\n%s", diff)
+		}
+
+		for _, path := range append(created, registrationFiles...) {
+			if filepath.Ext(path) != ".go" {
+				continue
+			}
+
+			_, err := parser.ParseFile(token.NewFileSet(), path, readFile(t, dir, path), parser.AllErrors)
+			assert.NoError(t, err, path)
+		}
+
+		checks := map[string][]string{
+			"internal/domain/repository/inspection_site.go": {
+				`//go:generate mockgen -source=inspection_site.go -destination=../../../tests/mock/domain/inspection_site.mock.go`,
+				`FindAll\(ctx context.Context, params pagination.Params\) \(\[\]\*model.InspectionSite, pagination.Meta, error\)`,
+				`FindByID\(ctx context.Context, id int\) \(\*model.InspectionSite, error\)`,
+			},
+			"internal/infra/datastore/inspection_site_repository.go": {
+				`"id": int64SortColumn\(g.ID, func\(row \*model.InspectionSite\) int64 \{ return row.ID \}\),`,
+				`Limit\(params.PerPage \+ 1\)`,
+				`Where\(r.query.InspectionSite.ID.Eq\(int64\(id\)\)\)`,
+				`Code:    myerrors.InspectionSiteNotFoundError,`,
+			},
+			"internal/usecase/inspection_site_usecase.go": {
+				`//go:generate mockgen -source=inspection_site_usecase.go -destination=../../tests/mock/usecase/inspection_site_usecase.mock.go`,
+			},
+			"internal/handler/inspection_site_handler.go": {
+				`\) InspectionSiteHandler \{`,
+				`var query ListInspectionSitesQueryParams`,
+				`h.inspectionSiteUseCase.ListInspectionSites\(c.Request.Context\(\), params\)`,
+				`Pagination: writePaginationHeaders\(c, meta, h.cursorCodec\),`,
+				`// @Router /inspection-sites/\{id\} \[get\]`,
+				`var uri GetInspectionSitePathParams`,
+				`setLastModified\(c, inspectionSite.UpdatedAt\)`,
+			},
+			"internal/errors/error.go": {
+				`InspectionSiteNotFoundError +ErrorCode = "E100009" // 点検箇所が存在しないエラー`,
+				`InspectionSiteNotFoundErrorMessage +ErrorMessage = "点検箇所は存在しません"`,
+			},
+			"internal/handler/error_response.go": {
+				`myerrors.WorkCategoryNotFoundError,\n\t+myerrors.InspectionSiteNotFoundError:`,
+			},
+			"internal/di/provider.go": {
+				`func ProvideInspectionSiteHandler\(`,
+				`ProvideWorkCategoryHandler,\n\t+ProvideInspectionSiteRepository,\n\t+ProvideInspectionSiteUseCase,\n\t+ProvideInspectionSiteHandler,\n`,
+				`return handler.NewInspectionSiteHandler\(l, inspectionSiteUseCase, cursorCodec\)`,
+				`\}\n\n// ProvideInspectionSiteRepository creates a new inspection site repository\n`,
+			},
+			"internal/server/route.go": {
+				`inspectionSiteHandler handler.InspectionSiteHandler,\n\) \{`,
+				`damageReportAttachmentHandler\)\n\n\t// 点検箇所関連のルート（api/openapi.yaml から生成）\n\thandler.RegisterInspectionSiteRoutes\(r, inspectionSiteHandler\)\n\n\t// Swagger JSON`,
+			},
+			"api/openapi.yaml": {
+				`    description: 工種区分\n  - name: inspection-sites\n    description: 点検箇所\npaths:\n`,
+				`\n  /inspection-sites:\n    get:\n      operationId: ListInspectionSites\n`,
+				`description: ソート条件（id。降順は先頭に-）`,
+				`\n  /inspection-sites/\{id\}:\n    get:\n      operationId: GetInspectionSite\n`,
+				`\$ref: "#/components/headers/LastModified"`,
+				`\ncomponents:\n`,
+				`      required: \[id, site_name, inspected_on, extra\]\n`,
+				`        inspected_on:\n          type: string\n          format: date-time\n          nullable: true\n`,
+				`        extra:\n          x-go-type: "datatypes.JSON"\n          x-go-import: gorm.io/datatypes\n`,
+				`    InspectionSiteListResponse:\n`,
+			},
+			"internal/handler/openapi.gen.go": {
+				`(?m)^\t"time"$`,
+				`(?m)^\t"gorm.io/datatypes"$`,
+				`InspectedOn \*time.Time +` + "`" + `json:"inspected_on"` + "`",
+				`Extra +datatypes.JSON +` + "`" + `json:"extra"` + "`",
+				`Items +\[\]\*InspectionSiteResponse +` + "`" + `json:"items"` + "`",
+				`Sort string ` + "`" + `form:"sort" binding:"omitempty,sort" ja:"ソート条件"` + "`",
+				`ID int ` + "`" + `uri:"id" binding:"required,min=1" ja:"点検箇所ID"` + "`",
+				`func RegisterInspectionSiteRoutes\(r gin.IRoutes, h InspectionSiteHandler\) \{\n\tr.GET\("/inspection-sites", h.ListInspectionSites\)\n\tr.GET\("/inspection-sites/:id", h.GetInspectionSite\)\n\}`,
+			},
+		}
+		for path, patterns := range checks {
+			src := readFile(t, dir, path)
+			for _, pattern := range patterns {
+				assert.Regexp(t, regexp.MustCompile(pattern), src, path)
+			}
+		}
+	})
+
+	t.Run("Success/生成したコードは既存のコードと合わせて型検査を通る", func(t *testing.T) {
+		if testing.Short() {
+			t.Skip("依存パッケージをコンパイルするため -short では省略する")
+		}
+
+		dir := copyBackend(t)
+		// 生成したコードの型だけを検査するため、モデルにないリレーションは除く
+		writeModel(t, dir, "inspection_sites", regexp.MustCompile(`(?m)^\tPhotos .*\n`).ReplaceAllString(inspectionSiteModel, ""))
+		generateQuery(t, dir, "inspection_sites")
+
+		s, err := scaffold.Load(dir, "inspection_sites", "点検箇所")
+		require.NoError(t, err)
+
+		_, err = scaffold.Generate(dir, s, false)
+		require.NoError(t, err)
+
+		// テストはモックの生成が必要なため、本体のコードだけを検査する
+		pkgs, err := packages.Load(&packages.Config{
+			Mode: packages.NeedName | packages.NeedImports | packages.NeedDeps | packages.NeedTypes,
+			Dir:  dir,
+		},
+			"./internal/errors",
+			"./internal/domain/repository",
+			"./internal/infra/datastore",
+			"./internal/usecase",
+			"./internal/handler",
+			"./internal/di",
+			"./internal/server",
+		)
+		require.NoError(t, err)
+
+		packages.Visit(pkgs, nil, func(p *packages.Package) {
+			for _, e := range p.Errors {
+				t.Errorf("%s: %s", p.PkgPath, e)
+			}
+		})
+	})
+
+	t.Run("Success/登録済みのファイルは変更しない", func(t *testing.T) {
+		dir := setupBackend(t)
+		s, err := scaffold.Load(dir, "inspection_sites", "点検箇所")
+		require.NoError(t, err)
+
+		_, err = scaffold.Generate(dir, s, false)
+		require.NoError(t, err)
+		provider := readFile(t, dir, "internal/di/provider.go")
+
+		got, err := scaffold.Generate(dir, s, true)
+		require.NoError(t, err)
+		assert.Equal(t, created, got.Created)
+		assert.Empty(t, got.Updated)
+		assert.Equal(t, provider, readFile(t, dir, "internal/di/provider.go"))
+	})
+
+	t.Run("failure/生成するファイルが既にある", func(t *testing.T) {
+		dir := setupBackend(t)
+		s, err := scaffold.Load(dir, "inspection_sites", "点検箇所")
+		require.NoError(t, err)
+
+		_, err = scaffold.Generate(dir, s, false)
+		require.NoError(t, err)
+
+		_, err = scaffold.Generate(dir, s, false)
+		assert.ErrorContains(t, err, "既にファイルがあります（-force で上書きします）")
+	})
+
+	t.Run("failure/登録先が見つからない場合は何も書き込まない", func(t *testing.T) {
+		dir := setupBackend(t)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "internal/server/route.go"), []byte("package server\n"), 0o600))
+		s, err := scaffold.Load(dir, "inspection_sites", "点検箇所")
+		require.NoError(t, err)
+
+		_, err = scaffold.Generate(dir, s, false)
+		assert.EqualError(t, err, "internal/server/route.go: RegisterRoutes がありません")
+
+		_, err = os.Stat(filepath.Join(dir, "internal/handler/inspection_site_handler.go"))
+		assert.ErrorIs(t, err, os.ErrNotExist)
+		assert.NotContains(t, readFile(t, dir, "internal/errors/error.go"), "InspectionSite")
+	})
+}
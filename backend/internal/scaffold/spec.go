@@ -0,0 +1,200 @@
+package scaffold
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"g_gen/internal/openapigen"
+)
+
+// APIの仕様と、仕様から ggen openapi で生成するハンドラーの型（backendディレクトリからの相対パス）
+const (
+	specFile    = "api/openapi.yaml"
+	openAPIFile = "internal/handler/openapi.gen.go"
+)
+
+// Schema レスポンスのプロパティのスキーマ
+type Schema struct {
+	Type     string
+	Format   string
+	Nullable bool
+	// GoType OpenAPIの型に対応しない列の型（x-go-type）
+	GoType string
+	// GoImport GoType が参照するパッケージ（x-go-import）
+	GoImport string
+	// GoName 既定のフィールド名（JSONの名前をキャメルケースにしたもの）とモデルのフィールド名が異なる場合のフィールド名（x-go-name）
+	GoName string
+}
+
+// Schema 列の型をレスポンスのプロパティのスキーマにする。ポインタはnullableにする
+func (f *Field) Schema() *Schema {
+	typ, nullable := strings.CutPrefix(f.Type, "*")
+	schema := &Schema{Nullable: nullable}
+	switch typ {
+	case "int32", "int64":
+		schema.Type, schema.Format = "integer", typ
+	case "int":
+		schema.Type = "integer"
+	case "float64":
+		schema.Type = "number"
+	case "float32":
+		schema.Type, schema.Format = "number", "float"
+	case "string":
+		schema.Type = "string"
+	case "bool":
+		schema.Type = "boolean"
+	case "time.Time":
+		schema.Type, schema.Format = "string", "date-time"
+	default:
+		schema.GoType, schema.GoImport = typ, f.Import
+	}
+
+	if openapigen.GoName(f.JSON) != f.Name {
+		schema.GoName = f.Name
+	}
+
+	return schema
+}
+
+// HandlerGoName 既定のインターフェース名（タグ名の単数形）がモデル名と一致しない場合のインターフェース名（x-go-name）
+func (s *Slice) HandlerGoName() string {
+	name := s.Model + "Handler"
+	if openapigen.HandlerName(s.Path()) == name {
+		return ""
+	}
+
+	return name
+}
+
+// editOpenAPI 仕様にタグ・一覧取得と詳細取得のパス・レスポンスのスキーマを追加し、ハンドラーの型とインターフェースを生成し直す
+// 変更しないファイルはnilを返す
+func editOpenAPI(dir string, s *Slice) (spec, generated []byte, err error) {
+	src, err := os.ReadFile(filepath.Join(dir, specFile))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	spec, err = editSpec(src, s)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", specFile, err)
+	}
+	if spec != nil {
+		src = spec
+	}
+
+	doc, err := openapigen.Parse(src)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", specFile, err)
+	}
+	out, err := openapigen.Generate(doc, openapigen.Options{Package: "handler", Source: specFile})
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", specFile, err)
+	}
+
+	current, err := os.ReadFile(filepath.Join(dir, openAPIFile))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, nil, err
+	}
+	if bytes.Equal(current, out) {
+		return spec, nil, nil
+	}
+
+	return spec, out, nil
+}
+
+// specInsertion 仕様の行の前に挿入するテンプレート
+type specInsertion struct {
+	line     int
+	template string
+}
+
+// editSpec タグ・パス・スキーマのブロックの末尾に縦割りの定義を追加する。登録済みの場合はnilを返す
+// コメントや記述順を残すため、YAMLを解析し直さずに行を挿入する
+func editSpec(src []byte, s *Slice) ([]byte, error) {
+	doc, err := openapigen.Parse(src)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range doc.Paths {
+		if p.Name == "/"+s.Path() {
+			return nil, nil
+		}
+	}
+
+	lines := strings.SplitAfter(string(src), "\n")
+	_, tags, ok := block(lines, "tags", 0, 0)
+	if !ok {
+		return nil, fmt.Errorf("tags がありません")
+	}
+	_, paths, ok := block(lines, "paths", 0, 0)
+	if !ok {
+		return nil, fmt.Errorf("paths がありません")
+	}
+	components, componentsEnd, ok := block(lines, "components", 0, 0)
+	if !ok {
+		return nil, fmt.Errorf("components がありません")
+	}
+	schemasStart, schemas, ok := block(lines, "schemas", 2, components)
+	if !ok || schemasStart >= componentsEnd {
+		return nil, fmt.Errorf("components.schemas がありません")
+	}
+
+	insertions := []specInsertion{
+		{line: tags, template: "openapi.tag"},
+		{line: paths, template: "openapi.paths"},
+		{line: schemas, template: "openapi.schemas"},
+	}
+	// 後ろから挿入し、前の挿入位置がずれないようにする
+	slices.SortStableFunc(insertions, func(a, b specInsertion) int { return b.line - a.line })
+
+	for _, ins := range insertions {
+		text, err := render(ins.template, s)
+		if err != nil {
+			return nil, err
+		}
+
+		if last := ins.line - 1; !strings.HasSuffix(lines[last], "\n") {
+			lines[last] += "\n"
+		}
+		lines = slices.Insert(lines, ins.line, string(text))
+	}
+
+	return []byte(strings.Join(lines, "")), nil
+}
+
+// block from 以降の行から深さ indent のキーのブロックを探し、キーの行番号とブロックの最後の行の次の行番号を返す
+// ブロックの末尾の空行とコメントはブロックに含めない
+func block(lines []string, key string, indent, from int) (start, end int, ok bool) {
+	header := strings.Repeat(" ", indent) + key + ":"
+	start = slices.IndexFunc(lines[from:], func(line string) bool {
+		return strings.TrimRight(line, " \r\n") == header
+	})
+	if start < 0 {
+		return 0, 0, false
+	}
+	start += from
+
+	end = start + 1
+	for i := start + 1; i < len(lines); i++ {
+		line := strings.TrimRight(lines[i], " \r\n")
+		content := strings.TrimLeft(line, " ")
+		if content == "" || strings.HasPrefix(content, "#") {
+			continue
+		}
+
+		// 深さが同じ行はシーケンスの要素（インデントしない記法）の場合のみブロックに含める
+		depth := len(line) - len(content)
+		if depth < indent || depth == indent && !strings.HasPrefix(content, "- ") {
+			break
+		}
+		end = i + 1
+	}
+
+	return start, end, true
+}
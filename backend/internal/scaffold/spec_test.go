@@ -0,0 +1,78 @@
+package scaffold_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"g_gen/internal/scaffold"
+)
+
+func TestField_Schema(t *testing.T) {
+	tests := []struct {
+		name  string
+		field *scaffold.Field
+		want  *scaffold.Schema
+	}{
+		{
+			name:  "Success/整数",
+			field: &scaffold.Field{Name: "SortOrder", Type: "int32", JSON: "sort_order"},
+			want:  &scaffold.Schema{Type: "integer", Format: "int32"},
+		},
+		{
+			name:  "Success/ポインタはnullable",
+			field: &scaffold.Field{Name: "Memo", Type: "*string", JSON: "memo"},
+			want:  &scaffold.Schema{Type: "string", Nullable: true},
+		},
+		{
+			name:  "Success/日時",
+			field: &scaffold.Field{Name: "InspectedOn", Type: "*time.Time", JSON: "inspected_on", Import: "time"},
+			want:  &scaffold.Schema{Type: "string", Format: "date-time", Nullable: true},
+		},
+		{
+			name:  "Success/OpenAPIの型に対応しない型は既存の型を指定",
+			field: &scaffold.Field{Name: "Extra", Type: "datatypes.JSON", JSON: "extra", Import: "gorm.io/datatypes"},
+			want:  &scaffold.Schema{GoType: "datatypes.JSON", GoImport: "gorm.io/datatypes"},
+		},
+		{
+			name:  "Success/既定の名前と異なるフィールド名",
+			field: &scaffold.Field{Name: "HTMLBody", Type: "string", JSON: "html_body"},
+			want:  &scaffold.Schema{Type: "string", GoName: "HTMLBody"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, tt.field.Schema()); diff != "" {
+				t.Errorf("Schema() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSlice_HandlerGoName(t *testing.T) {
+	tests := []struct {
+		name  string
+		slice *scaffold.Slice
+		want  string
+	}{
+		{
+			name:  "Success/タグ名の単数形と一致する場合は指定しない",
+			slice: &scaffold.Slice{Table: "inspection_sites", Model: "InspectionSite"},
+			want:  "",
+		},
+		{
+			name:  "Success/タグ名の単数形と異なる場合はモデル名",
+			slice: &scaffold.Slice{Table: "html_pages", Model: "HTMLPage"},
+			want:  "HTMLPageHandler",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.slice.HandlerGoName(); got != tt.want {
+				t.Errorf("HandlerGoName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
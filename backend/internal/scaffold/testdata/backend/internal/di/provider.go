@@ -0,0 +1,65 @@
+package di
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+
+	domain "g_gen/internal/domain/repository"
+	"g_gen/internal/env"
+	"g_gen/internal/handler"
+	"g_gen/internal/infra/datastore"
+	"g_gen/internal/infra/db"
+	"g_gen/internal/infra/logger"
+	"g_gen/internal/pagination"
+	"g_gen/internal/usecase"
+)
+
+// ProvideLogger creates a new logger instance
+func ProvideLogger() *logger.Logger {
+	return logger.New(logger.DefaultConfig())
+}
+
+// ProvideEnvValues creates a new env values instance
+func ProvideEnvValues() (*env.Values, error) {
+	return env.NewValues()
+}
+
+// ProvideWorkCategoryRepository creates a new work category repository
+func ProvideWorkCategoryRepository(dbClient db.Client) domain.WorkCategoryRepository {
+	ctx := context.Background()
+	return datastore.NewWorkCategoryRepository(ctx, dbClient)
+}
+
+// ProvideWorkCategoryUseCase creates a new work category use case
+func ProvideWorkCategoryUseCase(repo domain.WorkCategoryRepository) usecase.WorkCategoryUseCase {
+	return usecase.NewWorkCategoryUseCase(repo)
+}
+
+// ProvideWorkCategoryHandler creates a new work category handler
+func ProvideWorkCategoryHandler(
+	l *logger.Logger,
+	workCategoryUseCase usecase.WorkCategoryUseCase,
+	cursorCodec *pagination.CursorCodec,
+) handler.WorkCategoryHandler {
+	return handler.NewWorkCategoryHandler(l, workCategoryUseCase, cursorCodec)
+}
+
+// Core 環境変数・ロガーなど、APIサーバーとCLIのすべてのサブコマンドで共有する依存
+func Core() fx.Option {
+	return fx.Provide(
+		ProvideLogger,
+		ProvideEnvValues,
+	)
+}
+
+func Provider() fx.Option {
+	return fx.Options(
+		Core(),
+		fx.Provide(
+			ProvideWorkCategoryRepository,
+			ProvideWorkCategoryUseCase,
+			ProvideWorkCategoryHandler,
+		),
+	)
+}
@@ -0,0 +1,31 @@
+package myerrors
+
+type (
+	ErrorCode    string
+	ErrorMessage string
+)
+
+const (
+	SystemError                 ErrorCode = "E100000" // システムエラー
+	ValidationError             ErrorCode = "E100001"
+	PrefectureNotFoundError     ErrorCode = "E100002" // 都道府県が存在しないエラー
+	WorkCategoryNotFoundError   ErrorCode = "E100004" // 工種区分が存在しないエラー
+	InvalidStateTransitionError ErrorCode = "E100008" // 現在の状態から遷移できないエラー
+)
+
+const (
+	SystemErrorMessage                 ErrorMessage = "システムエラーが発生しました"
+	ValidationErrorMessage             ErrorMessage = "入力値に誤りがあります"
+	PrefectureNotFoundErrorMessage     ErrorMessage = "都道府県は存在しません"
+	WorkCategoryNotFoundErrorMessage   ErrorMessage = "工種区分は存在しません"
+	InvalidStateTransitionErrorMessage ErrorMessage = "現在の状態ではこの操作はできません"
+)
+
+type APIError struct {
+	Code    ErrorCode
+	Message ErrorMessage
+}
+
+func (e APIError) Error() string {
+	return string(e.Message)
+}
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"net/http"
+
+	myerrors "g_gen/internal/errors"
+)
+
+type ErrorResponse struct {
+	Code    myerrors.ErrorCode    `json:"code"`
+	Message myerrors.ErrorMessage `json:"message"`
+	status  int
+}
+
+func CreateErrResponse(err error) *ErrorResponse {
+	switch cErr := err.(type) {
+	case *myerrors.APIError:
+		switch cErr.Code {
+		case myerrors.ValidationError:
+			return &ErrorResponse{Code: cErr.Code, Message: cErr.Message, status: http.StatusBadRequest}
+		case myerrors.PrefectureNotFoundError,
+			myerrors.WorkCategoryNotFoundError:
+			return &ErrorResponse{Code: cErr.Code, Message: cErr.Message, status: http.StatusNotFound}
+		case myerrors.InvalidStateTransitionError:
+			return &ErrorResponse{Code: cErr.Code, Message: cErr.Message, status: http.StatusConflict}
+		default:
+			return &ErrorResponse{Code: cErr.Code, Message: cErr.Message, status: http.StatusInternalServerError}
+		}
+	default:
+		return &ErrorResponse{Code: myerrors.SystemError, Message: myerrors.SystemErrorMessage, status: http.StatusInternalServerError}
+	}
+}
@@ -0,0 +1,104 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/fx"
+
+	"g_gen/internal/env"
+	"g_gen/internal/handler"
+	"g_gen/internal/infra/cache"
+	"g_gen/internal/infra/db"
+	"g_gen/internal/infra/logger"
+	"g_gen/internal/server/middleware"
+)
+
+// RegisterRoutes registers all HTTP routes
+func RegisterRoutes(
+	lc fx.Lifecycle,
+	r *gin.Engine,
+	l *logger.Logger,
+	dbClient db.Client,
+	cacheRegistry *cache.Registry,
+	env *env.Values,
+	prefectureHandler handler.PrefectureHandler,
+	regionHandler handler.RegionHandler,
+	municipalityHandler handler.MunicipalityHandler,
+	workCategoryHandler handler.WorkCategoryHandler,
+	damageReportHandler handler.DamageReportHandler,
+	supportApplicationHandler handler.SupportApplicationHandler,
+	damageReportAttachmentHandler handler.DamageReportAttachmentHandler,
+	adminHandler handler.AdminHandler,
+) {
+	// Context for health check
+	ctx := context.Background()
+	// ヘルスチェックエンドポイント
+	r.GET("/health", func(c *gin.Context) {
+		if err := dbClient.Ping(ctx); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"status": "unhealthy",
+				"error":  "database ping failed",
+			})
+
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"status":   "healthy",
+			"database": "connected",
+			"caches":   cacheRegistry.Stats(),
+		})
+	})
+
+	// マスタデータはほとんど更新されないため、ETagによる条件付きGETとCache-Controlでキャッシュさせる
+	masterData := r.Group("", middleware.NewConditionalGet(env.MasterDataCacheControl))
+
+	// 都道府県・地方区分・市区町村関連のルート（api/openapi.yaml から生成）
+	handler.RegisterPrefectureRoutes(masterData, prefectureHandler)
+	handler.RegisterRegionRoutes(masterData, regionHandler)
+	handler.RegisterMunicipalityRoutes(masterData, municipalityHandler)
+
+	// 管理用のルート（api/openapi.yaml から生成）。ADMIN_API_TOKEN のBearerトークンで認証する
+	// インポート直後の状態を確認するため、条件付きGETのキャッシュは使わない
+	handler.RegisterAdminRoutes(r.Group("", middleware.NewAdminAuth(env.AdminAPIToken)), adminHandler)
+
+	// 工種区分関連のルート（api/openapi.yaml から生成）
+	handler.RegisterWorkCategoryRoutes(r.Group("", middleware.NewConditionalGet(env.WorkCategoryCacheControl)), workCategoryHandler)
+
+	// 被害報告関連のルート（api/openapi.yaml から生成）。更新されるデータのため条件付きGETのキャッシュは使わない
+	handler.RegisterDamageReportRoutes(r, damageReportHandler)
+
+	// 支援申請関連のルート（api/openapi.yaml から生成）。状態の遷移は操作ごとのエンドポイントで行う
+	// 操作できるかは利用者の役割で決まるため、役割はクライアントの指定ではなくトークンから決める
+	supportApplications := r.Group("", middleware.NewUserRole(env.RoleTokens, env.TrustUserRoleHeader && env.IsLocal()))
+	handler.RegisterSupportApplicationRoutes(supportApplications, supportApplicationHandler)
+
+	// 被害報告の添付ファイル関連のルート（api/openapi.yaml から生成）
+	handler.RegisterDamageReportAttachmentRoutes(r, damageReportAttachmentHandler)
+
+	// Swagger JSON エンドポイント
+	r.GET("/docs", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("./docs/api/swagger.json")
+	})
+
+	// Register lifecycle hooks for the HTTP server
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			go func() {
+				l.Info(fmt.Sprintf("Starting server on :%s", env.ServerPort))
+				if err := r.Run(fmt.Sprintf(":%s", env.ServerPort)); err != nil {
+					l.Error("Failed to start server", "error", err)
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			l.Info("Shutting down server")
+			return nil // Add proper cleanup if needed
+		},
+	})
+}
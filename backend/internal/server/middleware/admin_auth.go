@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/handler"
+)
+
+// bearerPrefix AuthorizationヘッダのBearerトークンの接頭辞
+const bearerPrefix = "Bearer "
+
+// NewAdminAuth 管理用APIのリクエストを、AuthorizationヘッダのBearerトークンが token と一致する場合のみ通す
+// トークンがない・一致しない場合は401、token が未設定で管理用APIを利用できない場合は403を返す
+func NewAdminAuth(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if token == "" {
+			c.AbortWithStatusJSON(http.StatusForbidden, handler.ErrorResponse{
+				Code:    myerrors.ForbiddenError,
+				Message: myerrors.ForbiddenErrorMessage,
+			})
+
+			return
+		}
+
+		// 一致するまでの時間からトークンを推測されないよう、定数時間で比較する
+		got, ok := bearerToken(c.GetHeader("Authorization"))
+		if !ok || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			c.Header("WWW-Authenticate", `Bearer realm="admin"`)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, handler.ErrorResponse{
+				Code:    myerrors.UnauthorizedError,
+				Message: myerrors.UnauthorizedErrorMessage,
+			})
+
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// bearerToken Authorizationヘッダの値からBearerトークンを取り出す
+func bearerToken(authorization string) (string, bool) {
+	if len(authorization) < len(bearerPrefix) || !strings.EqualFold(authorization[:len(bearerPrefix)], bearerPrefix) {
+		return "", false
+	}
+
+	token := strings.TrimSpace(authorization[len(bearerPrefix):])
+
+	return token, token != ""
+}
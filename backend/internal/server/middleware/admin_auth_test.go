@@ -0,0 +1,93 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"g_gen/internal/server/middleware"
+)
+
+func newAdminAuthRouter(token string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	admin := r.Group("/admin", middleware.NewAdminAuth(token))
+	admin.GET("/statistics", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"total": 1})
+	})
+
+	return r
+}
+
+func TestAdminAuth(t *testing.T) {
+	tests := []struct {
+		name             string
+		token            string
+		authorization    string
+		wantStatus       int
+		wantBody         string
+		wantAuthenticate string
+	}{
+		{
+			name:          "Success/トークンが一致する",
+			token:         "s3cr3t",
+			authorization: "Bearer s3cr3t",
+			wantStatus:    http.StatusOK,
+			wantBody:      `{"total":1}`,
+		},
+		{
+			name:          "Success/Bearerの大文字小文字は区別しない",
+			token:         "s3cr3t",
+			authorization: "bearer s3cr3t",
+			wantStatus:    http.StatusOK,
+			wantBody:      `{"total":1}`,
+		},
+		{
+			name:             "failure/Authorizationヘッダがない",
+			token:            "s3cr3t",
+			wantStatus:       http.StatusUnauthorized,
+			wantBody:         `{"code":"E100015","message":"認証が必要です"}`,
+			wantAuthenticate: `Bearer realm="admin"`,
+		},
+		{
+			name:             "failure/トークンが一致しない",
+			token:            "s3cr3t",
+			authorization:    "Bearer s3cr3",
+			wantStatus:       http.StatusUnauthorized,
+			wantBody:         `{"code":"E100015","message":"認証が必要です"}`,
+			wantAuthenticate: `Bearer realm="admin"`,
+		},
+		{
+			name:             "failure/Bearer以外の認証方式",
+			token:            "s3cr3t",
+			authorization:    "Basic czNjcjN0",
+			wantStatus:       http.StatusUnauthorized,
+			wantBody:         `{"code":"E100015","message":"認証が必要です"}`,
+			wantAuthenticate: `Bearer realm="admin"`,
+		},
+		{
+			name:          "failure/トークンが未設定の場合は空のトークンでも通さない",
+			token:         "",
+			authorization: "Bearer ",
+			wantStatus:    http.StatusForbidden,
+			wantBody:      `{"code":"E100016","message":"このAPIを利用する権限がありません"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/admin/statistics", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			newAdminAuthRouter(tt.token).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.JSONEq(t, tt.wantBody, rec.Body.String())
+			assert.Equal(t, tt.wantAuthenticate, rec.Header().Get("WWW-Authenticate"))
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// bufferedWriter レスポンスボディをクライアントに送らずに保持する
+type bufferedWriter struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
+
+// NewConditionalGet GETリクエストのレスポンスに強いETagと指定したCache-Controlを付与し、
+// If-None-Match / If-Modified-Since が一致する場合は304 Not Modifiedを返す
+// Last-Modifiedはハンドラが設定した場合のみ If-Modified-Since の判定に使う
+func NewConditionalGet(cacheControl string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet {
+			c.Next()
+			return
+		}
+
+		original := c.Writer
+		writer := &bufferedWriter{
+			ResponseWriter: original,
+			body:           &bytes.Buffer{},
+		}
+		c.Writer = writer
+
+		c.Next()
+
+		c.Writer = original
+
+		// エラーレスポンスなどはキャッシュさせずにそのまま返す
+		if original.Status() != http.StatusOK {
+			_, _ = original.Write(writer.body.Bytes())
+			return
+		}
+
+		header := original.Header()
+		if header.Get("ETag") == "" {
+			header.Set("ETag", strongETag(writer.body.Bytes()))
+		}
+
+		if cacheControl != "" {
+			header.Set("Cache-Control", cacheControl)
+		}
+
+		if notModified(c.Request, header) {
+			header.Del("Content-Type")
+			header.Del("Content-Length")
+			original.WriteHeader(http.StatusNotModified)
+			original.WriteHeaderNow()
+
+			return
+		}
+
+		_, _ = original.Write(writer.body.Bytes())
+	}
+}
+
+// strongETag レスポンスボディのSHA-256から強いETagを生成する
+func strongETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + base64.RawURLEncoding.EncodeToString(sum[:]) + `"`
+}
+
+// notModified RFC 9110 13.2.2 の順序で条件を評価する
+// If-None-Match がある場合は If-Modified-Since を無視する
+func notModified(r *http.Request, header http.Header) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		return etagMatches(inm, header.Get("ETag"))
+	}
+
+	ims := r.Header.Get("If-Modified-Since")
+	lastModified := header.Get("Last-Modified")
+	if ims == "" || lastModified == "" {
+		return false
+	}
+
+	since, err := http.ParseTime(ims)
+	if err != nil {
+		return false
+	}
+
+	modified, err := http.ParseTime(lastModified)
+	if err != nil {
+		return false
+	}
+
+	return !modified.Truncate(time.Second).After(since)
+}
+
+// etagMatches If-None-Match のいずれかのETagが一致するかを弱い比較で判定する
+func etagMatches(ifNoneMatch, etag string) bool {
+	if strings.TrimSpace(ifNoneMatch) == "*" {
+		return true
+	}
+
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,147 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"g_gen/internal/server/middleware"
+)
+
+func newConditionalGetRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	g := r.Group("", middleware.NewConditionalGet("private, max-age=300"))
+
+	updatedAt := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+	g.GET("/items/:id", func(c *gin.Context) {
+		if c.Param("id") == "0" {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"code": "E100002"})
+			return
+		}
+
+		c.Header("Last-Modified", updatedAt.Format(http.TimeFormat))
+		c.JSON(http.StatusOK, gin.H{"id": c.Param("id")})
+	})
+	g.POST("/items", func(c *gin.Context) {
+		c.JSON(http.StatusCreated, gin.H{"id": "2"})
+	})
+
+	return r
+}
+
+func TestConditionalGet(t *testing.T) {
+	r := newConditionalGetRouter()
+
+	// 最初のリクエストでETagを取得する
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/items/1", nil))
+	etag := rec.Header().Get("ETag")
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `{"id":"1"}`, rec.Body.String())
+	assert.Regexp(t, `^"[A-Za-z0-9_-]+"$`, etag)
+	assert.Equal(t, "private, max-age=300", rec.Header().Get("Cache-Control"))
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		header     map[string]string
+		wantStatus int
+		wantBody   string
+		wantETag   bool
+	}{
+		{
+			name:       "If-None-Matchが一致",
+			method:     http.MethodGet,
+			path:       "/items/1",
+			header:     map[string]string{"If-None-Match": `"other", ` + etag},
+			wantStatus: http.StatusNotModified,
+			wantETag:   true,
+		},
+		{
+			name:       "If-None-Matchが弱いETagで一致",
+			method:     http.MethodGet,
+			path:       "/items/1",
+			header:     map[string]string{"If-None-Match": "W/" + etag},
+			wantStatus: http.StatusNotModified,
+			wantETag:   true,
+		},
+		{
+			name:       "If-None-Matchが不一致",
+			method:     http.MethodGet,
+			path:       "/items/2",
+			header:     map[string]string{"If-None-Match": etag},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"id":"2"}`,
+			wantETag:   true,
+		},
+		{
+			name:       "If-Modified-Since以降に更新なし",
+			method:     http.MethodGet,
+			path:       "/items/1",
+			header:     map[string]string{"If-Modified-Since": "Tue, 01 Apr 2025 09:00:00 GMT"},
+			wantStatus: http.StatusNotModified,
+			wantETag:   true,
+		},
+		{
+			name:       "If-Modified-Since以降に更新あり",
+			method:     http.MethodGet,
+			path:       "/items/1",
+			header:     map[string]string{"If-Modified-Since": "Tue, 01 Apr 2025 08:59:59 GMT"},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"id":"1"}`,
+			wantETag:   true,
+		},
+		{
+			name:   "If-None-MatchがあればIf-Modified-Sinceは無視",
+			method: http.MethodGet,
+			path:   "/items/1",
+			header: map[string]string{
+				"If-None-Match":     `"other"`,
+				"If-Modified-Since": "Tue, 01 Apr 2025 09:00:00 GMT",
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   `{"id":"1"}`,
+			wantETag:   true,
+		},
+		{
+			name:       "エラーレスポンスにはETagを付与しない",
+			method:     http.MethodGet,
+			path:       "/items/0",
+			header:     map[string]string{"If-None-Match": "*"},
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"code":"E100002"}`,
+		},
+		{
+			name:       "GET以外は対象外",
+			method:     http.MethodPost,
+			path:       "/items",
+			wantStatus: http.StatusCreated,
+			wantBody:   `{"id":"2"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			for k, v := range tt.header {
+				req.Header.Set(k, v)
+			}
+
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			a.Equal(tt.wantStatus, rec.Code)
+			a.Equal(tt.wantBody, rec.Body.String())
+			a.Equal(tt.wantETag, rec.Header().Get("ETag") != "")
+		})
+	}
+}
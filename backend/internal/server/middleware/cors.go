@@ -11,8 +11,8 @@ func CORSMiddleware() gin.HandlerFunc {
 	config := cors.Config{
 		AllowOrigins:     []string{"*"}, // TODO: change to specific domain
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "PATCH", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization", "X-Trace-ID"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization", "X-Trace-ID", "If-None-Match", "If-Modified-Since", "X-User-Role"},
+		ExposeHeaders:    []string{"Content-Length", "Content-Disposition", "ETag", "Last-Modified", "Link", "X-Total-Count"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}
@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/handler"
+)
+
+// userRoleHeader 開発用に利用者の役割を指定するヘッダ
+const userRoleHeader = "X-User-Role"
+
+// NewUserRole 利用者の役割を認証し、ハンドラが c.Get(handler.UserRoleKey) で読み取れるようにする
+// 役割は AuthorizationヘッダのBearerトークンを tokens（トークン → 役割）から引いて決め、登録されていないトークンは401を返す
+// trustHeader はローカル環境での開発用で、トークンがない場合にクライアントが指定した X-User-Role ヘッダの役割を使う
+// 役割が決まらないリクエストもそのまま通し、役割が必要かどうかはハンドラで判定する
+func NewUserRole(tokens map[string]string, trustHeader bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if authorization := c.GetHeader("Authorization"); authorization != "" {
+			token, ok := bearerToken(authorization)
+			role, found := lookupRole(tokens, token)
+			if !ok || !found {
+				c.Header("WWW-Authenticate", `Bearer realm="api"`)
+				c.AbortWithStatusJSON(http.StatusUnauthorized, handler.ErrorResponse{
+					Code:    myerrors.UnauthorizedError,
+					Message: myerrors.UnauthorizedErrorMessage,
+				})
+
+				return
+			}
+
+			c.Set(handler.UserRoleKey, role)
+		} else if trustHeader {
+			if role := c.GetHeader(userRoleHeader); role != "" {
+				c.Set(handler.UserRoleKey, role)
+			}
+		}
+
+		c.Next()
+	}
+}
+
+// lookupRole トークンに対応する役割を探す
+// 一致するまでの時間からトークンを推測されないよう、すべてのトークンと定数時間で比較する
+func lookupRole(tokens map[string]string, token string) (string, bool) {
+	var role string
+	found := false
+	for t, r := range tokens {
+		if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+			role = r
+			found = true
+		}
+	}
+
+	return role, found
+}
@@ -0,0 +1,320 @@
+//go:generate mockgen -source=damage_report_attachment_usecase.go -destination=../../tests/mock/usecase/damage_report_attachment_usecase.mock.go
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"g_gen/internal/domain/model"
+	domain "g_gen/internal/domain/repository"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/pagination"
+	"g_gen/internal/photo"
+)
+
+// attachmentContentTypes 添付できるファイルの種類。ファイル名やリクエストのContent-Typeではなく、ファイルの内容から判定した種類で確認する
+var attachmentContentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/webp":      true,
+	"application/pdf": true,
+}
+
+// attachmentFileNameMaxLength ファイル名の最大文字数（damage_report_attachments.file_name の桁数）
+const attachmentFileNameMaxLength = 255
+
+// 一覧に表示するサムネイルの大きさ
+const (
+	thumbnailWidth  = 320
+	thumbnailHeight = 240
+)
+
+// thumbnailKeySuffix サムネイルは元の写真のキーにこれをつけたキーで保存する
+const thumbnailKeySuffix = "-thumbnail.jpg"
+
+type DamageReportAttachmentUseCase interface {
+	ListAttachments(
+		ctx context.Context,
+		damageReportID int,
+		params pagination.Params,
+	) ([]*model.DamageReportAttachment, pagination.Meta, error)
+	UploadAttachment(
+		ctx context.Context,
+		damageReportID int,
+		fileName string,
+		size int64,
+		body io.ReadSeeker,
+	) (*model.DamageReportAttachment, error)
+	// DownloadAttachment 添付ファイルの情報と本体を取得する。本体は呼び出し側で閉じること
+	DownloadAttachment(ctx context.Context, damageReportID, id int) (*model.DamageReportAttachment, io.ReadCloser, error)
+	// DownloadThumbnail 添付された写真のサムネイル（JPEG）を取得する。呼び出し側で閉じること
+	DownloadThumbnail(ctx context.Context, damageReportID, id int) (io.ReadCloser, error)
+}
+
+type damageReportAttachmentUseCase struct {
+	attachmentRepository   domain.DamageReportAttachmentRepository
+	damageReportRepository domain.DamageReportRepository
+	fileStorage            domain.FileStorage
+	taskRunner             domain.TaskRunner
+	maxSize                int64
+}
+
+// NewDamageReportAttachmentUseCase maxSize は添付できるファイルの最大サイズ（バイト）
+// 写真の処理（EXIFの読み取り・サムネイルの作成）は taskRunner でレスポンスの後に行う
+func NewDamageReportAttachmentUseCase(
+	attachmentRepository domain.DamageReportAttachmentRepository,
+	damageReportRepository domain.DamageReportRepository,
+	fileStorage domain.FileStorage,
+	taskRunner domain.TaskRunner,
+	maxSize int64,
+) DamageReportAttachmentUseCase {
+	return &damageReportAttachmentUseCase{
+		attachmentRepository:   attachmentRepository,
+		damageReportRepository: damageReportRepository,
+		fileStorage:            fileStorage,
+		taskRunner:             taskRunner,
+		maxSize:                maxSize,
+	}
+}
+
+// ListAttachments 被害報告の添付ファイルを1ページ分取得する。並び順の指定がない場合は登録した順とする
+func (u *damageReportAttachmentUseCase) ListAttachments(
+	ctx context.Context,
+	damageReportID int,
+	params pagination.Params,
+) ([]*model.DamageReportAttachment, pagination.Meta, error) {
+	if _, err := u.damageReportRepository.FindByID(ctx, damageReportID); err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	return u.attachmentRepository.FindByDamageReportID(ctx, damageReportID, params)
+}
+
+// UploadAttachment ファイルの種類とサイズを検証してストレージに保存し、添付ファイルの情報を登録する
+// 情報の登録に失敗した場合は、保存したファイルを削除する。写真の場合は、登録した後にバックグラウンドで processPhoto を行う
+func (u *damageReportAttachmentUseCase) UploadAttachment(
+	ctx context.Context,
+	damageReportID int,
+	fileName string,
+	size int64,
+	body io.ReadSeeker,
+) (*model.DamageReportAttachment, error) {
+	if _, err := u.damageReportRepository.FindByID(ctx, damageReportID); err != nil {
+		return nil, err
+	}
+
+	if err := u.validateSize(size); err != nil {
+		return nil, err
+	}
+
+	contentType, err := detectAttachmentContentType(body)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := &model.DamageReportAttachment{
+		DamageReportID: int64(damageReportID),
+		FileName:       attachmentFileName(fileName),
+		ContentType:    contentType,
+		Size:           size,
+		StorageKey:     fmt.Sprintf("damage-reports/%d/%s", damageReportID, uuid.NewString()),
+	}
+	if err := u.fileStorage.Put(ctx, attachment.StorageKey, body, size, contentType); err != nil {
+		return nil, err
+	}
+
+	if err := u.attachmentRepository.Create(ctx, attachment); err != nil {
+		return nil, errors.Join(err, u.fileStorage.Delete(ctx, attachment.StorageKey))
+	}
+
+	if strings.HasPrefix(contentType, "image/") {
+		// 返した添付ファイルはレスポンスに使うため、コピーを渡す
+		photoAttachment := *attachment
+		u.taskRunner.Go(ctx, "process photo", func(ctx context.Context) error {
+			return u.processPhoto(ctx, &photoAttachment)
+		})
+	}
+
+	return attachment, nil
+}
+
+func (u *damageReportAttachmentUseCase) DownloadAttachment(
+	ctx context.Context,
+	damageReportID, id int,
+) (*model.DamageReportAttachment, io.ReadCloser, error) {
+	attachment, err := u.attachmentRepository.FindByID(ctx, damageReportID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body, err := u.fileStorage.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attachment, body, nil
+}
+
+// DownloadThumbnail サムネイルを作成する前と、写真以外の添付ファイルはサムネイルが存在しない
+func (u *damageReportAttachmentUseCase) DownloadThumbnail(
+	ctx context.Context,
+	damageReportID, id int,
+) (io.ReadCloser, error) {
+	attachment, err := u.attachmentRepository.FindByID(ctx, damageReportID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if attachment.ThumbnailKey == nil {
+		return nil, myerrors.NewAPIError(
+			myerrors.ThumbnailNotFoundError,
+			myerrors.ThumbnailNotFoundErrorMessage,
+			fmt.Errorf("attachment %d has no thumbnail", id),
+			"thumbnail not found",
+		)
+	}
+
+	return u.fileStorage.Get(ctx, *attachment.ThumbnailKey)
+}
+
+// processPhoto 添付された写真のEXIFから撮影日時・撮影位置を読み取り、サムネイルを作成して添付ファイルの情報を更新する
+// 読み取った撮影日時・撮影位置で、作成中の被害報告の被災日・位置を補う
+// EXIFが壊れている場合も、向きはそのままとしてサムネイルを作成し、読み取りのエラーを返す
+func (u *damageReportAttachmentUseCase) processPhoto(ctx context.Context, attachment *model.DamageReportAttachment) error {
+	body, err := u.fileStorage.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	// EXIFの読み取りとサムネイルの作成で何度か読むため、メモリに読み込む
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+
+	// EXIFはJPEGの写真からだけ読み取る
+	metadata := &photo.Metadata{Orientation: 1}
+
+	var metadataErr error
+	if attachment.ContentType == "image/jpeg" {
+		if m, err := photo.ReadMetadata(bytes.NewReader(data)); err != nil {
+			metadataErr = fmt.Errorf("read exif of attachment %d: %w", attachment.ID, err)
+		} else {
+			metadata = m
+		}
+	}
+
+	var thumbnail bytes.Buffer
+	if err := photo.Thumbnail(&thumbnail, bytes.NewReader(data), metadata.Orientation, thumbnailWidth, thumbnailHeight); err != nil {
+		return errors.Join(metadataErr, err)
+	}
+
+	thumbnailKey := attachment.StorageKey + thumbnailKeySuffix
+	if err := u.fileStorage.Put(
+		ctx,
+		thumbnailKey,
+		bytes.NewReader(thumbnail.Bytes()),
+		int64(thumbnail.Len()),
+		"image/jpeg",
+	); err != nil {
+		return errors.Join(metadataErr, err)
+	}
+
+	attachment.CapturedAt = metadata.CapturedAt
+	attachment.Latitude = metadata.Latitude
+	attachment.Longitude = metadata.Longitude
+	attachment.ThumbnailKey = &thumbnailKey
+	if err := u.attachmentRepository.UpdatePhotoMetadata(ctx, attachment); err != nil {
+		return errors.Join(metadataErr, err, u.fileStorage.Delete(ctx, thumbnailKey))
+	}
+
+	if metadata.Latitude == nil || metadata.Longitude == nil {
+		return metadataErr
+	}
+
+	return errors.Join(metadataErr, u.damageReportRepository.PrefillFromPhoto(
+		ctx,
+		int(attachment.DamageReportID),
+		*metadata.Latitude,
+		*metadata.Longitude,
+	))
+}
+
+// validateSize 空のファイルと最大サイズを超えるファイルを拒否する
+func (u *damageReportAttachmentUseCase) validateSize(size int64) error {
+	if size <= 0 {
+		return myerrors.NewAPIError(
+			myerrors.ValidationError,
+			myerrors.ValidationErrorMessage,
+			errors.New("attachment is empty"),
+			"invalid attachment size",
+		)
+	}
+
+	if size > u.maxSize {
+		return myerrors.NewAPIError(
+			myerrors.AttachmentTooLargeError,
+			myerrors.AttachmentTooLargeErrorMessage,
+			fmt.Errorf("attachment is %d bytes, limit is %d bytes", size, u.maxSize),
+			"attachment too large",
+		)
+	}
+
+	return nil
+}
+
+// detectAttachmentContentType ファイルの先頭から種類を判定し、添付できる種類か検証する
+// 判定のために読んだ分は先頭に戻す
+func detectAttachmentContentType(body io.ReadSeeker) (string, error) {
+	// http.DetectContentType は先頭512バイトまでしか見ない
+	head := make([]byte, 512)
+	n, err := io.ReadFull(body, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	// "text/plain; charset=utf-8" のようなパラメータを除く
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(head[:n]))
+	if err != nil {
+		return "", err
+	}
+
+	if !attachmentContentTypes[contentType] {
+		return "", myerrors.NewAPIError(
+			myerrors.UnsupportedAttachmentTypeError,
+			myerrors.UnsupportedAttachmentTypeErrorMessage,
+			fmt.Errorf("attachment content type %s is not allowed", contentType),
+			"unsupported attachment type",
+		)
+	}
+
+	return contentType, nil
+}
+
+// attachmentFileName クライアントが送ったファイル名からディレクトリを除き、保存できる長さに切り詰める
+func attachmentFileName(name string) string {
+	name = path.Base(strings.ReplaceAll(name, `\`, "/"))
+	if name == "." || name == "/" {
+		return "attachment"
+	}
+
+	if runes := []rune(name); len(runes) > attachmentFileNameMaxLength {
+		return string(runes[:attachmentFileNameMaxLength])
+	}
+
+	return name
+}
@@ -0,0 +1,565 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"g_gen/internal/domain/model"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/pagination"
+	"g_gen/internal/usecase"
+	mockdomain "g_gen/tests/mock/domain"
+)
+
+// damageReportAttachmentMocks 添付ファイルのユースケースが利用するリポジトリ・ストレージ・バックグラウンド処理のモック
+type damageReportAttachmentMocks struct {
+	attachment   *mockdomain.MockDamageReportAttachmentRepository
+	damageReport *mockdomain.MockDamageReportRepository
+	fileStorage  *mockdomain.MockFileStorage
+	taskRunner   *mockdomain.MockTaskRunner
+}
+
+// attachmentMaxSize テストで使う添付ファイルの最大サイズ
+const attachmentMaxSize = 1024
+
+func setupDamageReportAttachmentTest(t *testing.T) (*damageReportAttachmentMocks, usecase.DamageReportAttachmentUseCase) {
+	ctrl := gomock.NewController(t)
+	mocks := &damageReportAttachmentMocks{
+		attachment:   mockdomain.NewMockDamageReportAttachmentRepository(ctrl),
+		damageReport: mockdomain.NewMockDamageReportRepository(ctrl),
+		fileStorage:  mockdomain.NewMockFileStorage(ctrl),
+		taskRunner:   mockdomain.NewMockTaskRunner(ctrl),
+	}
+	useCase := usecase.NewDamageReportAttachmentUseCase(
+		mocks.attachment,
+		mocks.damageReport,
+		mocks.fileStorage,
+		mocks.taskRunner,
+		attachmentMaxSize,
+	)
+	return mocks, useCase
+}
+
+func damageReportNotFound() *myerrors.APIError {
+	return &myerrors.APIError{
+		Code:    myerrors.DamageReportNotFoundError,
+		Message: myerrors.DamageReportNotFoundErrorMessage,
+	}
+}
+
+// 各形式のファイルの先頭
+var (
+	jpegContent = []byte("\xFF\xD8\xFF\xE0\x00\x10JFIF\x00photo")
+	pngContent  = []byte("\x89PNG\x0D\x0A\x1A\x0Aimage")
+	webpContent = []byte("RIFF\x10\x00\x00\x00WEBPVP8 image")
+	pdfContent  = []byte("%PDF-1.7\nestimate")
+)
+
+func TestDamageReportAttachmentUseCase_ListAttachments(t *testing.T) {
+	// Setup
+	mocks, useCase := setupDamageReportAttachmentTest(t)
+	ctx := context.Background()
+	notFound := damageReportNotFound()
+	params := pagination.NewParams(1, 20, nil, nil)
+
+	// Test cases
+	tests := []struct {
+		name          string
+		mockSetup     func(mocks *damageReportAttachmentMocks)
+		expectedError error
+		expectedLen   int
+	}{
+		{
+			name: "Success",
+			mockSetup: func(mocks *damageReportAttachmentMocks) {
+				mocks.damageReport.EXPECT().FindByID(gomock.Any(), 1).Return(&model.DamageReport{ID: 1}, nil)
+				mocks.attachment.EXPECT().FindByDamageReportID(gomock.Any(), 1, params).Return([]*model.DamageReportAttachment{
+					{ID: 1, DamageReportID: 1},
+					{ID: 2, DamageReportID: 1},
+				}, pagination.NewMeta(params, 2, nil), nil)
+			},
+			expectedLen: 2,
+		},
+		{
+			name: "failure/存在しない被害報告",
+			mockSetup: func(mocks *damageReportAttachmentMocks) {
+				mocks.damageReport.EXPECT().FindByID(gomock.Any(), 1).Return(nil, notFound)
+			},
+			expectedError: notFound,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mocks)
+
+			// Call the method
+			attachments, _, err := useCase.ListAttachments(ctx, 1, params)
+
+			// Check results
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, attachments)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, attachments, tt.expectedLen)
+			}
+		})
+	}
+}
+
+func TestDamageReportAttachmentUseCase_UploadAttachment(t *testing.T) {
+	// Setup
+	mocks, useCase := setupDamageReportAttachmentTest(t)
+	ctx := context.Background()
+
+	// Test cases
+	tests := []struct {
+		name                string
+		fileName            string
+		content             []byte
+		expectedFileName    string
+		expectedContentType string
+		// expectedProcessPhoto 写真の処理をバックグラウンドで行うか
+		expectedProcessPhoto bool
+	}{
+		{
+			name:                 "Success/JPEG",
+			fileName:             "被災状況.jpg",
+			content:              jpegContent,
+			expectedFileName:     "被災状況.jpg",
+			expectedContentType:  "image/jpeg",
+			expectedProcessPhoto: true,
+		},
+		{
+			name:                 "Success/PNG",
+			fileName:             "全景.png",
+			content:              pngContent,
+			expectedFileName:     "全景.png",
+			expectedContentType:  "image/png",
+			expectedProcessPhoto: true,
+		},
+		{
+			name:                 "Success/WebP",
+			fileName:             "崩落箇所.webp",
+			content:              webpContent,
+			expectedFileName:     "崩落箇所.webp",
+			expectedContentType:  "image/webp",
+			expectedProcessPhoto: true,
+		},
+		{
+			name:                "Success/PDF",
+			fileName:            "見積書.pdf",
+			content:             pdfContent,
+			expectedFileName:    "見積書.pdf",
+			expectedContentType: "application/pdf",
+		},
+		{
+			name:                "Success/拡張子ではなく内容から種類を判定",
+			fileName:            "見積書.jpg",
+			content:             pdfContent,
+			expectedFileName:    "見積書.jpg",
+			expectedContentType: "application/pdf",
+		},
+		{
+			name:                 "Success/ファイル名のディレクトリを除く",
+			fileName:             `C:\Users\inspector\被災状況.jpg`,
+			content:              jpegContent,
+			expectedFileName:     "被災状況.jpg",
+			expectedContentType:  "image/jpeg",
+			expectedProcessPhoto: true,
+		},
+		{
+			name:                 "Success/長いファイル名は255文字に切り詰める",
+			fileName:             strings.Repeat("写", 300),
+			content:              jpegContent,
+			expectedFileName:     strings.Repeat("写", 255),
+			expectedContentType:  "image/jpeg",
+			expectedProcessPhoto: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			var storageKey string
+			mocks.damageReport.EXPECT().FindByID(gomock.Any(), 1).Return(&model.DamageReport{ID: 1}, nil)
+			mocks.fileStorage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), int64(len(tt.content)), tt.expectedContentType).
+				DoAndReturn(func(_ context.Context, key string, body io.ReadSeeker, _ int64, _ string) error {
+					// 種類の判定で読んだ分が先頭に戻っていること
+					got, err := io.ReadAll(body)
+					assert.NoError(t, err)
+					assert.Equal(t, tt.content, got)
+					assert.Regexp(t, `^damage-reports/1/[0-9a-f-]{36}$`, key)
+					storageKey = key
+
+					return nil
+				})
+			mocks.attachment.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, a *model.DamageReportAttachment) error {
+					a.ID = 10
+					return nil
+				})
+			if tt.expectedProcessPhoto {
+				mocks.taskRunner.EXPECT().Go(gomock.Any(), "process photo", gomock.Any())
+			}
+
+			// Call the method
+			attachment, err := useCase.UploadAttachment(ctx, 1, tt.fileName, int64(len(tt.content)), bytes.NewReader(tt.content))
+
+			// Check results
+			assert.NoError(t, err)
+			assert.Equal(t, &model.DamageReportAttachment{
+				ID:             10,
+				DamageReportID: 1,
+				FileName:       tt.expectedFileName,
+				ContentType:    tt.expectedContentType,
+				Size:           int64(len(tt.content)),
+				StorageKey:     storageKey,
+			}, attachment)
+		})
+	}
+
+	failures := []struct {
+		name              string
+		content           []byte
+		size              int64
+		expectedErrorCode myerrors.ErrorCode
+	}{
+		{
+			name:              "failure/空のファイル",
+			content:           []byte{},
+			size:              0,
+			expectedErrorCode: myerrors.ValidationError,
+		},
+		{
+			name:              "failure/最大サイズを超える",
+			content:           jpegContent,
+			size:              attachmentMaxSize + 1,
+			expectedErrorCode: myerrors.AttachmentTooLargeError,
+		},
+		{
+			name:              "failure/テキストファイル",
+			content:           []byte("写真ではありません"),
+			size:              27,
+			expectedErrorCode: myerrors.UnsupportedAttachmentTypeError,
+		},
+		{
+			name:              "failure/GIF",
+			content:           []byte("GIF89a image"),
+			size:              12,
+			expectedErrorCode: myerrors.UnsupportedAttachmentTypeError,
+		},
+		{
+			name:              "failure/HTML",
+			content:           []byte("<html><script>alert(1)</script></html>"),
+			size:              38,
+			expectedErrorCode: myerrors.UnsupportedAttachmentTypeError,
+		},
+	}
+
+	for _, tt := range failures {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			mocks.damageReport.EXPECT().FindByID(gomock.Any(), 1).Return(&model.DamageReport{ID: 1}, nil)
+
+			// Call the method
+			attachment, err := useCase.UploadAttachment(ctx, 1, "file", tt.size, bytes.NewReader(tt.content))
+
+			// Check results
+			var apiErr *myerrors.APIError
+			assert.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, tt.expectedErrorCode, apiErr.Code)
+			assert.Nil(t, attachment)
+		})
+	}
+
+	t.Run("failure/存在しない被害報告", func(t *testing.T) {
+		notFound := damageReportNotFound()
+		mocks.damageReport.EXPECT().FindByID(gomock.Any(), 1).Return(nil, notFound)
+
+		attachment, err := useCase.UploadAttachment(ctx, 1, "被災状況.jpg", int64(len(jpegContent)), bytes.NewReader(jpegContent))
+		assert.ErrorIs(t, err, notFound)
+		assert.Nil(t, attachment)
+	})
+
+	t.Run("failure/ストレージへの保存エラー", func(t *testing.T) {
+		storageErr := errors.New("storage error")
+		mocks.damageReport.EXPECT().FindByID(gomock.Any(), 1).Return(&model.DamageReport{ID: 1}, nil)
+		mocks.fileStorage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(storageErr)
+
+		attachment, err := useCase.UploadAttachment(ctx, 1, "被災状況.jpg", int64(len(jpegContent)), bytes.NewReader(jpegContent))
+		assert.ErrorIs(t, err, storageErr)
+		assert.Nil(t, attachment)
+	})
+
+	t.Run("failure/登録エラーの場合は保存したファイルを削除", func(t *testing.T) {
+		dbErr := errors.New("database error")
+		var storageKey string
+		mocks.damageReport.EXPECT().FindByID(gomock.Any(), 1).Return(&model.DamageReport{ID: 1}, nil)
+		mocks.fileStorage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, key string, _ io.ReadSeeker, _ int64, _ string) error {
+				storageKey = key
+				return nil
+			})
+		mocks.attachment.EXPECT().Create(gomock.Any(), gomock.Any()).Return(dbErr)
+		mocks.fileStorage.EXPECT().Delete(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, key string) error {
+				assert.Equal(t, storageKey, key)
+				return nil
+			})
+
+		attachment, err := useCase.UploadAttachment(ctx, 1, "被災状況.jpg", int64(len(jpegContent)), bytes.NewReader(jpegContent))
+		assert.ErrorIs(t, err, dbErr)
+		assert.Nil(t, attachment)
+	})
+}
+
+func TestDamageReportAttachmentUseCase_DownloadAttachment(t *testing.T) {
+	// Setup
+	mocks, useCase := setupDamageReportAttachmentTest(t)
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		mocks.attachment.EXPECT().FindByID(gomock.Any(), 1, 2).
+			Return(&model.DamageReportAttachment{ID: 2, DamageReportID: 1, StorageKey: "damage-reports/1/a"}, nil)
+		mocks.fileStorage.EXPECT().Get(gomock.Any(), "damage-reports/1/a").
+			Return(io.NopCloser(bytes.NewReader(pdfContent)), nil)
+
+		attachment, body, err := useCase.DownloadAttachment(ctx, 1, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), attachment.ID)
+
+		got, err := io.ReadAll(body)
+		assert.NoError(t, err)
+		assert.Equal(t, pdfContent, got)
+	})
+
+	t.Run("failure/存在しない添付ファイル", func(t *testing.T) {
+		notFound := &myerrors.APIError{
+			Code:    myerrors.AttachmentNotFoundError,
+			Message: myerrors.AttachmentNotFoundErrorMessage,
+		}
+		mocks.attachment.EXPECT().FindByID(gomock.Any(), 1, 2).Return(nil, notFound)
+
+		attachment, body, err := useCase.DownloadAttachment(ctx, 1, 2)
+		assert.ErrorIs(t, err, notFound)
+		assert.Nil(t, attachment)
+		assert.Nil(t, body)
+	})
+
+	t.Run("failure/ストレージからの取得エラー", func(t *testing.T) {
+		storageErr := errors.New("storage error")
+		mocks.attachment.EXPECT().FindByID(gomock.Any(), 1, 2).
+			Return(&model.DamageReportAttachment{ID: 2, DamageReportID: 1, StorageKey: "damage-reports/1/a"}, nil)
+		mocks.fileStorage.EXPECT().Get(gomock.Any(), "damage-reports/1/a").Return(nil, storageErr)
+
+		attachment, body, err := useCase.DownloadAttachment(ctx, 1, 2)
+		assert.ErrorIs(t, err, storageErr)
+		assert.Nil(t, attachment)
+		assert.Nil(t, body)
+	})
+}
+
+func TestDamageReportAttachmentUseCase_ProcessPhoto(t *testing.T) {
+	// Setup
+	mocks, useCase := setupDamageReportAttachmentTest(t)
+	ctx := context.Background()
+
+	// exif.jpg 撮影日時 2026-06-30 14:05:00、撮影位置 北緯35度41分6.63秒・東経139度45分10.08秒のEXIFを持つ写真
+	exifJPEG, err := os.ReadFile("testdata/exif.jpg")
+	require.NoError(t, err)
+
+	var pngImage bytes.Buffer
+	require.NoError(t, png.Encode(&pngImage, image.NewGray(image.Rect(0, 0, 8, 8))))
+
+	capturedAt := time.Date(2026, 6, 30, 14, 5, 0, 0, time.FixedZone("JST", 9*60*60))
+	storageErr := errors.New("storage error")
+	dbErr := errors.New("database error")
+
+	// Test cases
+	tests := []struct {
+		name    string
+		content []byte
+		// mockSetup 添付ファイルの登録の後、バックグラウンドで行う処理のモック
+		mockSetup     func(mocks *damageReportAttachmentMocks, storageKey string)
+		expectedError error
+	}{
+		{
+			name:    "Success/EXIFの撮影日時・撮影位置を保存し、被害報告の位置を補う",
+			content: exifJPEG,
+			mockSetup: func(mocks *damageReportAttachmentMocks, storageKey string) {
+				thumbnailKey := storageKey + "-thumbnail.jpg"
+				mocks.fileStorage.EXPECT().Put(gomock.Any(), thumbnailKey, gomock.Any(), gomock.Any(), "image/jpeg").
+					DoAndReturn(func(_ context.Context, _ string, body io.ReadSeeker, _ int64, _ string) error {
+						config, err := jpeg.DecodeConfig(body)
+						assert.NoError(t, err)
+						assert.Equal(t, 320, config.Width)
+						assert.Equal(t, 240, config.Height)
+
+						return nil
+					})
+				mocks.attachment.EXPECT().UpdatePhotoMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, a *model.DamageReportAttachment) error {
+						assert.Equal(t, int64(10), a.ID)
+						assert.True(t, capturedAt.Equal(*a.CapturedAt))
+						assert.InDelta(t, 35.685175, *a.Latitude, 1e-6)
+						assert.InDelta(t, 139.7528, *a.Longitude, 1e-6)
+						assert.Equal(t, thumbnailKey, *a.ThumbnailKey)
+
+						return nil
+					})
+				mocks.damageReport.EXPECT().PrefillFromPhoto(gomock.Any(), 1, gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ int, latitude, longitude float64) error {
+						assert.InDelta(t, 35.685175, latitude, 1e-6)
+						assert.InDelta(t, 139.7528, longitude, 1e-6)
+
+						return nil
+					})
+			},
+		},
+		{
+			name:    "Success/EXIFがない写真はサムネイルだけ作成",
+			content: pngImage.Bytes(),
+			mockSetup: func(mocks *damageReportAttachmentMocks, storageKey string) {
+				thumbnailKey := storageKey + "-thumbnail.jpg"
+				mocks.fileStorage.EXPECT().Put(gomock.Any(), thumbnailKey, gomock.Any(), gomock.Any(), "image/jpeg")
+				mocks.attachment.EXPECT().UpdatePhotoMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, a *model.DamageReportAttachment) error {
+						assert.Nil(t, a.CapturedAt)
+						assert.Nil(t, a.Latitude)
+						assert.Nil(t, a.Longitude)
+						assert.Equal(t, thumbnailKey, *a.ThumbnailKey)
+
+						return nil
+					})
+			},
+		},
+		{
+			name:          "failure/途中で切れた写真",
+			content:       jpegContent,
+			mockSetup:     func(*damageReportAttachmentMocks, string) {},
+			expectedError: io.ErrUnexpectedEOF,
+		},
+		{
+			name:    "failure/サムネイルの保存エラー",
+			content: exifJPEG,
+			mockSetup: func(mocks *damageReportAttachmentMocks, storageKey string) {
+				mocks.fileStorage.EXPECT().Put(gomock.Any(), storageKey+"-thumbnail.jpg", gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(storageErr)
+			},
+			expectedError: storageErr,
+		},
+		{
+			name:    "failure/更新エラーの場合は保存したサムネイルを削除",
+			content: exifJPEG,
+			mockSetup: func(mocks *damageReportAttachmentMocks, storageKey string) {
+				mocks.fileStorage.EXPECT().Put(gomock.Any(), storageKey+"-thumbnail.jpg", gomock.Any(), gomock.Any(), gomock.Any())
+				mocks.attachment.EXPECT().UpdatePhotoMetadata(gomock.Any(), gomock.Any()).Return(dbErr)
+				mocks.fileStorage.EXPECT().Delete(gomock.Any(), storageKey+"-thumbnail.jpg")
+			},
+			expectedError: dbErr,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			var storageKey string
+			var processErr error
+			mocks.damageReport.EXPECT().FindByID(gomock.Any(), 1).Return(&model.DamageReport{ID: 1}, nil)
+			mocks.fileStorage.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), int64(len(tt.content)), gomock.Any()).DoAndReturn(
+				func(_ context.Context, key string, _ io.ReadSeeker, _ int64, _ string) error {
+					storageKey = key
+					return nil
+				})
+			mocks.attachment.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, a *model.DamageReportAttachment) error {
+					a.ID = 10
+					return nil
+				})
+			// バックグラウンドの処理は、登録の後にその場で実行する
+			mocks.taskRunner.EXPECT().Go(gomock.Any(), "process photo", gomock.Any()).DoAndReturn(
+				func(ctx context.Context, _ string, fn func(ctx context.Context) error) {
+					mocks.fileStorage.EXPECT().Get(gomock.Any(), storageKey).Return(io.NopCloser(bytes.NewReader(tt.content)), nil)
+					tt.mockSetup(mocks, storageKey)
+					processErr = fn(ctx)
+				})
+
+			// Call the method
+			attachment, err := useCase.UploadAttachment(ctx, 1, "被災状況", int64(len(tt.content)), bytes.NewReader(tt.content))
+
+			// Check results
+			require.NoError(t, err)
+			// 写真の処理の結果はレスポンスに含めない
+			assert.Nil(t, attachment.ThumbnailKey)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, processErr, tt.expectedError)
+			} else {
+				assert.NoError(t, processErr)
+			}
+		})
+	}
+}
+
+func TestDamageReportAttachmentUseCase_DownloadThumbnail(t *testing.T) {
+	// Setup
+	mocks, useCase := setupDamageReportAttachmentTest(t)
+	ctx := context.Background()
+	thumbnailKey := "damage-reports/1/a-thumbnail.jpg"
+
+	t.Run("Success", func(t *testing.T) {
+		mocks.attachment.EXPECT().FindByID(gomock.Any(), 1, 2).
+			Return(&model.DamageReportAttachment{ID: 2, DamageReportID: 1, ThumbnailKey: &thumbnailKey}, nil)
+		mocks.fileStorage.EXPECT().Get(gomock.Any(), thumbnailKey).
+			Return(io.NopCloser(bytes.NewReader(jpegContent)), nil)
+
+		body, err := useCase.DownloadThumbnail(ctx, 1, 2)
+		assert.NoError(t, err)
+
+		got, err := io.ReadAll(body)
+		assert.NoError(t, err)
+		assert.Equal(t, jpegContent, got)
+	})
+
+	t.Run("failure/サムネイルがない", func(t *testing.T) {
+		mocks.attachment.EXPECT().FindByID(gomock.Any(), 1, 2).
+			Return(&model.DamageReportAttachment{ID: 2, DamageReportID: 1, ContentType: "application/pdf"}, nil)
+
+		body, err := useCase.DownloadThumbnail(ctx, 1, 2)
+		var apiErr *myerrors.APIError
+		assert.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, myerrors.ThumbnailNotFoundError, apiErr.Code)
+		assert.Nil(t, body)
+	})
+
+	t.Run("failure/存在しない添付ファイル", func(t *testing.T) {
+		notFound := &myerrors.APIError{
+			Code:    myerrors.AttachmentNotFoundError,
+			Message: myerrors.AttachmentNotFoundErrorMessage,
+		}
+		mocks.attachment.EXPECT().FindByID(gomock.Any(), 1, 2).Return(nil, notFound)
+
+		body, err := useCase.DownloadThumbnail(ctx, 1, 2)
+		assert.ErrorIs(t, err, notFound)
+		assert.Nil(t, body)
+	})
+}
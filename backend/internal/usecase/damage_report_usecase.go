@@ -0,0 +1,226 @@
+//go:generate mockgen -source=damage_report_usecase.go -destination=../../tests/mock/usecase/damage_report_usecase.mock.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"g_gen/internal/domain/model"
+	domain "g_gen/internal/domain/repository"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/pagination"
+)
+
+// DamageReportAction 被害報告の状態を遷移させる操作
+type DamageReportAction string
+
+const (
+	// DamageReportActionReport 報告。作成中の被害報告を報告済みにする
+	DamageReportActionReport DamageReportAction = "report"
+	// DamageReportActionConfirm 確定。報告済みの被害報告を確定する
+	DamageReportActionConfirm DamageReportAction = "confirm"
+	// DamageReportActionWithdraw 取り下げ。確定する前の被害報告を取り下げる
+	DamageReportActionWithdraw DamageReportAction = "withdraw"
+)
+
+// damageReportTransition 状態遷移表の1行。操作と遷移前の状態の組ごとに、遷移後の状態を定める
+type damageReportTransition struct {
+	action DamageReportAction
+	from   string
+	to     string
+}
+
+// damageReportTransitions 被害報告の状態遷移表。ここにない操作と状態の組は遷移できない
+// 確定・取り下げた被害報告は、写真による被災日・位置の補完の対象に戻さないため、作成中に戻す遷移は設けない
+var damageReportTransitions = []damageReportTransition{
+	{DamageReportActionReport, domain.DamageReportStatusDraft, domain.DamageReportStatusReported},
+	{DamageReportActionConfirm, domain.DamageReportStatusReported, domain.DamageReportStatusConfirmed},
+	{DamageReportActionWithdraw, domain.DamageReportStatusDraft, domain.DamageReportStatusWithdrawn},
+	{DamageReportActionWithdraw, domain.DamageReportStatusReported, domain.DamageReportStatusWithdrawn},
+}
+
+type DamageReportUseCase interface {
+	ListDamageReports(ctx context.Context, params pagination.Params) ([]*model.DamageReport, pagination.Meta, error)
+	GetDamageReport(ctx context.Context, id int) (*model.DamageReport, error)
+	CreateDamageReport(ctx context.Context, damageReport *model.DamageReport) (*model.DamageReport, error)
+	UpdateDamageReport(ctx context.Context, damageReport *model.DamageReport) (*model.DamageReport, error)
+	TransitionDamageReport(ctx context.Context, id int, action DamageReportAction) (*model.DamageReport, error)
+}
+
+type damageReportUseCase struct {
+	damageReportRepository           domain.DamageReportRepository
+	municipalityRepository           domain.Municipality
+	municipalitySuccessionRepository domain.MunicipalitySuccessionRepository
+	workCategoryRepository           domain.WorkCategoryRepository
+}
+
+func NewDamageReportUseCase(
+	damageReportRepository domain.DamageReportRepository,
+	municipalityRepository domain.Municipality,
+	municipalitySuccessionRepository domain.MunicipalitySuccessionRepository,
+	workCategoryRepository domain.WorkCategoryRepository,
+) DamageReportUseCase {
+	return &damageReportUseCase{
+		damageReportRepository:           damageReportRepository,
+		municipalityRepository:           municipalityRepository,
+		municipalitySuccessionRepository: municipalitySuccessionRepository,
+		workCategoryRepository:           workCategoryRepository,
+	}
+}
+
+func (u *damageReportUseCase) ListDamageReports(
+	ctx context.Context,
+	params pagination.Params,
+) ([]*model.DamageReport, pagination.Meta, error) {
+	damageReports, meta, err := u.damageReportRepository.FindAll(ctx, params)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	return damageReports, meta, nil
+}
+
+func (u *damageReportUseCase) GetDamageReport(ctx context.Context, id int) (*model.DamageReport, error) {
+	damageReport, err := u.damageReportRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return damageReport, nil
+}
+
+// CreateDamageReport 有効な市区町村・工種区分を参照する被害報告を、作成中の状態で登録する
+// 合併前の団体コードが指定された場合は、承継先の団体コードに置き換えて登録する
+func (u *damageReportUseCase) CreateDamageReport(
+	ctx context.Context,
+	damageReport *model.DamageReport,
+) (*model.DamageReport, error) {
+	organizationCode, err := u.resolveMunicipality(ctx, damageReport.OrganizationCode)
+	if err != nil {
+		return nil, err
+	}
+	damageReport.OrganizationCode = organizationCode
+
+	if err := u.validateWorkCategory(ctx, damageReport.WorkCategoryID); err != nil {
+		return nil, err
+	}
+
+	damageReport.Status = domain.DamageReportStatusDraft
+	if err := u.damageReportRepository.Create(ctx, damageReport); err != nil {
+		return nil, err
+	}
+
+	return u.damageReportRepository.FindByID(ctx, int(damageReport.ID))
+}
+
+// UpdateDamageReport 被害報告の内容を更新する。状態は TransitionDamageReport で変更する
+// 合併や無効化の後も既存の報告を編集できるよう、市区町村・工種区分は変更された場合のみ有効かどうかを検証する
+// 変更先に合併前の団体コードが指定された場合は、登録時と同じく承継先の団体コードに置き換える
+func (u *damageReportUseCase) UpdateDamageReport(
+	ctx context.Context,
+	damageReport *model.DamageReport,
+) (*model.DamageReport, error) {
+	current, err := u.damageReportRepository.FindByID(ctx, int(damageReport.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if damageReport.OrganizationCode != current.OrganizationCode {
+		organizationCode, err := u.resolveMunicipality(ctx, damageReport.OrganizationCode)
+		if err != nil {
+			return nil, err
+		}
+		damageReport.OrganizationCode = organizationCode
+	}
+
+	if damageReport.WorkCategoryID != current.WorkCategoryID {
+		if err := u.validateWorkCategory(ctx, damageReport.WorkCategoryID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := u.damageReportRepository.Update(ctx, damageReport); err != nil {
+		return nil, err
+	}
+
+	return u.damageReportRepository.FindByID(ctx, int(damageReport.ID))
+}
+
+// TransitionDamageReport 状態遷移表に従って被害報告の状態を遷移させる
+// 現在の状態で行えない操作は状態遷移エラーとする
+func (u *damageReportUseCase) TransitionDamageReport(
+	ctx context.Context,
+	id int,
+	action DamageReportAction,
+) (*model.DamageReport, error) {
+	damageReport, err := u.damageReportRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	transition, err := findDamageReportTransition(action, damageReport.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.damageReportRepository.Transition(ctx, id, transition.from, transition.to); err != nil {
+		return nil, err
+	}
+
+	return u.damageReportRepository.FindByID(ctx, id)
+}
+
+// findDamageReportTransition 操作と現在の状態に対応する遷移を状態遷移表から探す
+func findDamageReportTransition(action DamageReportAction, status string) (*damageReportTransition, error) {
+	for _, t := range damageReportTransitions {
+		if t.action == action && t.from == status {
+			return &t, nil
+		}
+	}
+
+	return nil, myerrors.NewAPIError(
+		myerrors.InvalidStateTransitionError,
+		myerrors.InvalidStateTransitionErrorMessage,
+		fmt.Errorf("cannot %s a %s damage report", action, status),
+		"invalid damage report transition",
+	)
+}
+
+// resolveMunicipality 団体コードを承継をたどって現在の市区町村に解決し、有効であれば解決先の団体コードを返す
+// 無効化された団体コードや、取り込まれていない合併前の団体コードも、承継先があれば解決できる
+func (u *damageReportUseCase) resolveMunicipality(ctx context.Context, organizationCode string) (string, error) {
+	resolution, err := resolveMunicipality(ctx, u.municipalityRepository, u.municipalitySuccessionRepository, organizationCode)
+	if err != nil {
+		return "", err
+	}
+
+	if !resolution.Municipality.IsActive {
+		return "", myerrors.NewAPIError(
+			myerrors.MunicipalityNotFoundError,
+			myerrors.MunicipalityNotFoundErrorMessage,
+			fmt.Errorf("municipality %s is not active", resolution.Municipality.OrganizationCode),
+			"invalid damage report municipality",
+		)
+	}
+
+	return resolution.Municipality.OrganizationCode, nil
+}
+
+// validateWorkCategory 工種区分が有効であることを検証する
+func (u *damageReportUseCase) validateWorkCategory(ctx context.Context, id int64) error {
+	workCategory, err := u.workCategoryRepository.FindByID(ctx, int(id))
+	if err != nil {
+		return err
+	}
+
+	if !workCategory.IsActive {
+		return myerrors.NewAPIError(
+			myerrors.WorkCategoryNotFoundError,
+			myerrors.WorkCategoryNotFoundErrorMessage,
+			fmt.Errorf("work category %d is not active", id),
+			"invalid damage report work category",
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,564 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"g_gen/internal/domain/model"
+	domain "g_gen/internal/domain/repository"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/pagination"
+	"g_gen/internal/usecase"
+	mockdomain "g_gen/tests/mock/domain"
+)
+
+// damageReportMocks 被害報告のユースケースが利用するリポジトリのモック
+type damageReportMocks struct {
+	damageReport *mockdomain.MockDamageReportRepository
+	municipality *mockdomain.MockMunicipality
+	succession   *mockdomain.MockMunicipalitySuccessionRepository
+	workCategory *mockdomain.MockWorkCategoryRepository
+}
+
+func setupDamageReportTest(t *testing.T) (*damageReportMocks, usecase.DamageReportUseCase) {
+	ctrl := gomock.NewController(t)
+	mocks := &damageReportMocks{
+		damageReport: mockdomain.NewMockDamageReportRepository(ctrl),
+		municipality: mockdomain.NewMockMunicipality(ctrl),
+		succession:   mockdomain.NewMockMunicipalitySuccessionRepository(ctrl),
+		workCategory: mockdomain.NewMockWorkCategoryRepository(ctrl),
+	}
+	useCase := usecase.NewDamageReportUseCase(mocks.damageReport, mocks.municipality, mocks.succession, mocks.workCategory)
+	return mocks, useCase
+}
+
+// expectSuccessions 団体コードの承継をたどる順に、承継先の団体コードを返すよう設定する
+// 最後の団体コードには承継がないものとする
+func (m *damageReportMocks) expectSuccessions(codes ...string) {
+	for i, code := range codes {
+		successions := []*model.MunicipalitySuccession{}
+		if i+1 < len(codes) {
+			successions = append(successions, &model.MunicipalitySuccession{PredecessorCode: code, SuccessorCode: codes[i+1]})
+		}
+
+		m.succession.EXPECT().FindEffectiveByPredecessorCode(gomock.Any(), code, gomock.Any()).Return(successions, nil)
+	}
+}
+
+func TestDamageReportUseCase_ListDamageReports(t *testing.T) {
+	// Setup
+	mocks, useCase := setupDamageReportTest(t)
+	ctx := context.Background()
+	params := pagination.NewParams(1, 20, nil, map[string]string{"status": domain.DamageReportStatusDraft})
+
+	// Test cases
+	tests := []struct {
+		name          string
+		mockSetup     func(mocks *damageReportMocks)
+		expectedError bool
+		expectedLen   int
+		expectedTotal int64
+	}{
+		{
+			name: "Success",
+			mockSetup: func(mocks *damageReportMocks) {
+				damageReports := []*model.DamageReport{
+					{ID: 1, OrganizationCode: "131016", Status: domain.DamageReportStatusDraft},
+					{ID: 3, OrganizationCode: "011002", Status: domain.DamageReportStatusDraft},
+				}
+				mocks.damageReport.EXPECT().FindAll(gomock.Any(), params).Return(damageReports, pagination.NewMeta(params, 2, nil), nil)
+			},
+			expectedError: false,
+			expectedLen:   2,
+			expectedTotal: 2,
+		},
+		{
+			name: "Error",
+			mockSetup: func(mocks *damageReportMocks) {
+				mocks.damageReport.EXPECT().FindAll(gomock.Any(), params).Return(nil, pagination.Meta{}, errors.New("database error"))
+			},
+			expectedError: true,
+			expectedLen:   0,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mocks)
+
+			// Call the method
+			damageReports, meta, err := useCase.ListDamageReports(ctx, params)
+
+			// Check results
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, damageReports)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedLen, len(damageReports))
+				assert.Equal(t, tt.expectedTotal, meta.TotalCount)
+			}
+		})
+	}
+}
+
+func TestDamageReportUseCase_GetDamageReport(t *testing.T) {
+	// Setup
+	mocks, useCase := setupDamageReportTest(t)
+	ctx := context.Background()
+
+	notFound := &myerrors.APIError{
+		Code:    myerrors.DamageReportNotFoundError,
+		Message: myerrors.DamageReportNotFoundErrorMessage,
+	}
+
+	// Test cases
+	tests := []struct {
+		name          string
+		mockSetup     func(mocks *damageReportMocks)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockSetup: func(mocks *damageReportMocks) {
+				mocks.damageReport.EXPECT().FindByID(gomock.Any(), 1).Return(&model.DamageReport{ID: 1}, nil)
+			},
+		},
+		{
+			name: "Not Found",
+			mockSetup: func(mocks *damageReportMocks) {
+				mocks.damageReport.EXPECT().FindByID(gomock.Any(), 1).Return(nil, notFound)
+			},
+			expectedError: notFound,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mocks)
+
+			// Call the method
+			damageReport, err := useCase.GetDamageReport(ctx, 1)
+
+			// Check results
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, damageReport)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(1), damageReport.ID)
+			}
+		})
+	}
+}
+
+func TestDamageReportUseCase_CreateDamageReport(t *testing.T) {
+	// Setup
+	mocks, useCase := setupDamageReportTest(t)
+	ctx := context.Background()
+
+	// expectCreate 登録する被害報告の団体コードと状態を検証し、IDを採番する
+	expectCreate := func(mocks *damageReportMocks, wantOrganizationCode string) {
+		mocks.damageReport.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, d *model.DamageReport) error {
+				assert.Equal(t, domain.DamageReportStatusDraft, d.Status)
+				assert.Equal(t, wantOrganizationCode, d.OrganizationCode)
+				d.ID = 10
+
+				return nil
+			})
+		mocks.damageReport.EXPECT().FindByID(gomock.Any(), 10).
+			Return(&model.DamageReport{ID: 10, OrganizationCode: wantOrganizationCode, Status: domain.DamageReportStatusDraft}, nil)
+	}
+
+	// Test cases
+	tests := []struct {
+		name              string
+		organizationCode  string
+		mockSetup         func(mocks *damageReportMocks)
+		expectedErrorCode myerrors.ErrorCode
+		expectedError     bool
+	}{
+		{
+			name:             "Success/作成中の状態で登録",
+			organizationCode: "131016",
+			mockSetup: func(mocks *damageReportMocks) {
+				mocks.expectSuccessions("131016")
+				mocks.municipality.EXPECT().FindByOrganizationCode(gomock.Any(), "131016").
+					Return(&model.Municipality{OrganizationCode: "131016", IsActive: true}, nil)
+				mocks.workCategory.EXPECT().FindByID(gomock.Any(), 2).
+					Return(&model.WorkCategory{ID: 2, IsActive: true}, nil)
+				expectCreate(mocks, "131016")
+			},
+		},
+		{
+			name:             "Success/合併で無効化された団体コードは承継先の団体コードで登録",
+			organizationCode: "012211",
+			mockSetup: func(mocks *damageReportMocks) {
+				mocks.expectSuccessions("012211", "012343")
+				mocks.municipality.EXPECT().FindByOrganizationCode(gomock.Any(), "012343").
+					Return(&model.Municipality{OrganizationCode: "012343", IsActive: true}, nil)
+				mocks.workCategory.EXPECT().FindByID(gomock.Any(), 2).
+					Return(&model.WorkCategory{ID: 2, IsActive: true}, nil)
+				expectCreate(mocks, "012343")
+			},
+		},
+		{
+			name:             "Success/取り込まれていない合併前の団体コードは複数回の合併をたどって登録",
+			organizationCode: "011111",
+			mockSetup: func(mocks *damageReportMocks) {
+				mocks.expectSuccessions("011111", "011112", "012345")
+				mocks.municipality.EXPECT().FindByOrganizationCode(gomock.Any(), "012345").
+					Return(&model.Municipality{OrganizationCode: "012345", IsActive: true}, nil)
+				mocks.workCategory.EXPECT().FindByID(gomock.Any(), 2).
+					Return(&model.WorkCategory{ID: 2, IsActive: true}, nil)
+				expectCreate(mocks, "012345")
+			},
+		},
+		{
+			name:             "failure/承継先のない無効化された市区町村",
+			organizationCode: "131016",
+			mockSetup: func(mocks *damageReportMocks) {
+				mocks.expectSuccessions("131016")
+				mocks.municipality.EXPECT().FindByOrganizationCode(gomock.Any(), "131016").
+					Return(&model.Municipality{OrganizationCode: "131016", IsActive: false}, nil)
+			},
+			expectedErrorCode: myerrors.MunicipalityNotFoundError,
+			expectedError:     true,
+		},
+		{
+			name:             "failure/承継先のない存在しない団体コード",
+			organizationCode: "999999",
+			mockSetup: func(mocks *damageReportMocks) {
+				mocks.expectSuccessions("999999")
+				mocks.municipality.EXPECT().FindByOrganizationCode(gomock.Any(), "999999").Return(nil, &myerrors.APIError{
+					Code:    myerrors.MunicipalityNotFoundError,
+					Message: myerrors.MunicipalityNotFoundErrorMessage,
+				})
+			},
+			expectedErrorCode: myerrors.MunicipalityNotFoundError,
+			expectedError:     true,
+		},
+		{
+			name:             "failure/承継が循環している",
+			organizationCode: "011111",
+			mockSetup: func(mocks *damageReportMocks) {
+				mocks.succession.EXPECT().FindEffectiveByPredecessorCode(gomock.Any(), "011111", gomock.Any()).
+					Return([]*model.MunicipalitySuccession{{PredecessorCode: "011111", SuccessorCode: "011112"}}, nil)
+				mocks.succession.EXPECT().FindEffectiveByPredecessorCode(gomock.Any(), "011112", gomock.Any()).
+					Return([]*model.MunicipalitySuccession{{PredecessorCode: "011112", SuccessorCode: "011111"}}, nil)
+			},
+			expectedErrorCode: myerrors.SuccessionCycleError,
+			expectedError:     true,
+		},
+		{
+			name:             "failure/存在しない工種区分",
+			organizationCode: "131016",
+			mockSetup: func(mocks *damageReportMocks) {
+				mocks.expectSuccessions("131016")
+				mocks.municipality.EXPECT().FindByOrganizationCode(gomock.Any(), "131016").
+					Return(&model.Municipality{OrganizationCode: "131016", IsActive: true}, nil)
+				mocks.workCategory.EXPECT().FindByID(gomock.Any(), 2).Return(nil, &myerrors.APIError{
+					Code:    myerrors.WorkCategoryNotFoundError,
+					Message: myerrors.WorkCategoryNotFoundErrorMessage,
+				})
+			},
+			expectedErrorCode: myerrors.WorkCategoryNotFoundError,
+			expectedError:     true,
+		},
+		{
+			name:             "failure/無効化された工種区分",
+			organizationCode: "131016",
+			mockSetup: func(mocks *damageReportMocks) {
+				mocks.expectSuccessions("131016")
+				mocks.municipality.EXPECT().FindByOrganizationCode(gomock.Any(), "131016").
+					Return(&model.Municipality{OrganizationCode: "131016", IsActive: true}, nil)
+				mocks.workCategory.EXPECT().FindByID(gomock.Any(), 2).
+					Return(&model.WorkCategory{ID: 2, IsActive: false}, nil)
+			},
+			expectedErrorCode: myerrors.WorkCategoryNotFoundError,
+			expectedError:     true,
+		},
+		{
+			name:             "failure/登録エラー",
+			organizationCode: "131016",
+			mockSetup: func(mocks *damageReportMocks) {
+				mocks.expectSuccessions("131016")
+				mocks.municipality.EXPECT().FindByOrganizationCode(gomock.Any(), "131016").
+					Return(&model.Municipality{OrganizationCode: "131016", IsActive: true}, nil)
+				mocks.workCategory.EXPECT().FindByID(gomock.Any(), 2).
+					Return(&model.WorkCategory{ID: 2, IsActive: true}, nil)
+				mocks.damageReport.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mocks)
+
+			// Call the method
+			damageReport, err := useCase.CreateDamageReport(ctx, &model.DamageReport{
+				OrganizationCode: tt.organizationCode,
+				WorkCategoryID:   2,
+				Location:         "千代田区千代田1番地先",
+				EstimatedAmount:  500000,
+			})
+
+			// Check results
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, damageReport)
+
+				if tt.expectedErrorCode != "" {
+					var apiErr *myerrors.APIError
+					assert.ErrorAs(t, err, &apiErr)
+					assert.Equal(t, tt.expectedErrorCode, apiErr.Code)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(10), damageReport.ID)
+			}
+		})
+	}
+}
+
+func TestDamageReportUseCase_UpdateDamageReport(t *testing.T) {
+	// Setup
+	mocks, useCase := setupDamageReportTest(t)
+	ctx := context.Background()
+
+	current := &model.DamageReport{ID: 1, OrganizationCode: "131016", WorkCategoryID: 2, Status: domain.DamageReportStatusDraft}
+	notFound := &myerrors.APIError{
+		Code:    myerrors.DamageReportNotFoundError,
+		Message: myerrors.DamageReportNotFoundErrorMessage,
+	}
+
+	// Test cases
+	tests := []struct {
+		name                 string
+		damageReport         *model.DamageReport
+		mockSetup            func(mocks *damageReportMocks)
+		expectedErrorCode    myerrors.ErrorCode
+		wantOrganizationCode string
+	}{
+		{
+			name: "Success/市区町村・工種区分が変わらない場合は有効かどうかを検証しない",
+			damageReport: &model.DamageReport{
+				ID: 1, OrganizationCode: "131016", WorkCategoryID: 2,
+			},
+			mockSetup: func(mocks *damageReportMocks) {
+				mocks.damageReport.EXPECT().FindByID(gomock.Any(), 1).Return(current, nil)
+				mocks.damageReport.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.damageReport.EXPECT().FindByID(gomock.Any(), 1).Return(&model.DamageReport{
+					ID: 1, OrganizationCode: "131016", WorkCategoryID: 2, Status: domain.DamageReportStatusReported,
+				}, nil)
+			},
+			wantOrganizationCode: "131016",
+		},
+		{
+			name: "Success/変更した市区町村・工種区分を検証する",
+			damageReport: &model.DamageReport{
+				ID: 1, OrganizationCode: "011002", WorkCategoryID: 3,
+			},
+			mockSetup: func(mocks *damageReportMocks) {
+				mocks.damageReport.EXPECT().FindByID(gomock.Any(), 1).Return(current, nil)
+				mocks.expectSuccessions("011002")
+				mocks.municipality.EXPECT().FindByOrganizationCode(gomock.Any(), "011002").
+					Return(&model.Municipality{OrganizationCode: "011002", IsActive: true}, nil)
+				mocks.workCategory.EXPECT().FindByID(gomock.Any(), 3).
+					Return(&model.WorkCategory{ID: 3, IsActive: true}, nil)
+				mocks.damageReport.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				mocks.damageReport.EXPECT().FindByID(gomock.Any(), 1).Return(&model.DamageReport{
+					ID: 1, OrganizationCode: "011002", WorkCategoryID: 3, Status: domain.DamageReportStatusReported,
+				}, nil)
+			},
+			wantOrganizationCode: "011002",
+		},
+		{
+			name: "Success/変更先の合併前の団体コードは承継先の団体コードに置き換える",
+			damageReport: &model.DamageReport{
+				ID: 1, OrganizationCode: "012211", WorkCategoryID: 2,
+			},
+			mockSetup: func(mocks *damageReportMocks) {
+				mocks.damageReport.EXPECT().FindByID(gomock.Any(), 1).Return(current, nil)
+				mocks.expectSuccessions("012211", "012343")
+				mocks.municipality.EXPECT().FindByOrganizationCode(gomock.Any(), "012343").
+					Return(&model.Municipality{OrganizationCode: "012343", IsActive: true}, nil)
+				mocks.damageReport.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, d *model.DamageReport) error {
+						assert.Equal(t, "012343", d.OrganizationCode)
+
+						return nil
+					})
+				mocks.damageReport.EXPECT().FindByID(gomock.Any(), 1).Return(&model.DamageReport{
+					ID: 1, OrganizationCode: "012343", WorkCategoryID: 2, Status: domain.DamageReportStatusDraft,
+				}, nil)
+			},
+			wantOrganizationCode: "012343",
+		},
+		{
+			name: "failure/存在しない被害報告",
+			damageReport: &model.DamageReport{
+				ID: 1, OrganizationCode: "131016", WorkCategoryID: 2,
+			},
+			mockSetup: func(mocks *damageReportMocks) {
+				mocks.damageReport.EXPECT().FindByID(gomock.Any(), 1).Return(nil, notFound)
+			},
+			expectedErrorCode: myerrors.DamageReportNotFoundError,
+		},
+		{
+			name: "failure/変更先の市区町村が承継先なく無効化されている",
+			damageReport: &model.DamageReport{
+				ID: 1, OrganizationCode: "132047", WorkCategoryID: 2,
+			},
+			mockSetup: func(mocks *damageReportMocks) {
+				mocks.damageReport.EXPECT().FindByID(gomock.Any(), 1).Return(current, nil)
+				mocks.expectSuccessions("132047")
+				mocks.municipality.EXPECT().FindByOrganizationCode(gomock.Any(), "132047").
+					Return(&model.Municipality{OrganizationCode: "132047", IsActive: false}, nil)
+			},
+			expectedErrorCode: myerrors.MunicipalityNotFoundError,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mocks)
+
+			// Call the method
+			damageReport, err := useCase.UpdateDamageReport(ctx, tt.damageReport)
+
+			// Check results
+			if tt.expectedErrorCode != "" {
+				var apiErr *myerrors.APIError
+				assert.ErrorAs(t, err, &apiErr)
+				assert.Equal(t, tt.expectedErrorCode, apiErr.Code)
+				assert.Nil(t, damageReport)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantOrganizationCode, damageReport.OrganizationCode)
+			}
+		})
+	}
+}
+
+func TestDamageReportUseCase_TransitionDamageReport(t *testing.T) {
+	// Setup
+	mocks, useCase := setupDamageReportTest(t)
+	ctx := context.Background()
+
+	// Test cases
+	tests := []struct {
+		name              string
+		status            string
+		action            usecase.DamageReportAction
+		expectedStatus    string
+		expectedErrorCode myerrors.ErrorCode
+	}{
+		{
+			name:           "Success/作成中の被害報告を報告",
+			status:         domain.DamageReportStatusDraft,
+			action:         usecase.DamageReportActionReport,
+			expectedStatus: domain.DamageReportStatusReported,
+		},
+		{
+			name:           "Success/報告済みの被害報告を確定",
+			status:         domain.DamageReportStatusReported,
+			action:         usecase.DamageReportActionConfirm,
+			expectedStatus: domain.DamageReportStatusConfirmed,
+		},
+		{
+			name:           "Success/作成中の被害報告を取り下げ",
+			status:         domain.DamageReportStatusDraft,
+			action:         usecase.DamageReportActionWithdraw,
+			expectedStatus: domain.DamageReportStatusWithdrawn,
+		},
+		{
+			name:           "Success/報告済みの被害報告を取り下げ",
+			status:         domain.DamageReportStatusReported,
+			action:         usecase.DamageReportActionWithdraw,
+			expectedStatus: domain.DamageReportStatusWithdrawn,
+		},
+		{
+			name:              "failure/作成中の被害報告は確定できない",
+			status:            domain.DamageReportStatusDraft,
+			action:            usecase.DamageReportActionConfirm,
+			expectedErrorCode: myerrors.InvalidStateTransitionError,
+		},
+		{
+			name:              "failure/確定した被害報告は報告し直せない",
+			status:            domain.DamageReportStatusConfirmed,
+			action:            usecase.DamageReportActionReport,
+			expectedErrorCode: myerrors.InvalidStateTransitionError,
+		},
+		{
+			name:              "failure/確定した被害報告は取り下げられない",
+			status:            domain.DamageReportStatusConfirmed,
+			action:            usecase.DamageReportActionWithdraw,
+			expectedErrorCode: myerrors.InvalidStateTransitionError,
+		},
+		{
+			name:              "failure/取り下げた被害報告は報告できない",
+			status:            domain.DamageReportStatusWithdrawn,
+			action:            usecase.DamageReportActionReport,
+			expectedErrorCode: myerrors.InvalidStateTransitionError,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			mocks.damageReport.EXPECT().FindByID(gomock.Any(), 1).
+				Return(&model.DamageReport{ID: 1, Status: tt.status}, nil)
+			if tt.expectedErrorCode == "" {
+				mocks.damageReport.EXPECT().Transition(gomock.Any(), 1, tt.status, tt.expectedStatus).Return(nil)
+				mocks.damageReport.EXPECT().FindByID(gomock.Any(), 1).
+					Return(&model.DamageReport{ID: 1, Status: tt.expectedStatus}, nil)
+			}
+
+			// Call the method
+			damageReport, err := useCase.TransitionDamageReport(ctx, 1, tt.action)
+
+			// Check results
+			if tt.expectedErrorCode != "" {
+				var apiErr *myerrors.APIError
+				assert.ErrorAs(t, err, &apiErr)
+				assert.Equal(t, tt.expectedErrorCode, apiErr.Code)
+				assert.Nil(t, damageReport)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, damageReport.Status)
+			}
+		})
+	}
+
+	t.Run("failure/遷移中に状態が変わった", func(t *testing.T) {
+		conflict := &myerrors.APIError{
+			Code:    myerrors.InvalidStateTransitionError,
+			Message: myerrors.InvalidStateTransitionErrorMessage,
+		}
+		mocks.damageReport.EXPECT().FindByID(gomock.Any(), 1).
+			Return(&model.DamageReport{ID: 1, Status: domain.DamageReportStatusReported}, nil)
+		mocks.damageReport.EXPECT().Transition(gomock.Any(), 1, domain.DamageReportStatusReported, domain.DamageReportStatusConfirmed).
+			Return(conflict)
+
+		damageReport, err := useCase.TransitionDamageReport(ctx, 1, usecase.DamageReportActionConfirm)
+		assert.ErrorIs(t, err, conflict)
+		assert.Nil(t, damageReport)
+	})
+}
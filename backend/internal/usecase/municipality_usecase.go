@@ -0,0 +1,246 @@
+//go:generate mockgen -source=municipality_usecase.go -destination=../../tests/mock/usecase/municipality_usecase.mock.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"g_gen/internal/domain/model"
+	domain "g_gen/internal/domain/repository"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/kana"
+	"g_gen/internal/pagination"
+)
+
+// defaultMunicipalitySearchLimit 市区町村検索で件数が指定されなかった場合の取得件数
+const defaultMunicipalitySearchLimit = 20
+
+// 検索結果の並び順（値が小さいほど上位）
+const (
+	matchRankExact = iota
+	matchRankPrefix
+	matchRankSubstring
+	matchRankNone
+)
+
+// MunicipalityResolution 団体コードを現在の市区町村に解決した結果
+type MunicipalityResolution struct {
+	// Municipality 解決先の市区町村
+	Municipality *model.Municipality
+	// Successions 指定された団体コードから解決先に至るまでの承継履歴（古い順）
+	Successions []*model.MunicipalitySuccession
+}
+
+type MunicipalityUseCase interface {
+	ListMunicipalities(ctx context.Context, params pagination.Params) ([]*model.Municipality, pagination.Meta, error)
+	GetMunicipalityByID(ctx context.Context, id int) (*model.Municipality, error)
+	ResolveOrganizationCode(ctx context.Context, organizationCode string) (*MunicipalityResolution, error)
+	ListMunicipalitiesByPrefectureCode(
+		ctx context.Context,
+		prefectureCode string,
+		params pagination.Params,
+	) ([]*model.Municipality, pagination.Meta, error)
+	SearchMunicipalities(
+		ctx context.Context,
+		keyword string,
+		prefectureCode string,
+		limit int,
+	) ([]*model.Municipality, error)
+	GetMunicipalityStatistics(ctx context.Context) (*domain.MunicipalityStatistics, error)
+}
+
+type municipalityUseCase struct {
+	municipalityRepository           domain.Municipality
+	municipalitySuccessionRepository domain.MunicipalitySuccessionRepository
+}
+
+func NewMunicipalityUseCase(
+	municipalityRepository domain.Municipality,
+	municipalitySuccessionRepository domain.MunicipalitySuccessionRepository,
+) MunicipalityUseCase {
+	return &municipalityUseCase{
+		municipalityRepository:           municipalityRepository,
+		municipalitySuccessionRepository: municipalitySuccessionRepository,
+	}
+}
+
+func (u *municipalityUseCase) ListMunicipalities(
+	ctx context.Context,
+	params pagination.Params,
+) ([]*model.Municipality, pagination.Meta, error) {
+	municipalities, meta, err := u.municipalityRepository.FindAll(ctx, params)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	return municipalities, meta, nil
+}
+
+func (u *municipalityUseCase) GetMunicipalityByID(ctx context.Context, id int) (*model.Municipality, error) {
+	municipality, err := u.municipalityRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return municipality, nil
+}
+
+// GetMunicipalityStatistics インポート後のデータ確認のため、無効化された市区町村を含めた件数を集計する
+func (u *municipalityUseCase) GetMunicipalityStatistics(ctx context.Context) (*domain.MunicipalityStatistics, error) {
+	stats, err := u.municipalityRepository.Statistics(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// ResolveOrganizationCode 合併前の団体コードを含む任意の団体コードを、承継をたどって現在の市区町村に解決する
+func (u *municipalityUseCase) ResolveOrganizationCode(
+	ctx context.Context,
+	organizationCode string,
+) (*MunicipalityResolution, error) {
+	return resolveMunicipality(ctx, u.municipalityRepository, u.municipalitySuccessionRepository, organizationCode)
+}
+
+// resolveMunicipality 承継をたどって団体コードを現在の市区町村に解決する。被害報告の登録でも同じ解決を行う
+// 分割などで承継先が複数ある場合は、施行日が最も新しく団体コードが最も小さい承継先を採用する
+// 合併前の団体コードは市区町村として取り込まれていなくてもよく、解決先の市区町村のみを取得する
+// 承継のデータが循環している場合は解決できないため、SuccessionCycleError を返す
+func resolveMunicipality(
+	ctx context.Context,
+	municipalityRepository domain.Municipality,
+	municipalitySuccessionRepository domain.MunicipalitySuccessionRepository,
+	organizationCode string,
+) (*MunicipalityResolution, error) {
+	now := time.Now()
+	code := organizationCode
+	visited := map[string]struct{}{code: {}}
+	successions := make([]*model.MunicipalitySuccession, 0)
+
+	for {
+		found, err := municipalitySuccessionRepository.FindEffectiveByPredecessorCode(ctx, code, now)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(found) == 0 {
+			break
+		}
+
+		succession := found[0]
+		if _, ok := visited[succession.SuccessorCode]; ok {
+			return nil, myerrors.NewAPIError(
+				myerrors.SuccessionCycleError,
+				myerrors.SuccessionCycleErrorMessage,
+				fmt.Errorf("%s -> %s", code, succession.SuccessorCode),
+				"municipality succession cycle detected",
+			)
+		}
+
+		visited[succession.SuccessorCode] = struct{}{}
+		successions = append(successions, succession)
+		code = succession.SuccessorCode
+	}
+
+	municipality, err := municipalityRepository.FindByOrganizationCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MunicipalityResolution{
+		Municipality: municipality,
+		Successions:  successions,
+	}, nil
+}
+
+func (u *municipalityUseCase) ListMunicipalitiesByPrefectureCode(
+	ctx context.Context,
+	prefectureCode string,
+	params pagination.Params,
+) ([]*model.Municipality, pagination.Meta, error) {
+	municipalities, meta, err := u.municipalityRepository.FindByPrefectureCode(ctx, prefectureCode, params)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	return municipalities, meta, nil
+}
+
+// SearchMunicipalities ひらがな・カタカナ・半角カナ・漢字のいずれかで市区町村を検索する
+// 完全一致、前方一致、部分一致の順に並べ、同順位は団体コード順とする
+func (u *municipalityUseCase) SearchMunicipalities(
+	ctx context.Context,
+	keyword string,
+	prefectureCode string,
+	limit int,
+) ([]*model.Municipality, error) {
+	normalized := kana.Normalize(keyword)
+	if normalized == "" {
+		return []*model.Municipality{}, nil
+	}
+
+	if limit <= 0 {
+		limit = defaultMunicipalitySearchLimit
+	}
+
+	// 漢字表記には「さいたま市」のようにひらがなを含む名称があるため、入力そのままの語でも検索する
+	nameKeywords := []string{normalized}
+	if raw := strings.Join(strings.Fields(keyword), ""); raw != normalized {
+		nameKeywords = append(nameKeywords, raw)
+	}
+
+	cond := domain.MunicipalitySearchCondition{
+		NameKeywords:   nameKeywords,
+		PrefectureCode: prefectureCode,
+	}
+	if kana.IsKatakana(normalized) {
+		cond.KanaKeyword = kana.ToHalfWidth(normalized)
+	}
+
+	municipalities, err := u.municipalityRepository.Search(ctx, cond)
+	if err != nil {
+		return nil, err
+	}
+
+	ranks := make(map[*model.Municipality]int, len(municipalities))
+	matched := make([]*model.Municipality, 0, len(municipalities))
+	for _, m := range municipalities {
+		rank := min(
+			matchRank(kana.Normalize(m.MunicipalityNameKanji), normalized),
+			matchRank(kana.Normalize(m.MunicipalityNameKana), normalized),
+		)
+		if rank == matchRankNone {
+			continue
+		}
+
+		ranks[m] = rank
+		matched = append(matched, m)
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		return ranks[matched[i]] < ranks[matched[j]]
+	})
+
+	if len(matched) > limit {
+		matched = matched[:limit]
+	}
+
+	return matched, nil
+}
+
+func matchRank(name, keyword string) int {
+	switch {
+	case name == keyword:
+		return matchRankExact
+	case strings.HasPrefix(name, keyword):
+		return matchRankPrefix
+	case strings.Contains(name, keyword):
+		return matchRankSubstring
+	default:
+		return matchRankNone
+	}
+}
@@ -0,0 +1,504 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"g_gen/internal/domain/model"
+	domain "g_gen/internal/domain/repository"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/pagination"
+	"g_gen/internal/usecase"
+	mockdomain "g_gen/tests/mock/domain"
+)
+
+func setupMunicipalityTest(t *testing.T) (*mockdomain.MockMunicipality, usecase.MunicipalityUseCase) {
+	mockRepo, _, useCase := setupMunicipalityWithSuccessionTest(t)
+	return mockRepo, useCase
+}
+
+func setupMunicipalityWithSuccessionTest(t *testing.T) (
+	*mockdomain.MockMunicipality,
+	*mockdomain.MockMunicipalitySuccessionRepository,
+	usecase.MunicipalityUseCase,
+) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mockdomain.NewMockMunicipality(ctrl)
+	mockSuccessionRepo := mockdomain.NewMockMunicipalitySuccessionRepository(ctrl)
+	useCase := usecase.NewMunicipalityUseCase(mockRepo, mockSuccessionRepo)
+	return mockRepo, mockSuccessionRepo, useCase
+}
+
+func TestMunicipalityUseCase_ListMunicipalities(t *testing.T) {
+	// Setup
+	mockRepo, useCase := setupMunicipalityTest(t)
+	ctx := context.Background()
+	params := pagination.NewParams(1, 20, nil, nil)
+
+	// Test cases
+	tests := []struct {
+		name          string
+		mockSetup     func(mockRepo *mockdomain.MockMunicipality)
+		expectedError bool
+		expectedLen   int
+		expectedTotal int64
+	}{
+		{
+			name: "Success",
+			mockSetup: func(mockRepo *mockdomain.MockMunicipality) {
+				municipalities := []*model.Municipality{
+					{
+						ID:                    1,
+						OrganizationCode:      "011002",
+						MunicipalityNameKanji: "札幌市",
+					},
+					{
+						ID:                    2,
+						OrganizationCode:      "131016",
+						MunicipalityNameKanji: "千代田区",
+					},
+				}
+				mockRepo.EXPECT().FindAll(gomock.Any(), params).Return(municipalities, pagination.NewMeta(params, 2, nil), nil)
+			},
+			expectedError: false,
+			expectedLen:   2,
+			expectedTotal: 2,
+		},
+		{
+			name: "Error",
+			mockSetup: func(mockRepo *mockdomain.MockMunicipality) {
+				mockRepo.EXPECT().FindAll(gomock.Any(), params).Return(nil, pagination.Meta{}, errors.New("database error"))
+			},
+			expectedError: true,
+			expectedLen:   0,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mockRepo)
+
+			// Call the method
+			municipalities, meta, err := useCase.ListMunicipalities(ctx, params)
+
+			// Check results
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, municipalities)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, municipalities)
+				assert.Equal(t, tt.expectedLen, len(municipalities))
+				assert.Equal(t, tt.expectedTotal, meta.TotalCount)
+			}
+		})
+	}
+}
+
+func TestMunicipalityUseCase_GetMunicipalityByID(t *testing.T) {
+	// Setup
+	mockRepo, useCase := setupMunicipalityTest(t)
+	ctx := context.Background()
+
+	// Test cases
+	tests := []struct {
+		name          string
+		id            int
+		mockSetup     func(mockRepo *mockdomain.MockMunicipality)
+		expectedError bool
+	}{
+		{
+			name: "Success",
+			id:   1,
+			mockSetup: func(mockRepo *mockdomain.MockMunicipality) {
+				municipality := &model.Municipality{
+					ID:                    1,
+					OrganizationCode:      "011002",
+					MunicipalityNameKanji: "札幌市",
+				}
+				mockRepo.EXPECT().FindByID(gomock.Any(), 1).Return(municipality, nil)
+			},
+			expectedError: false,
+		},
+		{
+			name: "Not Found",
+			id:   999,
+			mockSetup: func(mockRepo *mockdomain.MockMunicipality) {
+				mockRepo.EXPECT().FindByID(gomock.Any(), 999).Return(nil, &myerrors.APIError{
+					Code:    myerrors.MunicipalityNotFoundError,
+					Message: myerrors.MunicipalityNotFoundErrorMessage,
+				})
+			},
+			expectedError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mockRepo)
+
+			// Call the method
+			municipality, err := useCase.GetMunicipalityByID(ctx, tt.id)
+
+			// Check results
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, municipality)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, municipality)
+				assert.Equal(t, int64(tt.id), municipality.ID)
+			}
+		})
+	}
+}
+
+func TestMunicipalityUseCase_ListMunicipalitiesByPrefectureCode(t *testing.T) {
+	// Setup
+	mockRepo, useCase := setupMunicipalityTest(t)
+	ctx := context.Background()
+	params := pagination.NewParams(1, 20, nil, nil)
+
+	// Test cases
+	tests := []struct {
+		name          string
+		code          string
+		mockSetup     func(mockRepo *mockdomain.MockMunicipality)
+		expectedError bool
+		expectedLen   int
+		expectedTotal int64
+	}{
+		{
+			name: "Success",
+			code: "13",
+			mockSetup: func(mockRepo *mockdomain.MockMunicipality) {
+				municipalities := []*model.Municipality{
+					{
+						ID:                    2,
+						PrefectureCode:        "13",
+						OrganizationCode:      "131016",
+						MunicipalityNameKanji: "千代田区",
+					},
+				}
+				mockRepo.EXPECT().FindByPrefectureCode(gomock.Any(), "13", params).Return(municipalities, pagination.NewMeta(params, 1, nil), nil)
+			},
+			expectedError: false,
+			expectedLen:   1,
+			expectedTotal: 1,
+		},
+		{
+			name: "Error",
+			code: "13",
+			mockSetup: func(mockRepo *mockdomain.MockMunicipality) {
+				mockRepo.EXPECT().
+					FindByPrefectureCode(gomock.Any(), "13", params).
+					Return(nil, pagination.Meta{}, errors.New("database error"))
+			},
+			expectedError: true,
+			expectedLen:   0,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mockRepo)
+
+			// Call the method
+			municipalities, meta, err := useCase.ListMunicipalitiesByPrefectureCode(ctx, tt.code, params)
+
+			// Check results
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, municipalities)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, municipalities)
+				assert.Equal(t, tt.expectedLen, len(municipalities))
+				assert.Equal(t, tt.expectedTotal, meta.TotalCount)
+			}
+		})
+	}
+}
+
+func TestMunicipalityUseCase_SearchMunicipalities(t *testing.T) {
+	// Setup
+	mockRepo, useCase := setupMunicipalityTest(t)
+	ctx := context.Background()
+
+	municipalities := func() []*model.Municipality {
+		return []*model.Municipality{
+			{ID: 1, OrganizationCode: "011002", MunicipalityNameKanji: "札幌市", MunicipalityNameKana: "ｻｯﾎﾟﾛｼ"},
+			{ID: 2, OrganizationCode: "012343", MunicipalityNameKanji: "北広島市", MunicipalityNameKana: "ｷﾀﾋﾛｼﾏｼ"},
+			{ID: 3, OrganizationCode: "342017", MunicipalityNameKanji: "広島市", MunicipalityNameKana: "ﾋﾛｼﾏｼ"},
+			{ID: 4, OrganizationCode: "343099", MunicipalityNameKanji: "広島県府中町", MunicipalityNameKana: "ﾌﾁｭｳﾁｮｳ"},
+		}
+	}
+
+	// Test cases
+	tests := []struct {
+		name           string
+		keyword        string
+		prefectureCode string
+		limit          int
+		mockSetup      func(mockRepo *mockdomain.MockMunicipality)
+		expectedError  bool
+		expectedIDs    []int64
+	}{
+		{
+			name:    "Success/ひらがなを全角・半角カナの検索条件に変換",
+			keyword: "ひろ しま",
+			mockSetup: func(mockRepo *mockdomain.MockMunicipality) {
+				mockRepo.EXPECT().Search(gomock.Any(), domain.MunicipalitySearchCondition{
+					NameKeywords: []string{"ヒロシマ", "ひろしま"},
+					KanaKeyword:  "ﾋﾛｼﾏ",
+				}).Return(municipalities()[1:3], nil)
+			},
+			expectedIDs: []int64{3, 2},
+		},
+		{
+			name:    "Success/完全一致・前方一致・部分一致の順",
+			keyword: "広島市",
+			mockSetup: func(mockRepo *mockdomain.MockMunicipality) {
+				mockRepo.EXPECT().Search(gomock.Any(), domain.MunicipalitySearchCondition{
+					NameKeywords: []string{"広島市"},
+				}).Return(municipalities()[1:], nil)
+			},
+			expectedIDs: []int64{3, 2},
+		},
+		{
+			name:           "Success/件数を制限",
+			keyword:        "ｼ",
+			prefectureCode: "01",
+			limit:          1,
+			mockSetup: func(mockRepo *mockdomain.MockMunicipality) {
+				mockRepo.EXPECT().Search(gomock.Any(), domain.MunicipalitySearchCondition{
+					NameKeywords:   []string{"シ", "ｼ"},
+					KanaKeyword:    "ｼ",
+					PrefectureCode: "01",
+				}).Return(municipalities()[:2], nil)
+			},
+			expectedIDs: []int64{1},
+		},
+		{
+			name:        "Success/空白のみの場合は検索しない",
+			keyword:     " 　",
+			expectedIDs: []int64{},
+		},
+		{
+			name:    "Error",
+			keyword: "札幌",
+			mockSetup: func(mockRepo *mockdomain.MockMunicipality) {
+				mockRepo.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			if tt.mockSetup != nil {
+				tt.mockSetup(mockRepo)
+			}
+
+			// Call the method
+			got, err := useCase.SearchMunicipalities(ctx, tt.keyword, tt.prefectureCode, tt.limit)
+
+			// Check results
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				ids := make([]int64, len(got))
+				for i, m := range got {
+					ids[i] = m.ID
+				}
+				assert.Equal(t, tt.expectedIDs, ids)
+			}
+		})
+	}
+}
+
+func TestMunicipalityUseCase_ResolveOrganizationCode(t *testing.T) {
+	// Setup
+	mockRepo, mockSuccessionRepo, useCase := setupMunicipalityWithSuccessionTest(t)
+	ctx := context.Background()
+
+	effective := time.Date(2005, 4, 1, 0, 0, 0, 0, time.UTC)
+	current := &model.Municipality{ID: 10, OrganizationCode: "012345", MunicipalityNameKanji: "新市", IsActive: true}
+
+	// Test cases
+	tests := []struct {
+		name             string
+		code             string
+		mockSetup        func(mockRepo *mockdomain.MockMunicipality, mockSuccessionRepo *mockdomain.MockMunicipalitySuccessionRepository)
+		expectedError    bool
+		wantErrorCode    myerrors.ErrorCode
+		expectedCodes    []string
+		expectedResolved string
+	}{
+		{
+			name: "Success/承継なしはそのまま返す",
+			code: "012345",
+			mockSetup: func(mockRepo *mockdomain.MockMunicipality, mockSuccessionRepo *mockdomain.MockMunicipalitySuccessionRepository) {
+				mockSuccessionRepo.EXPECT().FindEffectiveByPredecessorCode(gomock.Any(), "012345", gomock.Any()).
+					Return([]*model.MunicipalitySuccession{}, nil)
+				mockRepo.EXPECT().FindByOrganizationCode(gomock.Any(), "012345").Return(current, nil)
+			},
+			expectedCodes:    []string{},
+			expectedResolved: "012345",
+		},
+		{
+			name: "Success/複数回の合併をたどる",
+			code: "011111",
+			mockSetup: func(mockRepo *mockdomain.MockMunicipality, mockSuccessionRepo *mockdomain.MockMunicipalitySuccessionRepository) {
+				mockSuccessionRepo.EXPECT().FindEffectiveByPredecessorCode(gomock.Any(), "011111", gomock.Any()).
+					Return([]*model.MunicipalitySuccession{
+						{PredecessorCode: "011111", SuccessorCode: "011112", EffectiveDate: effective},
+					}, nil)
+				mockSuccessionRepo.EXPECT().FindEffectiveByPredecessorCode(gomock.Any(), "011112", gomock.Any()).
+					Return([]*model.MunicipalitySuccession{
+						{PredecessorCode: "011112", SuccessorCode: "012345", EffectiveDate: effective.AddDate(3, 0, 0)},
+					}, nil)
+				mockSuccessionRepo.EXPECT().FindEffectiveByPredecessorCode(gomock.Any(), "012345", gomock.Any()).
+					Return([]*model.MunicipalitySuccession{}, nil)
+				mockRepo.EXPECT().FindByOrganizationCode(gomock.Any(), "012345").Return(current, nil)
+			},
+			expectedCodes:    []string{"011112", "012345"},
+			expectedResolved: "012345",
+		},
+		{
+			name: "Error/承継が循環している",
+			code: "011111",
+			mockSetup: func(mockRepo *mockdomain.MockMunicipality, mockSuccessionRepo *mockdomain.MockMunicipalitySuccessionRepository) {
+				mockSuccessionRepo.EXPECT().FindEffectiveByPredecessorCode(gomock.Any(), "011111", gomock.Any()).
+					Return([]*model.MunicipalitySuccession{
+						{PredecessorCode: "011111", SuccessorCode: "011112", EffectiveDate: effective},
+					}, nil)
+				mockSuccessionRepo.EXPECT().FindEffectiveByPredecessorCode(gomock.Any(), "011112", gomock.Any()).
+					Return([]*model.MunicipalitySuccession{
+						{PredecessorCode: "011112", SuccessorCode: "011111", EffectiveDate: effective},
+					}, nil)
+			},
+			expectedError: true,
+			wantErrorCode: myerrors.SuccessionCycleError,
+		},
+		{
+			name: "Error/解決先の市区町村が存在しない",
+			code: "999999",
+			mockSetup: func(mockRepo *mockdomain.MockMunicipality, mockSuccessionRepo *mockdomain.MockMunicipalitySuccessionRepository) {
+				mockSuccessionRepo.EXPECT().FindEffectiveByPredecessorCode(gomock.Any(), "999999", gomock.Any()).
+					Return([]*model.MunicipalitySuccession{}, nil)
+				mockRepo.EXPECT().FindByOrganizationCode(gomock.Any(), "999999").Return(nil, &myerrors.APIError{
+					Code:    myerrors.MunicipalityNotFoundError,
+					Message: myerrors.MunicipalityNotFoundErrorMessage,
+				})
+			},
+			expectedError: true,
+		},
+		{
+			name: "Error/承継情報の取得失敗",
+			code: "011111",
+			mockSetup: func(mockRepo *mockdomain.MockMunicipality, mockSuccessionRepo *mockdomain.MockMunicipalitySuccessionRepository) {
+				mockSuccessionRepo.EXPECT().FindEffectiveByPredecessorCode(gomock.Any(), "011111", gomock.Any()).
+					Return(nil, errors.New("database error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mockRepo, mockSuccessionRepo)
+
+			// Call the method
+			got, err := useCase.ResolveOrganizationCode(ctx, tt.code)
+
+			// Check results
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+				if tt.wantErrorCode != "" {
+					var apiErr *myerrors.APIError
+					require.ErrorAs(t, err, &apiErr)
+					assert.Equal(t, tt.wantErrorCode, apiErr.Code)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResolved, got.Municipality.OrganizationCode)
+				codes := make([]string, len(got.Successions))
+				for i, s := range got.Successions {
+					codes[i] = s.SuccessorCode
+				}
+				assert.Equal(t, tt.expectedCodes, codes)
+			}
+		})
+	}
+}
+
+func TestMunicipalityUseCase_GetMunicipalityStatistics(t *testing.T) {
+	stats := &domain.MunicipalityStatistics{
+		Total:             2,
+		MunicipalityLevel: 2,
+		Active:            1,
+		Inactive:          1,
+		Prefectures: []*domain.PrefectureMunicipalityCount{
+			{PrefectureCode: "13", PrefectureName: "東京都", Total: 2, MunicipalityLevel: 2, Active: 1, Inactive: 1},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		mockSetup func(mockRepo *mockdomain.MockMunicipality)
+		want      *domain.MunicipalityStatistics
+		wantErr   bool
+	}{
+		{
+			name: "Success",
+			mockSetup: func(mockRepo *mockdomain.MockMunicipality) {
+				mockRepo.EXPECT().Statistics(gomock.Any()).Return(stats, nil)
+			},
+			want: stats,
+		},
+		{
+			name: "Repository Error",
+			mockSetup: func(mockRepo *mockdomain.MockMunicipality) {
+				mockRepo.EXPECT().Statistics(gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo, useCase := setupMunicipalityTest(t)
+			tt.mockSetup(mockRepo)
+
+			got, err := useCase.GetMunicipalityStatistics(context.Background())
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
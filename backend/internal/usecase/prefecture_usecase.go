@@ -6,32 +6,109 @@ import (
 
 	"g_gen/internal/domain/model"
 	domain "g_gen/internal/domain/repository"
+	"g_gen/internal/pagination"
 )
 
+// RegionPrefectures 地方区分ごとの都道府県
+type RegionPrefectures struct {
+	Region      *model.Region
+	Prefectures []*model.Prefecture
+}
+
 type PrefectureUseCase interface {
-	ListPrefectures(ctx context.Context) ([]*model.Prefecture, error)
+	ListPrefectures(ctx context.Context, params pagination.Params) ([]*model.Prefecture, pagination.Meta, error)
+	ListPrefecturesByRegion(
+		ctx context.Context,
+		regionID int,
+		params pagination.Params,
+	) ([]*model.Prefecture, pagination.Meta, error)
+	ListPrefecturesGroupedByRegion(
+		ctx context.Context,
+		params pagination.Params,
+	) ([]*RegionPrefectures, pagination.Meta, error)
 	GetPrefectureByCode(ctx context.Context, code string) (*model.Prefecture, error)
 }
 
 type prefectureUseCase struct {
 	prefectureRepository domain.PrefectureRepository
+	regionRepository     domain.RegionRepository
 }
 
 func NewPrefectureUseCase(
 	prefectureRepository domain.PrefectureRepository,
+	regionRepository domain.RegionRepository,
 ) PrefectureUseCase {
 	return &prefectureUseCase{
 		prefectureRepository: prefectureRepository,
+		regionRepository:     regionRepository,
 	}
 }
 
-func (u *prefectureUseCase) ListPrefectures(ctx context.Context) ([]*model.Prefecture, error) {
-	prefectures, err := u.prefectureRepository.FindAll(ctx)
+func (u *prefectureUseCase) ListPrefectures(
+	ctx context.Context,
+	params pagination.Params,
+) ([]*model.Prefecture, pagination.Meta, error) {
+	prefectures, meta, err := u.prefectureRepository.FindAll(ctx, params)
 	if err != nil {
-		return nil, err
+		return nil, pagination.Meta{}, err
+	}
+
+	return prefectures, meta, nil
+}
+
+// ListPrefecturesByRegion 地方区分に属する都道府県を1ページ分取得する。地方区分が存在しない場合はエラーとする
+func (u *prefectureUseCase) ListPrefecturesByRegion(
+	ctx context.Context,
+	regionID int,
+	params pagination.Params,
+) ([]*model.Prefecture, pagination.Meta, error) {
+	if _, err := u.regionRepository.FindByID(ctx, regionID); err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	prefectures, meta, err := u.prefectureRepository.FindByRegionID(ctx, regionID, params)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	return prefectures, meta, nil
+}
+
+// ListPrefecturesGroupedByRegion 地方区分を1ページ分取得し、それぞれに属する都道府県をまとめる
+// ページングの単位は地方区分とし、地方区分に属する都道府県はすべて含める
+func (u *prefectureUseCase) ListPrefecturesGroupedByRegion(
+	ctx context.Context,
+	params pagination.Params,
+) ([]*RegionPrefectures, pagination.Meta, error) {
+	regions, meta, err := u.regionRepository.FindAll(ctx, params)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	groups := make([]*RegionPrefectures, len(regions))
+	groupByRegionID := make(map[int64]*RegionPrefectures, len(regions))
+	regionIDs := make([]int, len(regions))
+	for i, region := range regions {
+		groups[i] = &RegionPrefectures{
+			Region:      region,
+			Prefectures: make([]*model.Prefecture, 0),
+		}
+		groupByRegionID[region.ID] = groups[i]
+		regionIDs[i] = int(region.ID)
+	}
+
+	prefectures, err := u.prefectureRepository.FindByRegionIDs(ctx, regionIDs)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	for _, prefecture := range prefectures {
+		if group, ok := groupByRegionID[prefecture.RegionID]; ok {
+			group.Prefectures = append(group.Prefectures, prefecture)
+		}
 	}
 
-	return prefectures, nil
+	return groups, meta, nil
 }
 
 func (u *prefectureUseCase) GetPrefectureByCode(ctx context.Context, code string) (*model.Prefecture, error) {
@@ -9,21 +9,34 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"g_gen/internal/domain/model"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/pagination"
 	"g_gen/internal/usecase"
 	mockdomain "g_gen/tests/mock/domain"
 )
 
 func setupPrefectureTest(t *testing.T) (*mockdomain.MockPrefectureRepository, usecase.PrefectureUseCase) {
+	mockRepo, _, useCase := setupPrefectureWithRegionTest(t)
+	return mockRepo, useCase
+}
+
+func setupPrefectureWithRegionTest(t *testing.T) (
+	*mockdomain.MockPrefectureRepository,
+	*mockdomain.MockRegionRepository,
+	usecase.PrefectureUseCase,
+) {
 	ctrl := gomock.NewController(t)
 	mockRepo := mockdomain.NewMockPrefectureRepository(ctrl)
-	useCase := usecase.NewPrefectureUseCase(mockRepo)
-	return mockRepo, useCase
+	mockRegionRepo := mockdomain.NewMockRegionRepository(ctrl)
+	useCase := usecase.NewPrefectureUseCase(mockRepo, mockRegionRepo)
+	return mockRepo, mockRegionRepo, useCase
 }
 
 func TestPrefectureUseCase_ListPrefectures(t *testing.T) {
 	// Setup
 	mockRepo, useCase := setupPrefectureTest(t)
 	ctx := context.Background()
+	params := pagination.NewParams(1, 20, nil, nil)
 
 	// Test cases
 	tests := []struct {
@@ -49,7 +62,7 @@ func TestPrefectureUseCase_ListPrefectures(t *testing.T) {
 						Name: "大阪府",
 					},
 				}
-				mockRepo.EXPECT().FindAll(gomock.Any()).Return(prefectures, nil)
+				mockRepo.EXPECT().FindAll(gomock.Any(), params).Return(prefectures, pagination.NewMeta(params, 3, nil), nil)
 			},
 			expectedError: false,
 			expectedLen:   3,
@@ -57,7 +70,7 @@ func TestPrefectureUseCase_ListPrefectures(t *testing.T) {
 		{
 			name: "Error",
 			mockSetup: func(mockRepo *mockdomain.MockPrefectureRepository) {
-				mockRepo.EXPECT().FindAll(gomock.Any()).Return(nil, errors.New("database error"))
+				mockRepo.EXPECT().FindAll(gomock.Any(), params).Return(nil, pagination.Meta{}, errors.New("database error"))
 			},
 			expectedError: true,
 			expectedLen:   0,
@@ -71,7 +84,7 @@ func TestPrefectureUseCase_ListPrefectures(t *testing.T) {
 			tt.mockSetup(mockRepo)
 
 			// Call the method
-			prefectures, err := useCase.ListPrefectures(ctx)
+			prefectures, meta, err := useCase.ListPrefectures(ctx, params)
 
 			// Check results
 			if tt.expectedError {
@@ -81,6 +94,7 @@ func TestPrefectureUseCase_ListPrefectures(t *testing.T) {
 				assert.NoError(t, err)
 				assert.NotNil(t, prefectures)
 				assert.Equal(t, tt.expectedLen, len(prefectures))
+				assert.Equal(t, int64(tt.expectedLen), meta.TotalCount)
 			}
 		})
 	}
@@ -142,3 +156,119 @@ func TestPrefectureUseCase_GetPrefectureByID(t *testing.T) {
 		})
 	}
 }
+
+func TestPrefectureUseCase_ListPrefecturesByRegion(t *testing.T) {
+	// Setup
+	mockRepo, mockRegionRepo, useCase := setupPrefectureWithRegionTest(t)
+	ctx := context.Background()
+	params := pagination.NewParams(1, 20, nil, nil)
+
+	notFound := &myerrors.APIError{
+		Code:    myerrors.RegionNotFoundError,
+		Message: myerrors.RegionNotFoundErrorMessage,
+	}
+
+	// Test cases
+	tests := []struct {
+		name          string
+		regionID      int
+		mockSetup     func(mockRepo *mockdomain.MockPrefectureRepository, mockRegionRepo *mockdomain.MockRegionRepository)
+		expectedError error
+		expectedLen   int
+	}{
+		{
+			name:     "Success",
+			regionID: 6,
+			mockSetup: func(mockRepo *mockdomain.MockPrefectureRepository, mockRegionRepo *mockdomain.MockRegionRepository) {
+				mockRegionRepo.EXPECT().FindByID(gomock.Any(), 6).Return(&model.Region{ID: 6, Name: "四国"}, nil)
+				mockRepo.EXPECT().FindByRegionID(gomock.Any(), 6, params).Return([]*model.Prefecture{
+					{ID: 36, Code: "36", Name: "徳島県", RegionID: 6},
+					{ID: 37, Code: "37", Name: "香川県", RegionID: 6},
+					{ID: 38, Code: "38", Name: "愛媛県", RegionID: 6},
+					{ID: 39, Code: "39", Name: "高知県", RegionID: 6},
+				}, pagination.NewMeta(params, 4, nil), nil)
+			},
+			expectedLen: 4,
+		},
+		{
+			name:     "Not Found",
+			regionID: 99,
+			mockSetup: func(_ *mockdomain.MockPrefectureRepository, mockRegionRepo *mockdomain.MockRegionRepository) {
+				mockRegionRepo.EXPECT().FindByID(gomock.Any(), 99).Return(nil, notFound)
+			},
+			expectedError: notFound,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mockRepo, mockRegionRepo)
+
+			// Call the method
+			prefectures, _, err := useCase.ListPrefecturesByRegion(ctx, tt.regionID, params)
+
+			// Check results
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, prefectures)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedLen, len(prefectures))
+			}
+		})
+	}
+}
+
+func TestPrefectureUseCase_ListPrefecturesGroupedByRegion(t *testing.T) {
+	// Setup
+	mockRepo, mockRegionRepo, useCase := setupPrefectureWithRegionTest(t)
+	ctx := context.Background()
+	params := pagination.NewParams(1, 3, nil, nil)
+
+	t.Run("Success/地方区分の1ページ分ごとにまとめる", func(t *testing.T) {
+		mockRegionRepo.EXPECT().FindAll(gomock.Any(), params).Return([]*model.Region{
+			{ID: 1, Name: "北海道・東北"},
+			{ID: 2, Name: "関東"},
+			{ID: 6, Name: "四国"},
+		}, pagination.NewMeta(params, 7, nil), nil)
+		mockRepo.EXPECT().FindByRegionIDs(gomock.Any(), []int{1, 2, 6}).Return([]*model.Prefecture{
+			{ID: 1, Code: "01", Name: "北海道", RegionID: 1},
+			{ID: 2, Code: "02", Name: "青森県", RegionID: 1},
+			{ID: 13, Code: "13", Name: "東京都", RegionID: 2},
+		}, nil)
+
+		groups, meta, err := useCase.ListPrefecturesGroupedByRegion(ctx, params)
+		assert.NoError(t, err)
+		assert.Len(t, groups, 3)
+		assert.Equal(t, int64(7), meta.TotalCount)
+
+		names := make([][]string, len(groups))
+		for i, g := range groups {
+			names[i] = make([]string, len(g.Prefectures))
+			for j, p := range g.Prefectures {
+				names[i][j] = p.Name
+			}
+		}
+		assert.Equal(t, [][]string{{"北海道", "青森県"}, {"東京都"}, {}}, names)
+	})
+
+	t.Run("Error/地方区分の取得失敗", func(t *testing.T) {
+		mockRegionRepo.EXPECT().FindAll(gomock.Any(), params).Return(nil, pagination.Meta{}, errors.New("database error"))
+
+		groups, _, err := useCase.ListPrefecturesGroupedByRegion(ctx, params)
+		assert.Error(t, err)
+		assert.Nil(t, groups)
+	})
+
+	t.Run("Error/都道府県の取得失敗", func(t *testing.T) {
+		mockRegionRepo.EXPECT().FindAll(gomock.Any(), params).
+			Return([]*model.Region{{ID: 1, Name: "北海道・東北"}}, pagination.NewMeta(params, 7, nil), nil)
+		mockRepo.EXPECT().FindByRegionIDs(gomock.Any(), []int{1}).Return(nil, errors.New("database error"))
+
+		groups, _, err := useCase.ListPrefecturesGroupedByRegion(ctx, params)
+		assert.Error(t, err)
+		assert.Nil(t, groups)
+	})
+}
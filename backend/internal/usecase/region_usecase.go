@@ -0,0 +1,38 @@
+//go:generate mockgen -source=region_usecase.go -destination=../../tests/mock/usecase/region_usecase.mock.go
+package usecase
+
+import (
+	"context"
+
+	"g_gen/internal/domain/model"
+	domain "g_gen/internal/domain/repository"
+	"g_gen/internal/pagination"
+)
+
+type RegionUseCase interface {
+	ListRegions(ctx context.Context, params pagination.Params) ([]*model.Region, pagination.Meta, error)
+}
+
+type regionUseCase struct {
+	regionRepository domain.RegionRepository
+}
+
+func NewRegionUseCase(
+	regionRepository domain.RegionRepository,
+) RegionUseCase {
+	return &regionUseCase{
+		regionRepository: regionRepository,
+	}
+}
+
+func (u *regionUseCase) ListRegions(
+	ctx context.Context,
+	params pagination.Params,
+) ([]*model.Region, pagination.Meta, error) {
+	regions, meta, err := u.regionRepository.FindAll(ctx, params)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	return regions, meta, nil
+}
@@ -0,0 +1,72 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"g_gen/internal/domain/model"
+	"g_gen/internal/pagination"
+	"g_gen/internal/usecase"
+	mockdomain "g_gen/tests/mock/domain"
+)
+
+func TestRegionUseCase_ListRegions(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	mockRepo := mockdomain.NewMockRegionRepository(ctrl)
+	useCase := usecase.NewRegionUseCase(mockRepo)
+	ctx := context.Background()
+	params := pagination.NewParams(1, 20, nil, nil)
+
+	// Test cases
+	tests := []struct {
+		name          string
+		mockSetup     func(mockRepo *mockdomain.MockRegionRepository)
+		expectedError bool
+		expectedLen   int
+	}{
+		{
+			name: "Success",
+			mockSetup: func(mockRepo *mockdomain.MockRegionRepository) {
+				mockRepo.EXPECT().FindAll(gomock.Any(), params).Return([]*model.Region{
+					{ID: 1, Name: "北海道・東北"},
+					{ID: 2, Name: "関東"},
+				}, pagination.NewMeta(params, 2, nil), nil)
+			},
+			expectedError: false,
+			expectedLen:   2,
+		},
+		{
+			name: "Error",
+			mockSetup: func(mockRepo *mockdomain.MockRegionRepository) {
+				mockRepo.EXPECT().FindAll(gomock.Any(), params).Return(nil, pagination.Meta{}, errors.New("database error"))
+			},
+			expectedError: true,
+			expectedLen:   0,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mockRepo)
+
+			// Call the method
+			regions, _, err := useCase.ListRegions(ctx, params)
+
+			// Check results
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, regions)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedLen, len(regions))
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+//go:generate mockgen -source=support_application_usecase.go -destination=../../tests/mock/usecase/support_application_usecase.mock.go
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"g_gen/internal/domain/model"
+	domain "g_gen/internal/domain/repository"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/pagination"
+)
+
+// SupportApplicationAction 支援申請の状態を遷移させる操作
+type SupportApplicationAction string
+
+const (
+	// SupportApplicationActionSubmit 提出。作成中・差戻しの申請を市区町村に提出する
+	SupportApplicationActionSubmit SupportApplicationAction = "submit"
+	// SupportApplicationActionAccept 受付。市区町村が提出された申請の審査を始める
+	SupportApplicationActionAccept SupportApplicationAction = "accept"
+	// SupportApplicationActionForward 進達。市区町村が審査した申請を都道府県に送る
+	SupportApplicationActionForward SupportApplicationAction = "forward"
+	// SupportApplicationActionApprove 承認
+	SupportApplicationActionApprove SupportApplicationAction = "approve"
+	// SupportApplicationActionReject 却下
+	SupportApplicationActionReject SupportApplicationAction = "reject"
+	// SupportApplicationActionReturn 差戻し。申請者に修正を求める
+	SupportApplicationActionReturn SupportApplicationAction = "return"
+)
+
+// supportApplicationTransition 状態遷移表の1行。操作と遷移前の状態の組ごとに、遷移後の状態と操作できる役割を定める
+type supportApplicationTransition struct {
+	action SupportApplicationAction
+	from   string
+	to     string
+	role   string
+}
+
+// supportApplicationTransitions 支援申請の状態遷移表。ここにない操作と状態の組は遷移できない
+// 審査中の申請の却下・差戻しは、その段階で審査している役割だけが行える
+var supportApplicationTransitions = []supportApplicationTransition{
+	{SupportApplicationActionSubmit, domain.SupportApplicationStatusDraft, domain.SupportApplicationStatusSubmitted, domain.RoleApplicant},
+	{SupportApplicationActionSubmit, domain.SupportApplicationStatusReturned, domain.SupportApplicationStatusSubmitted, domain.RoleApplicant},
+	{SupportApplicationActionAccept, domain.SupportApplicationStatusSubmitted, domain.SupportApplicationStatusMunicipalityReview, domain.RoleMunicipality},
+	{SupportApplicationActionForward, domain.SupportApplicationStatusMunicipalityReview, domain.SupportApplicationStatusPrefectureReview, domain.RoleMunicipality},
+	{SupportApplicationActionReject, domain.SupportApplicationStatusMunicipalityReview, domain.SupportApplicationStatusRejected, domain.RoleMunicipality},
+	{SupportApplicationActionReturn, domain.SupportApplicationStatusMunicipalityReview, domain.SupportApplicationStatusReturned, domain.RoleMunicipality},
+	{SupportApplicationActionApprove, domain.SupportApplicationStatusPrefectureReview, domain.SupportApplicationStatusApproved, domain.RolePrefecture},
+	{SupportApplicationActionReject, domain.SupportApplicationStatusPrefectureReview, domain.SupportApplicationStatusRejected, domain.RolePrefecture},
+	{SupportApplicationActionReturn, domain.SupportApplicationStatusPrefectureReview, domain.SupportApplicationStatusReturned, domain.RolePrefecture},
+}
+
+type SupportApplicationUseCase interface {
+	GetSupportApplication(ctx context.Context, id int) (*model.SupportApplication, error)
+	ListSupportApplicationTransitions(
+		ctx context.Context,
+		id int,
+		params pagination.Params,
+	) ([]*model.SupportApplicationTransition, pagination.Meta, error)
+	CreateSupportApplication(ctx context.Context, application *model.SupportApplication) (*model.SupportApplication, error)
+	TransitionSupportApplication(
+		ctx context.Context,
+		id int,
+		action SupportApplicationAction,
+		role string,
+		comment *string,
+	) (*model.SupportApplication, error)
+}
+
+type supportApplicationUseCase struct {
+	supportApplicationRepository domain.SupportApplicationRepository
+	damageReportRepository       domain.DamageReportRepository
+}
+
+func NewSupportApplicationUseCase(
+	supportApplicationRepository domain.SupportApplicationRepository,
+	damageReportRepository domain.DamageReportRepository,
+) SupportApplicationUseCase {
+	return &supportApplicationUseCase{
+		supportApplicationRepository: supportApplicationRepository,
+		damageReportRepository:       damageReportRepository,
+	}
+}
+
+func (u *supportApplicationUseCase) GetSupportApplication(
+	ctx context.Context,
+	id int,
+) (*model.SupportApplication, error) {
+	application, err := u.supportApplicationRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return application, nil
+}
+
+// ListSupportApplicationTransitions 支援申請の遷移履歴を1ページ分取得する。並び順の指定がない場合は遷移した順とする
+func (u *supportApplicationUseCase) ListSupportApplicationTransitions(
+	ctx context.Context,
+	id int,
+	params pagination.Params,
+) ([]*model.SupportApplicationTransition, pagination.Meta, error) {
+	if _, err := u.supportApplicationRepository.FindByID(ctx, id); err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	return u.supportApplicationRepository.FindTransitions(ctx, id, params)
+}
+
+// CreateSupportApplication 被害報告に基づく支援申請を、作成中の状態で登録する
+func (u *supportApplicationUseCase) CreateSupportApplication(
+	ctx context.Context,
+	application *model.SupportApplication,
+) (*model.SupportApplication, error) {
+	if _, err := u.damageReportRepository.FindByID(ctx, int(application.DamageReportID)); err != nil {
+		return nil, err
+	}
+
+	application.Status = domain.SupportApplicationStatusDraft
+	if err := u.supportApplicationRepository.Create(ctx, application); err != nil {
+		return nil, err
+	}
+
+	return u.supportApplicationRepository.FindByID(ctx, int(application.ID))
+}
+
+// TransitionSupportApplication 状態遷移表に従って支援申請の状態を遷移させ、遷移履歴を残す
+// 現在の状態で行えない操作は状態遷移エラー、役割に許可されていない操作は権限エラーとする
+func (u *supportApplicationUseCase) TransitionSupportApplication(
+	ctx context.Context,
+	id int,
+	action SupportApplicationAction,
+	role string,
+	comment *string,
+) (*model.SupportApplication, error) {
+	application, err := u.supportApplicationRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	transition, err := findSupportApplicationTransition(action, application.Status, role)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.supportApplicationRepository.Transition(ctx, &model.SupportApplicationTransition{
+		SupportApplicationID: application.ID,
+		Action:               string(transition.action),
+		FromStatus:           transition.from,
+		ToStatus:             transition.to,
+		ActorRole:            role,
+		Comment:              comment,
+	}); err != nil {
+		return nil, err
+	}
+
+	return u.supportApplicationRepository.FindByID(ctx, id)
+}
+
+// findSupportApplicationTransition 操作と現在の状態に対応する遷移を状態遷移表から探し、役割が操作できるか検証する
+func findSupportApplicationTransition(
+	action SupportApplicationAction,
+	status string,
+	role string,
+) (*supportApplicationTransition, error) {
+	for _, t := range supportApplicationTransitions {
+		if t.action != action || t.from != status {
+			continue
+		}
+
+		if t.role != role {
+			return nil, myerrors.NewAPIError(
+				myerrors.TransitionForbiddenError,
+				myerrors.TransitionForbiddenErrorMessage,
+				fmt.Errorf("role %q cannot %s a %s support application", role, action, status),
+				"forbidden support application transition",
+			)
+		}
+
+		return &t, nil
+	}
+
+	return nil, myerrors.NewAPIError(
+		myerrors.InvalidStateTransitionError,
+		myerrors.InvalidStateTransitionErrorMessage,
+		fmt.Errorf("cannot %s a %s support application", action, status),
+		"invalid support application transition",
+	)
+}
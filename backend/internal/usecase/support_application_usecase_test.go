@@ -0,0 +1,403 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"g_gen/internal/domain/model"
+	domain "g_gen/internal/domain/repository"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/pagination"
+	"g_gen/internal/usecase"
+	mockdomain "g_gen/tests/mock/domain"
+)
+
+// supportApplicationMocks 支援申請のユースケースが利用するリポジトリのモック
+type supportApplicationMocks struct {
+	supportApplication *mockdomain.MockSupportApplicationRepository
+	damageReport       *mockdomain.MockDamageReportRepository
+}
+
+func setupSupportApplicationTest(t *testing.T) (*supportApplicationMocks, usecase.SupportApplicationUseCase) {
+	ctrl := gomock.NewController(t)
+	mocks := &supportApplicationMocks{
+		supportApplication: mockdomain.NewMockSupportApplicationRepository(ctrl),
+		damageReport:       mockdomain.NewMockDamageReportRepository(ctrl),
+	}
+	useCase := usecase.NewSupportApplicationUseCase(mocks.supportApplication, mocks.damageReport)
+	return mocks, useCase
+}
+
+func supportApplicationNotFound() *myerrors.APIError {
+	return &myerrors.APIError{
+		Code:    myerrors.SupportApplicationNotFoundError,
+		Message: myerrors.SupportApplicationNotFoundErrorMessage,
+	}
+}
+
+func TestSupportApplicationUseCase_GetSupportApplication(t *testing.T) {
+	// Setup
+	mocks, useCase := setupSupportApplicationTest(t)
+	ctx := context.Background()
+	notFound := supportApplicationNotFound()
+
+	// Test cases
+	tests := []struct {
+		name          string
+		mockSetup     func(mocks *supportApplicationMocks)
+		expectedError error
+	}{
+		{
+			name: "Success",
+			mockSetup: func(mocks *supportApplicationMocks) {
+				mocks.supportApplication.EXPECT().FindByID(gomock.Any(), 1).Return(&model.SupportApplication{ID: 1}, nil)
+			},
+		},
+		{
+			name: "Not Found",
+			mockSetup: func(mocks *supportApplicationMocks) {
+				mocks.supportApplication.EXPECT().FindByID(gomock.Any(), 1).Return(nil, notFound)
+			},
+			expectedError: notFound,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mocks)
+
+			// Call the method
+			application, err := useCase.GetSupportApplication(ctx, 1)
+
+			// Check results
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, application)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(1), application.ID)
+			}
+		})
+	}
+}
+
+func TestSupportApplicationUseCase_ListSupportApplicationTransitions(t *testing.T) {
+	// Setup
+	mocks, useCase := setupSupportApplicationTest(t)
+	ctx := context.Background()
+	notFound := supportApplicationNotFound()
+	params := pagination.NewParams(1, 20, nil, nil)
+
+	// Test cases
+	tests := []struct {
+		name          string
+		mockSetup     func(mocks *supportApplicationMocks)
+		expectedError error
+		expectedLen   int
+	}{
+		{
+			name: "Success",
+			mockSetup: func(mocks *supportApplicationMocks) {
+				mocks.supportApplication.EXPECT().FindByID(gomock.Any(), 1).Return(&model.SupportApplication{ID: 1}, nil)
+				mocks.supportApplication.EXPECT().FindTransitions(gomock.Any(), 1, params).Return([]*model.SupportApplicationTransition{
+					{ID: 1, SupportApplicationID: 1, Action: "submit"},
+					{ID: 2, SupportApplicationID: 1, Action: "accept"},
+				}, pagination.NewMeta(params, 2, nil), nil)
+			},
+			expectedLen: 2,
+		},
+		{
+			name: "failure/存在しない支援申請",
+			mockSetup: func(mocks *supportApplicationMocks) {
+				mocks.supportApplication.EXPECT().FindByID(gomock.Any(), 1).Return(nil, notFound)
+			},
+			expectedError: notFound,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mocks)
+
+			// Call the method
+			transitions, _, err := useCase.ListSupportApplicationTransitions(ctx, 1, params)
+
+			// Check results
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, transitions)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, transitions, tt.expectedLen)
+			}
+		})
+	}
+}
+
+func TestSupportApplicationUseCase_CreateSupportApplication(t *testing.T) {
+	// Setup
+	mocks, useCase := setupSupportApplicationTest(t)
+	ctx := context.Background()
+
+	// Test cases
+	tests := []struct {
+		name              string
+		mockSetup         func(mocks *supportApplicationMocks)
+		expectedErrorCode myerrors.ErrorCode
+		expectedError     bool
+	}{
+		{
+			name: "Success/作成中の状態で登録",
+			mockSetup: func(mocks *supportApplicationMocks) {
+				mocks.damageReport.EXPECT().FindByID(gomock.Any(), 3).Return(&model.DamageReport{ID: 3}, nil)
+				mocks.supportApplication.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, a *model.SupportApplication) error {
+						assert.Equal(t, domain.SupportApplicationStatusDraft, a.Status)
+						a.ID = 10
+
+						return nil
+					})
+				mocks.supportApplication.EXPECT().FindByID(gomock.Any(), 10).
+					Return(&model.SupportApplication{ID: 10, Status: domain.SupportApplicationStatusDraft}, nil)
+			},
+		},
+		{
+			name: "failure/存在しない被害報告",
+			mockSetup: func(mocks *supportApplicationMocks) {
+				mocks.damageReport.EXPECT().FindByID(gomock.Any(), 3).Return(nil, &myerrors.APIError{
+					Code:    myerrors.DamageReportNotFoundError,
+					Message: myerrors.DamageReportNotFoundErrorMessage,
+				})
+			},
+			expectedErrorCode: myerrors.DamageReportNotFoundError,
+			expectedError:     true,
+		},
+		{
+			name: "failure/登録エラー",
+			mockSetup: func(mocks *supportApplicationMocks) {
+				mocks.damageReport.EXPECT().FindByID(gomock.Any(), 3).Return(&model.DamageReport{ID: 3}, nil)
+				mocks.supportApplication.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mocks)
+
+			// Call the method
+			application, err := useCase.CreateSupportApplication(ctx, &model.SupportApplication{
+				DamageReportID:  3,
+				ApplicantName:   "丸の内 花子",
+				RequestedAmount: 250000,
+			})
+
+			// Check results
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, application)
+
+				if tt.expectedErrorCode != "" {
+					var apiErr *myerrors.APIError
+					assert.ErrorAs(t, err, &apiErr)
+					assert.Equal(t, tt.expectedErrorCode, apiErr.Code)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(10), application.ID)
+			}
+		})
+	}
+}
+
+func TestSupportApplicationUseCase_TransitionSupportApplication(t *testing.T) {
+	// Setup
+	mocks, useCase := setupSupportApplicationTest(t)
+	ctx := context.Background()
+	comment := "書類に不備があります"
+
+	// Test cases
+	tests := []struct {
+		name              string
+		status            string
+		action            usecase.SupportApplicationAction
+		role              string
+		expectedStatus    string
+		expectedErrorCode myerrors.ErrorCode
+	}{
+		{
+			name:           "Success/申請者が作成中の申請を提出",
+			status:         domain.SupportApplicationStatusDraft,
+			action:         usecase.SupportApplicationActionSubmit,
+			role:           domain.RoleApplicant,
+			expectedStatus: domain.SupportApplicationStatusSubmitted,
+		},
+		{
+			name:           "Success/申請者が差戻しの申請を再提出",
+			status:         domain.SupportApplicationStatusReturned,
+			action:         usecase.SupportApplicationActionSubmit,
+			role:           domain.RoleApplicant,
+			expectedStatus: domain.SupportApplicationStatusSubmitted,
+		},
+		{
+			name:           "Success/市区町村が受付",
+			status:         domain.SupportApplicationStatusSubmitted,
+			action:         usecase.SupportApplicationActionAccept,
+			role:           domain.RoleMunicipality,
+			expectedStatus: domain.SupportApplicationStatusMunicipalityReview,
+		},
+		{
+			name:           "Success/市区町村が都道府県に進達",
+			status:         domain.SupportApplicationStatusMunicipalityReview,
+			action:         usecase.SupportApplicationActionForward,
+			role:           domain.RoleMunicipality,
+			expectedStatus: domain.SupportApplicationStatusPrefectureReview,
+		},
+		{
+			name:           "Success/市区町村が却下",
+			status:         domain.SupportApplicationStatusMunicipalityReview,
+			action:         usecase.SupportApplicationActionReject,
+			role:           domain.RoleMunicipality,
+			expectedStatus: domain.SupportApplicationStatusRejected,
+		},
+		{
+			name:           "Success/市区町村が差戻し",
+			status:         domain.SupportApplicationStatusMunicipalityReview,
+			action:         usecase.SupportApplicationActionReturn,
+			role:           domain.RoleMunicipality,
+			expectedStatus: domain.SupportApplicationStatusReturned,
+		},
+		{
+			name:           "Success/都道府県が承認",
+			status:         domain.SupportApplicationStatusPrefectureReview,
+			action:         usecase.SupportApplicationActionApprove,
+			role:           domain.RolePrefecture,
+			expectedStatus: domain.SupportApplicationStatusApproved,
+		},
+		{
+			name:           "Success/都道府県が却下",
+			status:         domain.SupportApplicationStatusPrefectureReview,
+			action:         usecase.SupportApplicationActionReject,
+			role:           domain.RolePrefecture,
+			expectedStatus: domain.SupportApplicationStatusRejected,
+		},
+		{
+			name:           "Success/都道府県が差戻し",
+			status:         domain.SupportApplicationStatusPrefectureReview,
+			action:         usecase.SupportApplicationActionReturn,
+			role:           domain.RolePrefecture,
+			expectedStatus: domain.SupportApplicationStatusReturned,
+		},
+		{
+			name:              "failure/作成中の申請は承認できない",
+			status:            domain.SupportApplicationStatusDraft,
+			action:            usecase.SupportApplicationActionApprove,
+			role:              domain.RolePrefecture,
+			expectedErrorCode: myerrors.InvalidStateTransitionError,
+		},
+		{
+			name:              "failure/提出済みの申請は再提出できない",
+			status:            domain.SupportApplicationStatusSubmitted,
+			action:            usecase.SupportApplicationActionSubmit,
+			role:              domain.RoleApplicant,
+			expectedErrorCode: myerrors.InvalidStateTransitionError,
+		},
+		{
+			name:              "failure/承認済みの申請は差し戻せない",
+			status:            domain.SupportApplicationStatusApproved,
+			action:            usecase.SupportApplicationActionReturn,
+			role:              domain.RolePrefecture,
+			expectedErrorCode: myerrors.InvalidStateTransitionError,
+		},
+		{
+			name:              "failure/申請者は受付できない",
+			status:            domain.SupportApplicationStatusSubmitted,
+			action:            usecase.SupportApplicationActionAccept,
+			role:              domain.RoleApplicant,
+			expectedErrorCode: myerrors.TransitionForbiddenError,
+		},
+		{
+			name:              "failure/市区町村審査中の申請は都道府県が却下できない",
+			status:            domain.SupportApplicationStatusMunicipalityReview,
+			action:            usecase.SupportApplicationActionReject,
+			role:              domain.RolePrefecture,
+			expectedErrorCode: myerrors.TransitionForbiddenError,
+		},
+		{
+			name:              "failure/役割の指定がない",
+			status:            domain.SupportApplicationStatusDraft,
+			action:            usecase.SupportApplicationActionSubmit,
+			role:              "",
+			expectedErrorCode: myerrors.TransitionForbiddenError,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			mocks.supportApplication.EXPECT().FindByID(gomock.Any(), 1).
+				Return(&model.SupportApplication{ID: 1, Status: tt.status}, nil)
+			if tt.expectedErrorCode == "" {
+				mocks.supportApplication.EXPECT().Transition(gomock.Any(), &model.SupportApplicationTransition{
+					SupportApplicationID: 1,
+					Action:               string(tt.action),
+					FromStatus:           tt.status,
+					ToStatus:             tt.expectedStatus,
+					ActorRole:            tt.role,
+					Comment:              &comment,
+				}).Return(nil)
+				mocks.supportApplication.EXPECT().FindByID(gomock.Any(), 1).
+					Return(&model.SupportApplication{ID: 1, Status: tt.expectedStatus}, nil)
+			}
+
+			// Call the method
+			application, err := useCase.TransitionSupportApplication(ctx, 1, tt.action, tt.role, &comment)
+
+			// Check results
+			if tt.expectedErrorCode != "" {
+				var apiErr *myerrors.APIError
+				assert.ErrorAs(t, err, &apiErr)
+				assert.Equal(t, tt.expectedErrorCode, apiErr.Code)
+				assert.Nil(t, application)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, application.Status)
+			}
+		})
+	}
+
+	t.Run("failure/存在しない支援申請", func(t *testing.T) {
+		notFound := supportApplicationNotFound()
+		mocks.supportApplication.EXPECT().FindByID(gomock.Any(), 1).Return(nil, notFound)
+
+		application, err := useCase.TransitionSupportApplication(ctx, 1, usecase.SupportApplicationActionSubmit, domain.RoleApplicant, nil)
+		assert.ErrorIs(t, err, notFound)
+		assert.Nil(t, application)
+	})
+
+	t.Run("failure/遷移中に状態が変わった", func(t *testing.T) {
+		conflict := &myerrors.APIError{
+			Code:    myerrors.InvalidStateTransitionError,
+			Message: myerrors.InvalidStateTransitionErrorMessage,
+		}
+		mocks.supportApplication.EXPECT().FindByID(gomock.Any(), 1).
+			Return(&model.SupportApplication{ID: 1, Status: domain.SupportApplicationStatusDraft}, nil)
+		mocks.supportApplication.EXPECT().Transition(gomock.Any(), gomock.Any()).Return(conflict)
+
+		application, err := useCase.TransitionSupportApplication(ctx, 1, usecase.SupportApplicationActionSubmit, domain.RoleApplicant, nil)
+		assert.ErrorIs(t, err, conflict)
+		assert.Nil(t, application)
+	})
+}
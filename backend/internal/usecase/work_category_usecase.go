@@ -0,0 +1,87 @@
+//go:generate mockgen -source=work_category_usecase.go -destination=../../tests/mock/usecase/work_category_usecase.mock.go
+package usecase
+
+import (
+	"context"
+
+	"g_gen/internal/domain/model"
+	domain "g_gen/internal/domain/repository"
+	"g_gen/internal/pagination"
+)
+
+type WorkCategoryUseCase interface {
+	ListWorkCategories(ctx context.Context, params pagination.Params) ([]*model.WorkCategory, pagination.Meta, error)
+	GetWorkCategory(ctx context.Context, id int) (*model.WorkCategory, error)
+	CreateWorkCategory(ctx context.Context, workCategory *model.WorkCategory) (*model.WorkCategory, error)
+	UpdateWorkCategory(ctx context.Context, workCategory *model.WorkCategory) (*model.WorkCategory, error)
+	DeactivateWorkCategory(ctx context.Context, id int) error
+	ReorderWorkCategories(ctx context.Context, ids []int) ([]*model.WorkCategory, error)
+}
+
+type workCategoryUseCase struct {
+	workCategoryRepository domain.WorkCategoryRepository
+}
+
+func NewWorkCategoryUseCase(
+	workCategoryRepository domain.WorkCategoryRepository,
+) WorkCategoryUseCase {
+	return &workCategoryUseCase{
+		workCategoryRepository: workCategoryRepository,
+	}
+}
+
+func (u *workCategoryUseCase) ListWorkCategories(
+	ctx context.Context,
+	params pagination.Params,
+) ([]*model.WorkCategory, pagination.Meta, error) {
+	workCategories, meta, err := u.workCategoryRepository.FindActive(ctx, params)
+	if err != nil {
+		return nil, pagination.Meta{}, err
+	}
+
+	return workCategories, meta, nil
+}
+
+func (u *workCategoryUseCase) GetWorkCategory(ctx context.Context, id int) (*model.WorkCategory, error) {
+	workCategory, err := u.workCategoryRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return workCategory, nil
+}
+
+func (u *workCategoryUseCase) CreateWorkCategory(
+	ctx context.Context,
+	workCategory *model.WorkCategory,
+) (*model.WorkCategory, error) {
+	workCategory.IsActive = true
+	if err := u.workCategoryRepository.Create(ctx, workCategory); err != nil {
+		return nil, err
+	}
+
+	return workCategory, nil
+}
+
+func (u *workCategoryUseCase) UpdateWorkCategory(
+	ctx context.Context,
+	workCategory *model.WorkCategory,
+) (*model.WorkCategory, error) {
+	if err := u.workCategoryRepository.Update(ctx, workCategory); err != nil {
+		return nil, err
+	}
+
+	return u.workCategoryRepository.FindByID(ctx, int(workCategory.ID))
+}
+
+func (u *workCategoryUseCase) DeactivateWorkCategory(ctx context.Context, id int) error {
+	return u.workCategoryRepository.Deactivate(ctx, id)
+}
+
+func (u *workCategoryUseCase) ReorderWorkCategories(ctx context.Context, ids []int) ([]*model.WorkCategory, error) {
+	if err := u.workCategoryRepository.Reorder(ctx, ids); err != nil {
+		return nil, err
+	}
+
+	return u.workCategoryRepository.FindAllActive(ctx)
+}
@@ -0,0 +1,256 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"g_gen/internal/domain/model"
+	myerrors "g_gen/internal/errors"
+	"g_gen/internal/pagination"
+	"g_gen/internal/usecase"
+	mockdomain "g_gen/tests/mock/domain"
+)
+
+func setupWorkCategoryTest(t *testing.T) (*mockdomain.MockWorkCategoryRepository, usecase.WorkCategoryUseCase) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mockdomain.NewMockWorkCategoryRepository(ctrl)
+	useCase := usecase.NewWorkCategoryUseCase(mockRepo)
+	return mockRepo, useCase
+}
+
+func TestWorkCategoryUseCase_ListWorkCategories(t *testing.T) {
+	// Setup
+	mockRepo, useCase := setupWorkCategoryTest(t)
+	ctx := context.Background()
+	params := pagination.NewParams(1, 20, nil, nil)
+
+	// Test cases
+	tests := []struct {
+		name          string
+		mockSetup     func(mockRepo *mockdomain.MockWorkCategoryRepository)
+		expectedError bool
+		expectedLen   int
+	}{
+		{
+			name: "Success",
+			mockSetup: func(mockRepo *mockdomain.MockWorkCategoryRepository) {
+				workCategories := []*model.WorkCategory{
+					{ID: 1, CategoryName: "農地", SortOrder: 10, IsActive: true},
+					{ID: 2, CategoryName: "水路", SortOrder: 20, IsActive: true},
+				}
+				mockRepo.EXPECT().FindActive(gomock.Any(), params).Return(workCategories, pagination.NewMeta(params, 2, nil), nil)
+			},
+			expectedError: false,
+			expectedLen:   2,
+		},
+		{
+			name: "Error",
+			mockSetup: func(mockRepo *mockdomain.MockWorkCategoryRepository) {
+				mockRepo.EXPECT().FindActive(gomock.Any(), params).Return(nil, pagination.Meta{}, errors.New("database error"))
+			},
+			expectedError: true,
+			expectedLen:   0,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mockRepo)
+
+			// Call the method
+			workCategories, _, err := useCase.ListWorkCategories(ctx, params)
+
+			// Check results
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, workCategories)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedLen, len(workCategories))
+			}
+		})
+	}
+}
+
+func TestWorkCategoryUseCase_CreateWorkCategory(t *testing.T) {
+	// Setup
+	mockRepo, useCase := setupWorkCategoryTest(t)
+	ctx := context.Background()
+
+	// Test cases
+	tests := []struct {
+		name          string
+		mockSetup     func(mockRepo *mockdomain.MockWorkCategoryRepository)
+		expectedError bool
+	}{
+		{
+			name: "Success/有効状態で登録",
+			mockSetup: func(mockRepo *mockdomain.MockWorkCategoryRepository) {
+				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, w *model.WorkCategory) error {
+						assert.True(t, w.IsActive)
+						w.ID = 10
+
+						return nil
+					})
+			},
+			expectedError: false,
+		},
+		{
+			name: "Error",
+			mockSetup: func(mockRepo *mockdomain.MockWorkCategoryRepository) {
+				mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mockRepo)
+
+			// Call the method
+			workCategory, err := useCase.CreateWorkCategory(ctx, &model.WorkCategory{
+				CategoryName: "護岸",
+				SortOrder:    100,
+			})
+
+			// Check results
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, workCategory)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(10), workCategory.ID)
+			}
+		})
+	}
+}
+
+func TestWorkCategoryUseCase_UpdateWorkCategory(t *testing.T) {
+	// Setup
+	mockRepo, useCase := setupWorkCategoryTest(t)
+	ctx := context.Background()
+
+	notFound := &myerrors.APIError{
+		Code:    myerrors.WorkCategoryNotFoundError,
+		Message: myerrors.WorkCategoryNotFoundErrorMessage,
+	}
+
+	// Test cases
+	tests := []struct {
+		name          string
+		mockSetup     func(mockRepo *mockdomain.MockWorkCategoryRepository)
+		expectedError bool
+	}{
+		{
+			name: "Success",
+			mockSetup: func(mockRepo *mockdomain.MockWorkCategoryRepository) {
+				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+				mockRepo.EXPECT().FindByID(gomock.Any(), 1).Return(&model.WorkCategory{
+					ID:           1,
+					CategoryName: "農地（更新）",
+					SortOrder:    15,
+					IsActive:     true,
+				}, nil)
+			},
+			expectedError: false,
+		},
+		{
+			name: "Not Found",
+			mockSetup: func(mockRepo *mockdomain.MockWorkCategoryRepository) {
+				mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(notFound)
+			},
+			expectedError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mockRepo)
+
+			// Call the method
+			workCategory, err := useCase.UpdateWorkCategory(ctx, &model.WorkCategory{
+				ID:           1,
+				CategoryName: "農地（更新）",
+				SortOrder:    15,
+			})
+
+			// Check results
+			if tt.expectedError {
+				assert.ErrorIs(t, err, notFound)
+				assert.Nil(t, workCategory)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "農地（更新）", workCategory.CategoryName)
+			}
+		})
+	}
+}
+
+func TestWorkCategoryUseCase_ReorderWorkCategories(t *testing.T) {
+	// Setup
+	mockRepo, useCase := setupWorkCategoryTest(t)
+	ctx := context.Background()
+
+	// Test cases
+	tests := []struct {
+		name          string
+		ids           []int
+		mockSetup     func(mockRepo *mockdomain.MockWorkCategoryRepository)
+		expectedError bool
+		expectedLen   int
+	}{
+		{
+			name: "Success/並び替え後の一覧を返す",
+			ids:  []int{2, 1},
+			mockSetup: func(mockRepo *mockdomain.MockWorkCategoryRepository) {
+				mockRepo.EXPECT().Reorder(gomock.Any(), []int{2, 1}).Return(nil)
+				mockRepo.EXPECT().FindAllActive(gomock.Any()).Return([]*model.WorkCategory{
+					{ID: 2, CategoryName: "水路", SortOrder: 10, IsActive: true},
+					{ID: 1, CategoryName: "農地", SortOrder: 20, IsActive: true},
+				}, nil)
+			},
+			expectedError: false,
+			expectedLen:   2,
+		},
+		{
+			name: "Error/並び替え失敗",
+			ids:  []int{1},
+			mockSetup: func(mockRepo *mockdomain.MockWorkCategoryRepository) {
+				mockRepo.EXPECT().Reorder(gomock.Any(), []int{1}).Return(errors.New("database error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mock
+			tt.mockSetup(mockRepo)
+
+			// Call the method
+			workCategories, err := useCase.ReorderWorkCategories(ctx, tt.ids)
+
+			// Check results
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, workCategories)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedLen, len(workCategories))
+			}
+		})
+	}
+}
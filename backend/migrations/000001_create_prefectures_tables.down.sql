@@ -0,0 +1,2 @@
+-- 都道府県マスタテーブル
+DROP TABLE IF EXISTS prefectures;
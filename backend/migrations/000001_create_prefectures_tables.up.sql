@@ -1,5 +1,4 @@
 -- 都道府県マスタテーブル
-DROP TABLE IF EXISTS prefectures CASCADE;
 CREATE TABLE IF NOT EXISTS prefectures
 (
     id   BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
@@ -62,4 +61,5 @@ VALUES ('北海道', '01'),
        ('大分県', '44'),
        ('宮崎県', '45'),
        ('鹿児島県', '46'),
-       ('沖縄県', '47');
+       ('沖縄県', '47')
+ON CONFLICT (code) DO NOTHING;
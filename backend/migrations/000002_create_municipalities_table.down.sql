@@ -0,0 +1,2 @@
+-- 市町村マスタテーブル
+DROP TABLE IF EXISTS municipalities;
@@ -1,6 +1,5 @@
 -- 市町村マスタテーブル
 -- 全国の都道府県・市区町村の基本情報を管理する
-DROP TABLE IF EXISTS municipalities CASCADE;
 CREATE TABLE IF NOT EXISTS municipalities
 (
     id                      BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,     -- 内部ID（主キー、自動採番）
@@ -0,0 +1,2 @@
+-- 工種区分マスタ
+DROP TABLE IF EXISTS work_categories;
@@ -1,5 +1,4 @@
 -- 工種区分マスタ
-DROP TABLE IF EXISTS work_categories CASCADE;
 CREATE TABLE work_categories
 (
     id            BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY, -- 工種区分ID（主キー、自動掲番）
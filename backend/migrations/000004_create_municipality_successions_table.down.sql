@@ -0,0 +1,2 @@
+-- 市区町村承継テーブル
+DROP TABLE IF EXISTS municipality_successions;
@@ -0,0 +1,24 @@
+-- 市区町村承継テーブル
+-- 合併・編入などで廃止された団体コードと、その承継先の団体コードを管理する
+CREATE TABLE IF NOT EXISTS municipality_successions
+(
+    id               BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,                     -- 内部ID（主キー、自動採番）
+    predecessor_code VARCHAR(6) NOT NULL,                                                 -- 旧団体コード（廃止された団体コード）
+    successor_code   VARCHAR(6) NOT NULL REFERENCES municipalities (organization_code),  -- 承継先団体コード
+    effective_date   DATE       NOT NULL,                                                 -- 施行日（この日以降は承継先の団体コードを使用する）
+    UNIQUE (predecessor_code, successor_code),
+    CHECK (predecessor_code <> successor_code)
+);
+
+-- インデックス作成
+CREATE INDEX IF NOT EXISTS idx_municipality_successions_predecessor_code ON municipality_successions (predecessor_code);
+CREATE INDEX IF NOT EXISTS idx_municipality_successions_successor_code ON municipality_successions (successor_code);
+
+-- テーブルコメント
+COMMENT ON TABLE municipality_successions IS '市区町村承継テーブル - 合併などによる旧団体コードと承継先団体コードの対応を管理';
+
+-- カラムコメント
+COMMENT ON COLUMN municipality_successions.id IS '内部ID（主キー、自動採番）';
+COMMENT ON COLUMN municipality_successions.predecessor_code IS '旧団体コード（廃止された団体コード）';
+COMMENT ON COLUMN municipality_successions.successor_code IS '承継先団体コード（外部キー、市町村マスタの団体コード）';
+COMMENT ON COLUMN municipality_successions.effective_date IS '施行日';
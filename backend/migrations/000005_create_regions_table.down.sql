@@ -0,0 +1,6 @@
+-- 都道府県マスタの地方区分
+DROP INDEX IF EXISTS idx_prefectures_region_id;
+ALTER TABLE prefectures DROP COLUMN IF EXISTS region_id;
+
+-- 地方区分マスタテーブル
+DROP TABLE IF EXISTS regions;
@@ -0,0 +1,43 @@
+-- 地方区分マスタテーブル
+CREATE TABLE IF NOT EXISTS regions
+(
+    id   BIGINT PRIMARY KEY,          -- 地方区分ID（1=北海道・東北 〜 7=九州・沖縄）
+    name VARCHAR(10) NOT NULL UNIQUE  -- 地方区分名
+);
+
+-- テーブルコメント
+COMMENT ON TABLE regions IS '地方区分マスタテーブル - 都道府県を集計するための地方区分を管理';
+
+-- カラムコメント
+COMMENT ON COLUMN regions.id IS '地方区分ID';
+COMMENT ON COLUMN regions.name IS '地方区分名';
+
+-- 地方区分マスタデータのINSERT
+INSERT INTO regions (id, name)
+VALUES (1, '北海道・東北'),
+       (2, '関東'),
+       (3, '中部'),
+       (4, '近畿'),
+       (5, '中国'),
+       (6, '四国'),
+       (7, '九州・沖縄')
+ON CONFLICT (id) DO NOTHING;
+
+-- 都道府県マスタに地方区分を追加
+ALTER TABLE prefectures ADD COLUMN IF NOT EXISTS region_id BIGINT REFERENCES regions (id);
+
+UPDATE prefectures
+SET region_id = CASE
+                    WHEN code BETWEEN '01' AND '07' THEN 1
+                    WHEN code BETWEEN '08' AND '14' THEN 2
+                    WHEN code BETWEEN '15' AND '23' THEN 3
+                    WHEN code BETWEEN '24' AND '30' THEN 4
+                    WHEN code BETWEEN '31' AND '35' THEN 5
+                    WHEN code BETWEEN '36' AND '39' THEN 6
+                    WHEN code BETWEEN '40' AND '47' THEN 7
+    END;
+
+ALTER TABLE prefectures ALTER COLUMN region_id SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_prefectures_region_id ON prefectures (region_id);
+COMMENT ON COLUMN prefectures.region_id IS '地方区分ID（外部キー、地方区分マスタのID）';
@@ -0,0 +1,18 @@
+-- 市区町村の変更時に都道府県の更新日時を更新するトリガー
+DROP TRIGGER IF EXISTS trg_municipalities_touch_prefecture ON municipalities;
+DROP FUNCTION IF EXISTS touch_prefecture_updated_at();
+
+-- 工種区分マスタ
+DROP TRIGGER IF EXISTS trg_work_categories_updated_at ON work_categories;
+ALTER TABLE work_categories DROP COLUMN IF EXISTS updated_at;
+
+-- 市区町村マスタ
+DROP TRIGGER IF EXISTS trg_municipalities_updated_at ON municipalities;
+ALTER TABLE municipalities DROP COLUMN IF EXISTS updated_at;
+
+-- 都道府県マスタ
+DROP TRIGGER IF EXISTS trg_prefectures_updated_at ON prefectures;
+ALTER TABLE prefectures DROP COLUMN IF EXISTS updated_at;
+
+-- 行の更新時に updated_at を現在日時に更新するトリガー関数
+DROP FUNCTION IF EXISTS set_updated_at();
@@ -0,0 +1,60 @@
+-- マスタデータの更新日時（条件付きGETのLast-Modifiedに使用）
+
+-- 行の更新時に updated_at を現在日時に更新するトリガー関数
+CREATE OR REPLACE FUNCTION set_updated_at() RETURNS TRIGGER AS
+$$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- 都道府県マスタ
+ALTER TABLE prefectures ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP;
+COMMENT ON COLUMN prefectures.updated_at IS '更新日時';
+
+DROP TRIGGER IF EXISTS trg_prefectures_updated_at ON prefectures;
+CREATE TRIGGER trg_prefectures_updated_at
+    BEFORE UPDATE ON prefectures
+    FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+-- 市区町村マスタ
+ALTER TABLE municipalities ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP;
+COMMENT ON COLUMN municipalities.updated_at IS '更新日時';
+
+DROP TRIGGER IF EXISTS trg_municipalities_updated_at ON municipalities;
+CREATE TRIGGER trg_municipalities_updated_at
+    BEFORE UPDATE ON municipalities
+    FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+-- 工種区分マスタ
+ALTER TABLE work_categories ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP;
+COMMENT ON COLUMN work_categories.updated_at IS '更新日時';
+
+DROP TRIGGER IF EXISTS trg_work_categories_updated_at ON work_categories;
+CREATE TRIGGER trg_work_categories_updated_at
+    BEFORE UPDATE ON work_categories
+    FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+-- 都道府県の詳細は有効な市区町村を含むため、市区町村の追加・更新・削除時に都道府県の更新日時も更新する
+CREATE OR REPLACE FUNCTION touch_prefecture_updated_at() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        UPDATE prefectures SET updated_at = CURRENT_TIMESTAMP WHERE code = OLD.prefecture_code;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        UPDATE prefectures SET updated_at = CURRENT_TIMESTAMP WHERE code = NEW.prefecture_code;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_municipalities_touch_prefecture ON municipalities;
+CREATE TRIGGER trg_municipalities_touch_prefecture
+    AFTER INSERT OR UPDATE OR DELETE ON municipalities
+    FOR EACH ROW
+EXECUTE FUNCTION touch_prefecture_updated_at();
@@ -0,0 +1,2 @@
+-- 被害報告
+DROP TABLE IF EXISTS damage_reports;
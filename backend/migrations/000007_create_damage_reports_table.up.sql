@@ -0,0 +1,45 @@
+-- 被害報告
+-- 市区町村が報告する農業災害の被害を、被災箇所・工種区分ごとに管理する
+CREATE TABLE IF NOT EXISTS damage_reports
+(
+    id                BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,                       -- 被害報告ID（主キー、自動採番）
+    organization_code VARCHAR(6)     NOT NULL REFERENCES municipalities (organization_code), -- 団体コード（報告する市区町村）
+    work_category_id  BIGINT         NOT NULL REFERENCES work_categories (id),               -- 工種区分ID
+    damage_date       DATE           NOT NULL,                                               -- 被災日
+    location          VARCHAR(200)   NOT NULL,                                               -- 被災箇所（地名・地番など）
+    estimated_amount  BIGINT         NOT NULL DEFAULT 0,                                     -- 被害見込額（円）
+    area              NUMERIC(12, 2),                                                        -- 被害面積（㎡、施設の被害などで面積がない場合はNULL）
+    status            VARCHAR(20)    NOT NULL DEFAULT 'draft',                               -- 状態
+    created_at        TIMESTAMPTZ    NOT NULL DEFAULT CURRENT_TIMESTAMP,                     -- 作成日時
+    updated_at        TIMESTAMPTZ    NOT NULL DEFAULT CURRENT_TIMESTAMP,                     -- 更新日時
+    CHECK (estimated_amount >= 0),
+    CHECK (area >= 0),
+    CHECK (status IN ('draft', 'reported', 'confirmed', 'withdrawn'))
+);
+
+-- インデックス作成
+CREATE INDEX IF NOT EXISTS idx_damage_reports_organization_code ON damage_reports (organization_code);
+CREATE INDEX IF NOT EXISTS idx_damage_reports_work_category_id ON damage_reports (work_category_id);
+CREATE INDEX IF NOT EXISTS idx_damage_reports_damage_date ON damage_reports (damage_date);
+CREATE INDEX IF NOT EXISTS idx_damage_reports_status ON damage_reports (status);
+
+-- テーブルコメント
+COMMENT ON TABLE damage_reports IS '被害報告 - 市区町村が報告する農業災害の被害を管理';
+
+-- カラムコメント
+COMMENT ON COLUMN damage_reports.id IS '被害報告ID（主キー、自動採番）';
+COMMENT ON COLUMN damage_reports.organization_code IS '団体コード（外部キー、報告する市区町村）';
+COMMENT ON COLUMN damage_reports.work_category_id IS '工種区分ID（外部キー、工種区分マスタのID）';
+COMMENT ON COLUMN damage_reports.damage_date IS '被災日';
+COMMENT ON COLUMN damage_reports.location IS '被災箇所（地名・地番など）';
+COMMENT ON COLUMN damage_reports.estimated_amount IS '被害見込額（円）';
+COMMENT ON COLUMN damage_reports.area IS '被害面積（㎡）';
+COMMENT ON COLUMN damage_reports.status IS '状態（draft: 作成中、reported: 報告済み、confirmed: 確定、withdrawn: 取り下げ）';
+COMMENT ON COLUMN damage_reports.created_at IS '作成日時';
+COMMENT ON COLUMN damage_reports.updated_at IS '更新日時';
+
+DROP TRIGGER IF EXISTS trg_damage_reports_updated_at ON damage_reports;
+CREATE TRIGGER trg_damage_reports_updated_at
+    BEFORE UPDATE ON damage_reports
+    FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
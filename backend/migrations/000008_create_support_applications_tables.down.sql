@@ -0,0 +1,5 @@
+-- 支援申請の状態遷移履歴
+DROP TABLE IF EXISTS support_application_transitions;
+
+-- 支援申請
+DROP TABLE IF EXISTS support_applications;
@@ -0,0 +1,66 @@
+-- 支援申請
+-- 被害報告に基づいて農業者が申請する支援を、市区町村・都道府県の審査の状態とあわせて管理する
+CREATE TABLE IF NOT EXISTS support_applications
+(
+    id               BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,      -- 支援申請ID（主キー、自動採番）
+    damage_report_id BIGINT       NOT NULL REFERENCES damage_reports (id), -- 被害報告ID
+    applicant_name   VARCHAR(100) NOT NULL,                                -- 申請者名
+    requested_amount BIGINT       NOT NULL DEFAULT 0,                      -- 申請額（円）
+    status           VARCHAR(30)  NOT NULL DEFAULT 'draft',                -- 状態
+    created_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,      -- 作成日時
+    updated_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,      -- 更新日時
+    CHECK (requested_amount >= 0),
+    CHECK (status IN ('draft', 'submitted', 'municipality_review', 'prefecture_review', 'approved', 'rejected', 'returned'))
+);
+
+-- インデックス作成
+CREATE INDEX IF NOT EXISTS idx_support_applications_damage_report_id ON support_applications (damage_report_id);
+CREATE INDEX IF NOT EXISTS idx_support_applications_status ON support_applications (status);
+
+-- テーブルコメント
+COMMENT ON TABLE support_applications IS '支援申請 - 被害報告に基づく支援の申請と審査の状態を管理';
+
+-- カラムコメント
+COMMENT ON COLUMN support_applications.id IS '支援申請ID（主キー、自動採番）';
+COMMENT ON COLUMN support_applications.damage_report_id IS '被害報告ID（外部キー、申請の元になる被害報告）';
+COMMENT ON COLUMN support_applications.applicant_name IS '申請者名';
+COMMENT ON COLUMN support_applications.requested_amount IS '申請額（円）';
+COMMENT ON COLUMN support_applications.status IS '状態（draft: 作成中、submitted: 提出済み、municipality_review: 市区町村審査中、prefecture_review: 都道府県審査中、approved: 承認、rejected: 却下、returned: 差戻し）';
+COMMENT ON COLUMN support_applications.created_at IS '作成日時';
+COMMENT ON COLUMN support_applications.updated_at IS '更新日時';
+
+DROP TRIGGER IF EXISTS trg_support_applications_updated_at ON support_applications;
+CREATE TRIGGER trg_support_applications_updated_at
+    BEFORE UPDATE ON support_applications
+    FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+-- 支援申請の状態遷移履歴
+-- 状態を遷移させた操作と操作した利用者の役割を、遷移ごとに記録する
+CREATE TABLE IF NOT EXISTS support_application_transitions
+(
+    id                     BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,                            -- 内部ID（主キー、自動採番）
+    support_application_id BIGINT       NOT NULL REFERENCES support_applications (id) ON DELETE CASCADE, -- 支援申請ID
+    action                 VARCHAR(30)  NOT NULL,                                                      -- 操作
+    from_status            VARCHAR(30)  NOT NULL,                                                      -- 遷移前の状態
+    to_status              VARCHAR(30)  NOT NULL,                                                      -- 遷移後の状態
+    actor_role             VARCHAR(20)  NOT NULL,                                                      -- 操作した利用者の役割
+    comment                VARCHAR(500),                                                               -- コメント（差戻し・却下の理由など）
+    created_at             TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP                             -- 遷移日時
+);
+
+-- インデックス作成
+CREATE INDEX IF NOT EXISTS idx_support_application_transitions_support_application_id ON support_application_transitions (support_application_id);
+
+-- テーブルコメント
+COMMENT ON TABLE support_application_transitions IS '支援申請の状態遷移履歴 - 状態の遷移ごとに操作と操作した利用者の役割を記録';
+
+-- カラムコメント
+COMMENT ON COLUMN support_application_transitions.id IS '内部ID（主キー、自動採番）';
+COMMENT ON COLUMN support_application_transitions.support_application_id IS '支援申請ID（外部キー、遷移した支援申請）';
+COMMENT ON COLUMN support_application_transitions.action IS '操作（submit: 提出、accept: 受付、forward: 進達、approve: 承認、reject: 却下、return: 差戻し）';
+COMMENT ON COLUMN support_application_transitions.from_status IS '遷移前の状態';
+COMMENT ON COLUMN support_application_transitions.to_status IS '遷移後の状態';
+COMMENT ON COLUMN support_application_transitions.actor_role IS '操作した利用者の役割（applicant: 申請者、municipality: 市区町村、prefecture: 都道府県）';
+COMMENT ON COLUMN support_application_transitions.comment IS 'コメント（差戻し・却下の理由など）';
+COMMENT ON COLUMN support_application_transitions.created_at IS '遷移日時';
@@ -0,0 +1,2 @@
+-- 被害報告の添付ファイル
+DROP TABLE IF EXISTS damage_report_attachments;
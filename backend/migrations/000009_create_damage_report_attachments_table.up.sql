@@ -0,0 +1,28 @@
+-- 被害報告の添付ファイル
+-- 被害報告に添付した写真・書類のメタデータを管理する。ファイル本体はストレージに保存する
+CREATE TABLE IF NOT EXISTS damage_report_attachments
+(
+    id               BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,                         -- 添付ファイルID（主キー、自動採番）
+    damage_report_id BIGINT       NOT NULL REFERENCES damage_reports (id) ON DELETE CASCADE, -- 被害報告ID
+    file_name        VARCHAR(255) NOT NULL,                                                   -- ファイル名（アップロード時の名前）
+    content_type     VARCHAR(100) NOT NULL,                                                   -- ファイルの内容から判定したMIMEタイプ
+    size             BIGINT       NOT NULL,                                                   -- ファイルサイズ（バイト）
+    storage_key      VARCHAR(255) NOT NULL UNIQUE,                                            -- ストレージ上のキー
+    created_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,                         -- 登録日時
+    CHECK (size > 0)
+);
+
+-- インデックス作成
+CREATE INDEX IF NOT EXISTS idx_damage_report_attachments_damage_report_id ON damage_report_attachments (damage_report_id);
+
+-- テーブルコメント
+COMMENT ON TABLE damage_report_attachments IS '被害報告の添付ファイル - 被害報告に添付した写真・書類のメタデータを管理';
+
+-- カラムコメント
+COMMENT ON COLUMN damage_report_attachments.id IS '添付ファイルID（主キー、自動採番）';
+COMMENT ON COLUMN damage_report_attachments.damage_report_id IS '被害報告ID（外部キー、添付先の被害報告）';
+COMMENT ON COLUMN damage_report_attachments.file_name IS 'ファイル名（アップロード時の名前）';
+COMMENT ON COLUMN damage_report_attachments.content_type IS 'MIMEタイプ（ファイルの内容から判定）';
+COMMENT ON COLUMN damage_report_attachments.size IS 'ファイルサイズ（バイト）';
+COMMENT ON COLUMN damage_report_attachments.storage_key IS 'ストレージ上のキー';
+COMMENT ON COLUMN damage_report_attachments.created_at IS '登録日時';
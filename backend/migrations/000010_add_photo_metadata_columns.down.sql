@@ -0,0 +1,12 @@
+-- 被害報告
+ALTER TABLE damage_reports
+    DROP CONSTRAINT IF EXISTS damage_reports_coordinates_check,
+    DROP COLUMN IF EXISTS longitude,
+    DROP COLUMN IF EXISTS latitude;
+
+-- 被害報告の添付ファイル
+ALTER TABLE damage_report_attachments
+    DROP COLUMN IF EXISTS thumbnail_key,
+    DROP COLUMN IF EXISTS longitude,
+    DROP COLUMN IF EXISTS latitude,
+    DROP COLUMN IF EXISTS captured_at;
@@ -0,0 +1,23 @@
+-- 写真の撮影情報とサムネイル
+-- 添付した写真のEXIFから読み取った撮影日時・撮影位置と、一覧表示用のサムネイルのキーを保存する
+
+-- 被害報告の添付ファイル
+ALTER TABLE damage_report_attachments
+    ADD COLUMN IF NOT EXISTS captured_at   TIMESTAMPTZ,                                                    -- 撮影日時（EXIF）
+    ADD COLUMN IF NOT EXISTS latitude      DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),           -- 撮影位置の緯度（EXIF）
+    ADD COLUMN IF NOT EXISTS longitude     DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180),        -- 撮影位置の経度（EXIF）
+    ADD COLUMN IF NOT EXISTS thumbnail_key VARCHAR(255);                                                   -- サムネイルのストレージ上のキー
+
+COMMENT ON COLUMN damage_report_attachments.captured_at IS '撮影日時（写真のEXIF、ない場合はNULL）';
+COMMENT ON COLUMN damage_report_attachments.latitude IS '撮影位置の緯度（写真のEXIF、ない場合はNULL）';
+COMMENT ON COLUMN damage_report_attachments.longitude IS '撮影位置の経度（写真のEXIF、ない場合はNULL）';
+COMMENT ON COLUMN damage_report_attachments.thumbnail_key IS 'サムネイルのストレージ上のキー（写真以外・生成前はNULL）';
+
+-- 被害報告
+ALTER TABLE damage_reports
+    ADD COLUMN IF NOT EXISTS latitude  DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),    -- 被災箇所の緯度
+    ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180), -- 被災箇所の経度
+    ADD CONSTRAINT damage_reports_coordinates_check CHECK ((latitude IS NULL) = (longitude IS NULL));
+
+COMMENT ON COLUMN damage_reports.latitude IS '被災箇所の緯度（写真の撮影位置から設定、未設定の場合はNULL）';
+COMMENT ON COLUMN damage_reports.longitude IS '被災箇所の経度（写真の撮影位置から設定、未設定の場合はNULL）';
@@ -0,0 +1,9 @@
+// Package migrations データベースのマイグレーションファイルをバイナリに埋め込む
+package migrations
+
+import "embed"
+
+// FS マイグレーションファイル（{バージョン}_{名前}.up.sql / {バージョン}_{名前}.down.sql）
+//
+//go:embed *.sql
+var FS embed.FS
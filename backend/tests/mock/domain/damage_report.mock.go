@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: damage_report.go
+//
+// Generated by this command:
+//
+//	mockgen -source=damage_report.go -destination=../../../tests/mock/domain/damage_report.mock.go
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	context "context"
+	model "g_gen/internal/domain/model"
+	pagination "g_gen/internal/pagination"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDamageReportRepository is a mock of DamageReportRepository interface.
+type MockDamageReportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDamageReportRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockDamageReportRepositoryMockRecorder is the mock recorder for MockDamageReportRepository.
+type MockDamageReportRepositoryMockRecorder struct {
+	mock *MockDamageReportRepository
+}
+
+// NewMockDamageReportRepository creates a new mock instance.
+func NewMockDamageReportRepository(ctrl *gomock.Controller) *MockDamageReportRepository {
+	mock := &MockDamageReportRepository{ctrl: ctrl}
+	mock.recorder = &MockDamageReportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDamageReportRepository) EXPECT() *MockDamageReportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDamageReportRepository) Create(ctx context.Context, damageReport *model.DamageReport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, damageReport)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDamageReportRepositoryMockRecorder) Create(ctx, damageReport any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDamageReportRepository)(nil).Create), ctx, damageReport)
+}
+
+// FindAll mocks base method.
+func (m *MockDamageReportRepository) FindAll(ctx context.Context, params pagination.Params) ([]*model.DamageReport, pagination.Meta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, params)
+	ret0, _ := ret[0].([]*model.DamageReport)
+	ret1, _ := ret[1].(pagination.Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockDamageReportRepositoryMockRecorder) FindAll(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockDamageReportRepository)(nil).FindAll), ctx, params)
+}
+
+// FindByID mocks base method.
+func (m *MockDamageReportRepository) FindByID(ctx context.Context, id int) (*model.DamageReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*model.DamageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockDamageReportRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockDamageReportRepository)(nil).FindByID), ctx, id)
+}
+
+// PrefillFromPhoto mocks base method.
+func (m *MockDamageReportRepository) PrefillFromPhoto(ctx context.Context, id int, latitude, longitude float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrefillFromPhoto", ctx, id, latitude, longitude)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PrefillFromPhoto indicates an expected call of PrefillFromPhoto.
+func (mr *MockDamageReportRepositoryMockRecorder) PrefillFromPhoto(ctx, id, latitude, longitude any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrefillFromPhoto", reflect.TypeOf((*MockDamageReportRepository)(nil).PrefillFromPhoto), ctx, id, latitude, longitude)
+}
+
+// Transition mocks base method.
+func (m *MockDamageReportRepository) Transition(ctx context.Context, id int, from, to string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transition", ctx, id, from, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transition indicates an expected call of Transition.
+func (mr *MockDamageReportRepositoryMockRecorder) Transition(ctx, id, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transition", reflect.TypeOf((*MockDamageReportRepository)(nil).Transition), ctx, id, from, to)
+}
+
+// Update mocks base method.
+func (m *MockDamageReportRepository) Update(ctx context.Context, damageReport *model.DamageReport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, damageReport)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDamageReportRepositoryMockRecorder) Update(ctx, damageReport any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDamageReportRepository)(nil).Update), ctx, damageReport)
+}
@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: damage_report_attachment.go
+//
+// Generated by this command:
+//
+//	mockgen -source=damage_report_attachment.go -destination=../../../tests/mock/domain/damage_report_attachment.mock.go
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	context "context"
+	model "g_gen/internal/domain/model"
+	pagination "g_gen/internal/pagination"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDamageReportAttachmentRepository is a mock of DamageReportAttachmentRepository interface.
+type MockDamageReportAttachmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDamageReportAttachmentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockDamageReportAttachmentRepositoryMockRecorder is the mock recorder for MockDamageReportAttachmentRepository.
+type MockDamageReportAttachmentRepositoryMockRecorder struct {
+	mock *MockDamageReportAttachmentRepository
+}
+
+// NewMockDamageReportAttachmentRepository creates a new mock instance.
+func NewMockDamageReportAttachmentRepository(ctrl *gomock.Controller) *MockDamageReportAttachmentRepository {
+	mock := &MockDamageReportAttachmentRepository{ctrl: ctrl}
+	mock.recorder = &MockDamageReportAttachmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDamageReportAttachmentRepository) EXPECT() *MockDamageReportAttachmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDamageReportAttachmentRepository) Create(ctx context.Context, attachment *model.DamageReportAttachment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, attachment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDamageReportAttachmentRepositoryMockRecorder) Create(ctx, attachment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDamageReportAttachmentRepository)(nil).Create), ctx, attachment)
+}
+
+// FindByDamageReportID mocks base method.
+func (m *MockDamageReportAttachmentRepository) FindByDamageReportID(ctx context.Context, damageReportID int, params pagination.Params) ([]*model.DamageReportAttachment, pagination.Meta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByDamageReportID", ctx, damageReportID, params)
+	ret0, _ := ret[0].([]*model.DamageReportAttachment)
+	ret1, _ := ret[1].(pagination.Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByDamageReportID indicates an expected call of FindByDamageReportID.
+func (mr *MockDamageReportAttachmentRepositoryMockRecorder) FindByDamageReportID(ctx, damageReportID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByDamageReportID", reflect.TypeOf((*MockDamageReportAttachmentRepository)(nil).FindByDamageReportID), ctx, damageReportID, params)
+}
+
+// FindByID mocks base method.
+func (m *MockDamageReportAttachmentRepository) FindByID(ctx context.Context, damageReportID, id int) (*model.DamageReportAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, damageReportID, id)
+	ret0, _ := ret[0].(*model.DamageReportAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockDamageReportAttachmentRepositoryMockRecorder) FindByID(ctx, damageReportID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockDamageReportAttachmentRepository)(nil).FindByID), ctx, damageReportID, id)
+}
+
+// UpdatePhotoMetadata mocks base method.
+func (m *MockDamageReportAttachmentRepository) UpdatePhotoMetadata(ctx context.Context, attachment *model.DamageReportAttachment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePhotoMetadata", ctx, attachment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePhotoMetadata indicates an expected call of UpdatePhotoMetadata.
+func (mr *MockDamageReportAttachmentRepositoryMockRecorder) UpdatePhotoMetadata(ctx, attachment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePhotoMetadata", reflect.TypeOf((*MockDamageReportAttachmentRepository)(nil).UpdatePhotoMetadata), ctx, attachment)
+}
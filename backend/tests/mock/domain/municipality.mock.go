@@ -11,9 +11,10 @@ package mock_domain
 
 import (
 	context "context"
-	reflect "reflect"
-
 	model "g_gen/internal/domain/model"
+	domain "g_gen/internal/domain/repository"
+	pagination "g_gen/internal/pagination"
+	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -22,6 +23,7 @@ import (
 type MockMunicipality struct {
 	ctrl     *gomock.Controller
 	recorder *MockMunicipalityMockRecorder
+	isgomock struct{}
 }
 
 // MockMunicipalityMockRecorder is the mock recorder for MockMunicipality.
@@ -42,18 +44,19 @@ func (m *MockMunicipality) EXPECT() *MockMunicipalityMockRecorder {
 }
 
 // FindAll mocks base method.
-func (m *MockMunicipality) FindAll(ctx context.Context) ([]*model.Municipality, error) {
+func (m *MockMunicipality) FindAll(ctx context.Context, params pagination.Params) ([]*model.Municipality, pagination.Meta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret := m.ctrl.Call(m, "FindAll", ctx, params)
 	ret0, _ := ret[0].([]*model.Municipality)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(pagination.Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // FindAll indicates an expected call of FindAll.
-func (mr *MockMunicipalityMockRecorder) FindAll(ctx any) *gomock.Call {
+func (mr *MockMunicipalityMockRecorder) FindAll(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockMunicipality)(nil).FindAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockMunicipality)(nil).FindAll), ctx, params)
 }
 
 // FindByID mocks base method.
@@ -71,17 +74,63 @@ func (mr *MockMunicipalityMockRecorder) FindByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockMunicipality)(nil).FindByID), ctx, id)
 }
 
+// FindByOrganizationCode mocks base method.
+func (m *MockMunicipality) FindByOrganizationCode(ctx context.Context, organizationCode string) (*model.Municipality, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByOrganizationCode", ctx, organizationCode)
+	ret0, _ := ret[0].(*model.Municipality)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByOrganizationCode indicates an expected call of FindByOrganizationCode.
+func (mr *MockMunicipalityMockRecorder) FindByOrganizationCode(ctx, organizationCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOrganizationCode", reflect.TypeOf((*MockMunicipality)(nil).FindByOrganizationCode), ctx, organizationCode)
+}
+
 // FindByPrefectureCode mocks base method.
-func (m *MockMunicipality) FindByPrefectureCode(ctx context.Context, prefectureCode string) ([]*model.Municipality, error) {
+func (m *MockMunicipality) FindByPrefectureCode(ctx context.Context, prefectureCode string, params pagination.Params) ([]*model.Municipality, pagination.Meta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByPrefectureCode", ctx, prefectureCode)
+	ret := m.ctrl.Call(m, "FindByPrefectureCode", ctx, prefectureCode, params)
+	ret0, _ := ret[0].([]*model.Municipality)
+	ret1, _ := ret[1].(pagination.Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByPrefectureCode indicates an expected call of FindByPrefectureCode.
+func (mr *MockMunicipalityMockRecorder) FindByPrefectureCode(ctx, prefectureCode, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPrefectureCode", reflect.TypeOf((*MockMunicipality)(nil).FindByPrefectureCode), ctx, prefectureCode, params)
+}
+
+// Search mocks base method.
+func (m *MockMunicipality) Search(ctx context.Context, cond domain.MunicipalitySearchCondition) ([]*model.Municipality, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, cond)
 	ret0, _ := ret[0].([]*model.Municipality)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindByPrefectureCode indicates an expected call of FindByPrefectureCode.
-func (mr *MockMunicipalityMockRecorder) FindByPrefectureCode(ctx, prefectureCode any) *gomock.Call {
+// Search indicates an expected call of Search.
+func (mr *MockMunicipalityMockRecorder) Search(ctx, cond any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockMunicipality)(nil).Search), ctx, cond)
+}
+
+// Statistics mocks base method.
+func (m *MockMunicipality) Statistics(ctx context.Context) (*domain.MunicipalityStatistics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Statistics", ctx)
+	ret0, _ := ret[0].(*domain.MunicipalityStatistics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Statistics indicates an expected call of Statistics.
+func (mr *MockMunicipalityMockRecorder) Statistics(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPrefectureCode", reflect.TypeOf((*MockMunicipality)(nil).FindByPrefectureCode), ctx, prefectureCode)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Statistics", reflect.TypeOf((*MockMunicipality)(nil).Statistics), ctx)
 }
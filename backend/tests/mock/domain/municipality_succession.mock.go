@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: municipality_succession.go
+//
+// Generated by this command:
+//
+//	mockgen -source=municipality_succession.go -destination=../../../tests/mock/domain/municipality_succession.mock.go
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	context "context"
+	model "g_gen/internal/domain/model"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMunicipalitySuccessionRepository is a mock of MunicipalitySuccessionRepository interface.
+type MockMunicipalitySuccessionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMunicipalitySuccessionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMunicipalitySuccessionRepositoryMockRecorder is the mock recorder for MockMunicipalitySuccessionRepository.
+type MockMunicipalitySuccessionRepositoryMockRecorder struct {
+	mock *MockMunicipalitySuccessionRepository
+}
+
+// NewMockMunicipalitySuccessionRepository creates a new mock instance.
+func NewMockMunicipalitySuccessionRepository(ctrl *gomock.Controller) *MockMunicipalitySuccessionRepository {
+	mock := &MockMunicipalitySuccessionRepository{ctrl: ctrl}
+	mock.recorder = &MockMunicipalitySuccessionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMunicipalitySuccessionRepository) EXPECT() *MockMunicipalitySuccessionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindEffectiveByPredecessorCode mocks base method.
+func (m *MockMunicipalitySuccessionRepository) FindEffectiveByPredecessorCode(ctx context.Context, predecessorCode string, at time.Time) ([]*model.MunicipalitySuccession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEffectiveByPredecessorCode", ctx, predecessorCode, at)
+	ret0, _ := ret[0].([]*model.MunicipalitySuccession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEffectiveByPredecessorCode indicates an expected call of FindEffectiveByPredecessorCode.
+func (mr *MockMunicipalitySuccessionRepositoryMockRecorder) FindEffectiveByPredecessorCode(ctx, predecessorCode, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEffectiveByPredecessorCode", reflect.TypeOf((*MockMunicipalitySuccessionRepository)(nil).FindEffectiveByPredecessorCode), ctx, predecessorCode, at)
+}
@@ -11,9 +11,9 @@ package mock_domain
 
 import (
 	context "context"
-	reflect "reflect"
-
 	model "g_gen/internal/domain/model"
+	pagination "g_gen/internal/pagination"
+	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -22,6 +22,7 @@ import (
 type MockPrefectureRepository struct {
 	ctrl     *gomock.Controller
 	recorder *MockPrefectureRepositoryMockRecorder
+	isgomock struct{}
 }
 
 // MockPrefectureRepositoryMockRecorder is the mock recorder for MockPrefectureRepository.
@@ -42,18 +43,19 @@ func (m *MockPrefectureRepository) EXPECT() *MockPrefectureRepositoryMockRecorde
 }
 
 // FindAll mocks base method.
-func (m *MockPrefectureRepository) FindAll(ctx context.Context) ([]*model.Prefecture, error) {
+func (m *MockPrefectureRepository) FindAll(ctx context.Context, params pagination.Params) ([]*model.Prefecture, pagination.Meta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll", ctx)
+	ret := m.ctrl.Call(m, "FindAll", ctx, params)
 	ret0, _ := ret[0].([]*model.Prefecture)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(pagination.Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // FindAll indicates an expected call of FindAll.
-func (mr *MockPrefectureRepositoryMockRecorder) FindAll(ctx any) *gomock.Call {
+func (mr *MockPrefectureRepositoryMockRecorder) FindAll(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPrefectureRepository)(nil).FindAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPrefectureRepository)(nil).FindAll), ctx, params)
 }
 
 // FindByCode mocks base method.
@@ -70,3 +72,34 @@ func (mr *MockPrefectureRepositoryMockRecorder) FindByCode(ctx, code any) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCode", reflect.TypeOf((*MockPrefectureRepository)(nil).FindByCode), ctx, code)
 }
+
+// FindByRegionID mocks base method.
+func (m *MockPrefectureRepository) FindByRegionID(ctx context.Context, regionID int, params pagination.Params) ([]*model.Prefecture, pagination.Meta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByRegionID", ctx, regionID, params)
+	ret0, _ := ret[0].([]*model.Prefecture)
+	ret1, _ := ret[1].(pagination.Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByRegionID indicates an expected call of FindByRegionID.
+func (mr *MockPrefectureRepositoryMockRecorder) FindByRegionID(ctx, regionID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByRegionID", reflect.TypeOf((*MockPrefectureRepository)(nil).FindByRegionID), ctx, regionID, params)
+}
+
+// FindByRegionIDs mocks base method.
+func (m *MockPrefectureRepository) FindByRegionIDs(ctx context.Context, regionIDs []int) ([]*model.Prefecture, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByRegionIDs", ctx, regionIDs)
+	ret0, _ := ret[0].([]*model.Prefecture)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByRegionIDs indicates an expected call of FindByRegionIDs.
+func (mr *MockPrefectureRepositoryMockRecorder) FindByRegionIDs(ctx, regionIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByRegionIDs", reflect.TypeOf((*MockPrefectureRepository)(nil).FindByRegionIDs), ctx, regionIDs)
+}
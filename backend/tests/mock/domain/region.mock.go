@@ -0,0 +1,74 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: region.go
+//
+// Generated by this command:
+//
+//	mockgen -source=region.go -destination=../../../tests/mock/domain/region.mock.go
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	context "context"
+	model "g_gen/internal/domain/model"
+	pagination "g_gen/internal/pagination"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRegionRepository is a mock of RegionRepository interface.
+type MockRegionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRegionRepositoryMockRecorder is the mock recorder for MockRegionRepository.
+type MockRegionRepositoryMockRecorder struct {
+	mock *MockRegionRepository
+}
+
+// NewMockRegionRepository creates a new mock instance.
+func NewMockRegionRepository(ctrl *gomock.Controller) *MockRegionRepository {
+	mock := &MockRegionRepository{ctrl: ctrl}
+	mock.recorder = &MockRegionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegionRepository) EXPECT() *MockRegionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockRegionRepository) FindAll(ctx context.Context, params pagination.Params) ([]*model.Region, pagination.Meta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, params)
+	ret0, _ := ret[0].([]*model.Region)
+	ret1, _ := ret[1].(pagination.Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockRegionRepositoryMockRecorder) FindAll(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockRegionRepository)(nil).FindAll), ctx, params)
+}
+
+// FindByID mocks base method.
+func (m *MockRegionRepository) FindByID(ctx context.Context, id int) (*model.Region, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*model.Region)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockRegionRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockRegionRepository)(nil).FindByID), ctx, id)
+}
@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: support_application.go
+//
+// Generated by this command:
+//
+//	mockgen -source=support_application.go -destination=../../../tests/mock/domain/support_application.mock.go
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	context "context"
+	model "g_gen/internal/domain/model"
+	pagination "g_gen/internal/pagination"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSupportApplicationRepository is a mock of SupportApplicationRepository interface.
+type MockSupportApplicationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSupportApplicationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSupportApplicationRepositoryMockRecorder is the mock recorder for MockSupportApplicationRepository.
+type MockSupportApplicationRepositoryMockRecorder struct {
+	mock *MockSupportApplicationRepository
+}
+
+// NewMockSupportApplicationRepository creates a new mock instance.
+func NewMockSupportApplicationRepository(ctrl *gomock.Controller) *MockSupportApplicationRepository {
+	mock := &MockSupportApplicationRepository{ctrl: ctrl}
+	mock.recorder = &MockSupportApplicationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSupportApplicationRepository) EXPECT() *MockSupportApplicationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSupportApplicationRepository) Create(ctx context.Context, application *model.SupportApplication) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, application)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSupportApplicationRepositoryMockRecorder) Create(ctx, application any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSupportApplicationRepository)(nil).Create), ctx, application)
+}
+
+// FindByID mocks base method.
+func (m *MockSupportApplicationRepository) FindByID(ctx context.Context, id int) (*model.SupportApplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*model.SupportApplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockSupportApplicationRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockSupportApplicationRepository)(nil).FindByID), ctx, id)
+}
+
+// FindTransitions mocks base method.
+func (m *MockSupportApplicationRepository) FindTransitions(ctx context.Context, id int, params pagination.Params) ([]*model.SupportApplicationTransition, pagination.Meta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTransitions", ctx, id, params)
+	ret0, _ := ret[0].([]*model.SupportApplicationTransition)
+	ret1, _ := ret[1].(pagination.Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindTransitions indicates an expected call of FindTransitions.
+func (mr *MockSupportApplicationRepositoryMockRecorder) FindTransitions(ctx, id, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTransitions", reflect.TypeOf((*MockSupportApplicationRepository)(nil).FindTransitions), ctx, id, params)
+}
+
+// Transition mocks base method.
+func (m *MockSupportApplicationRepository) Transition(ctx context.Context, transition *model.SupportApplicationTransition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transition", ctx, transition)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transition indicates an expected call of Transition.
+func (mr *MockSupportApplicationRepositoryMockRecorder) Transition(ctx, transition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transition", reflect.TypeOf((*MockSupportApplicationRepository)(nil).Transition), ctx, transition)
+}
@@ -0,0 +1,53 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: task_runner.go
+//
+// Generated by this command:
+//
+//	mockgen -source=task_runner.go -destination=../../../tests/mock/domain/task_runner.mock.go
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTaskRunner is a mock of TaskRunner interface.
+type MockTaskRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskRunnerMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskRunnerMockRecorder is the mock recorder for MockTaskRunner.
+type MockTaskRunnerMockRecorder struct {
+	mock *MockTaskRunner
+}
+
+// NewMockTaskRunner creates a new mock instance.
+func NewMockTaskRunner(ctrl *gomock.Controller) *MockTaskRunner {
+	mock := &MockTaskRunner{ctrl: ctrl}
+	mock.recorder = &MockTaskRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskRunner) EXPECT() *MockTaskRunnerMockRecorder {
+	return m.recorder
+}
+
+// Go mocks base method.
+func (m *MockTaskRunner) Go(ctx context.Context, name string, fn func(context.Context) error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Go", ctx, name, fn)
+}
+
+// Go indicates an expected call of Go.
+func (mr *MockTaskRunnerMockRecorder) Go(ctx, name, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Go", reflect.TypeOf((*MockTaskRunner)(nil).Go), ctx, name, fn)
+}
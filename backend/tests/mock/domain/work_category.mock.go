@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: work_category.go
+//
+// Generated by this command:
+//
+//	mockgen -source=work_category.go -destination=../../../tests/mock/domain/work_category.mock.go
+//
+
+// Package mock_domain is a generated GoMock package.
+package mock_domain
+
+import (
+	context "context"
+	model "g_gen/internal/domain/model"
+	pagination "g_gen/internal/pagination"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWorkCategoryRepository is a mock of WorkCategoryRepository interface.
+type MockWorkCategoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkCategoryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWorkCategoryRepositoryMockRecorder is the mock recorder for MockWorkCategoryRepository.
+type MockWorkCategoryRepositoryMockRecorder struct {
+	mock *MockWorkCategoryRepository
+}
+
+// NewMockWorkCategoryRepository creates a new mock instance.
+func NewMockWorkCategoryRepository(ctrl *gomock.Controller) *MockWorkCategoryRepository {
+	mock := &MockWorkCategoryRepository{ctrl: ctrl}
+	mock.recorder = &MockWorkCategoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkCategoryRepository) EXPECT() *MockWorkCategoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWorkCategoryRepository) Create(ctx context.Context, workCategory *model.WorkCategory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, workCategory)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWorkCategoryRepositoryMockRecorder) Create(ctx, workCategory any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWorkCategoryRepository)(nil).Create), ctx, workCategory)
+}
+
+// Deactivate mocks base method.
+func (m *MockWorkCategoryRepository) Deactivate(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deactivate", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deactivate indicates an expected call of Deactivate.
+func (mr *MockWorkCategoryRepositoryMockRecorder) Deactivate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deactivate", reflect.TypeOf((*MockWorkCategoryRepository)(nil).Deactivate), ctx, id)
+}
+
+// FindActive mocks base method.
+func (m *MockWorkCategoryRepository) FindActive(ctx context.Context, params pagination.Params) ([]*model.WorkCategory, pagination.Meta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActive", ctx, params)
+	ret0, _ := ret[0].([]*model.WorkCategory)
+	ret1, _ := ret[1].(pagination.Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindActive indicates an expected call of FindActive.
+func (mr *MockWorkCategoryRepositoryMockRecorder) FindActive(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActive", reflect.TypeOf((*MockWorkCategoryRepository)(nil).FindActive), ctx, params)
+}
+
+// FindAllActive mocks base method.
+func (m *MockWorkCategoryRepository) FindAllActive(ctx context.Context) ([]*model.WorkCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllActive", ctx)
+	ret0, _ := ret[0].([]*model.WorkCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllActive indicates an expected call of FindAllActive.
+func (mr *MockWorkCategoryRepositoryMockRecorder) FindAllActive(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllActive", reflect.TypeOf((*MockWorkCategoryRepository)(nil).FindAllActive), ctx)
+}
+
+// FindByID mocks base method.
+func (m *MockWorkCategoryRepository) FindByID(ctx context.Context, id int) (*model.WorkCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*model.WorkCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockWorkCategoryRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockWorkCategoryRepository)(nil).FindByID), ctx, id)
+}
+
+// Reorder mocks base method.
+func (m *MockWorkCategoryRepository) Reorder(ctx context.Context, ids []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reorder", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reorder indicates an expected call of Reorder.
+func (mr *MockWorkCategoryRepositoryMockRecorder) Reorder(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reorder", reflect.TypeOf((*MockWorkCategoryRepository)(nil).Reorder), ctx, ids)
+}
+
+// Update mocks base method.
+func (m *MockWorkCategoryRepository) Update(ctx context.Context, workCategory *model.WorkCategory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, workCategory)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWorkCategoryRepositoryMockRecorder) Update(ctx, workCategory any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWorkCategoryRepository)(nil).Update), ctx, workCategory)
+}
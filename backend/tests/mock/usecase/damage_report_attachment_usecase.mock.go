@@ -0,0 +1,106 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: damage_report_attachment_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=damage_report_attachment_usecase.go -destination=../../tests/mock/usecase/damage_report_attachment_usecase.mock.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	model "g_gen/internal/domain/model"
+	pagination "g_gen/internal/pagination"
+	io "io"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDamageReportAttachmentUseCase is a mock of DamageReportAttachmentUseCase interface.
+type MockDamageReportAttachmentUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDamageReportAttachmentUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockDamageReportAttachmentUseCaseMockRecorder is the mock recorder for MockDamageReportAttachmentUseCase.
+type MockDamageReportAttachmentUseCaseMockRecorder struct {
+	mock *MockDamageReportAttachmentUseCase
+}
+
+// NewMockDamageReportAttachmentUseCase creates a new mock instance.
+func NewMockDamageReportAttachmentUseCase(ctrl *gomock.Controller) *MockDamageReportAttachmentUseCase {
+	mock := &MockDamageReportAttachmentUseCase{ctrl: ctrl}
+	mock.recorder = &MockDamageReportAttachmentUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDamageReportAttachmentUseCase) EXPECT() *MockDamageReportAttachmentUseCaseMockRecorder {
+	return m.recorder
+}
+
+// DownloadAttachment mocks base method.
+func (m *MockDamageReportAttachmentUseCase) DownloadAttachment(ctx context.Context, damageReportID, id int) (*model.DamageReportAttachment, io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadAttachment", ctx, damageReportID, id)
+	ret0, _ := ret[0].(*model.DamageReportAttachment)
+	ret1, _ := ret[1].(io.ReadCloser)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DownloadAttachment indicates an expected call of DownloadAttachment.
+func (mr *MockDamageReportAttachmentUseCaseMockRecorder) DownloadAttachment(ctx, damageReportID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadAttachment", reflect.TypeOf((*MockDamageReportAttachmentUseCase)(nil).DownloadAttachment), ctx, damageReportID, id)
+}
+
+// DownloadThumbnail mocks base method.
+func (m *MockDamageReportAttachmentUseCase) DownloadThumbnail(ctx context.Context, damageReportID, id int) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadThumbnail", ctx, damageReportID, id)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadThumbnail indicates an expected call of DownloadThumbnail.
+func (mr *MockDamageReportAttachmentUseCaseMockRecorder) DownloadThumbnail(ctx, damageReportID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadThumbnail", reflect.TypeOf((*MockDamageReportAttachmentUseCase)(nil).DownloadThumbnail), ctx, damageReportID, id)
+}
+
+// ListAttachments mocks base method.
+func (m *MockDamageReportAttachmentUseCase) ListAttachments(ctx context.Context, damageReportID int, params pagination.Params) ([]*model.DamageReportAttachment, pagination.Meta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttachments", ctx, damageReportID, params)
+	ret0, _ := ret[0].([]*model.DamageReportAttachment)
+	ret1, _ := ret[1].(pagination.Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAttachments indicates an expected call of ListAttachments.
+func (mr *MockDamageReportAttachmentUseCaseMockRecorder) ListAttachments(ctx, damageReportID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttachments", reflect.TypeOf((*MockDamageReportAttachmentUseCase)(nil).ListAttachments), ctx, damageReportID, params)
+}
+
+// UploadAttachment mocks base method.
+func (m *MockDamageReportAttachmentUseCase) UploadAttachment(ctx context.Context, damageReportID int, fileName string, size int64, body io.ReadSeeker) (*model.DamageReportAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadAttachment", ctx, damageReportID, fileName, size, body)
+	ret0, _ := ret[0].(*model.DamageReportAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadAttachment indicates an expected call of UploadAttachment.
+func (mr *MockDamageReportAttachmentUseCaseMockRecorder) UploadAttachment(ctx, damageReportID, fileName, size, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAttachment", reflect.TypeOf((*MockDamageReportAttachmentUseCase)(nil).UploadAttachment), ctx, damageReportID, fileName, size, body)
+}
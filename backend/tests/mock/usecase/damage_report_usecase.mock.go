@@ -0,0 +1,120 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: damage_report_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=damage_report_usecase.go -destination=../../tests/mock/usecase/damage_report_usecase.mock.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	model "g_gen/internal/domain/model"
+	pagination "g_gen/internal/pagination"
+	usecase "g_gen/internal/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDamageReportUseCase is a mock of DamageReportUseCase interface.
+type MockDamageReportUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDamageReportUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockDamageReportUseCaseMockRecorder is the mock recorder for MockDamageReportUseCase.
+type MockDamageReportUseCaseMockRecorder struct {
+	mock *MockDamageReportUseCase
+}
+
+// NewMockDamageReportUseCase creates a new mock instance.
+func NewMockDamageReportUseCase(ctrl *gomock.Controller) *MockDamageReportUseCase {
+	mock := &MockDamageReportUseCase{ctrl: ctrl}
+	mock.recorder = &MockDamageReportUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDamageReportUseCase) EXPECT() *MockDamageReportUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CreateDamageReport mocks base method.
+func (m *MockDamageReportUseCase) CreateDamageReport(ctx context.Context, damageReport *model.DamageReport) (*model.DamageReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDamageReport", ctx, damageReport)
+	ret0, _ := ret[0].(*model.DamageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDamageReport indicates an expected call of CreateDamageReport.
+func (mr *MockDamageReportUseCaseMockRecorder) CreateDamageReport(ctx, damageReport any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDamageReport", reflect.TypeOf((*MockDamageReportUseCase)(nil).CreateDamageReport), ctx, damageReport)
+}
+
+// GetDamageReport mocks base method.
+func (m *MockDamageReportUseCase) GetDamageReport(ctx context.Context, id int) (*model.DamageReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDamageReport", ctx, id)
+	ret0, _ := ret[0].(*model.DamageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDamageReport indicates an expected call of GetDamageReport.
+func (mr *MockDamageReportUseCaseMockRecorder) GetDamageReport(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDamageReport", reflect.TypeOf((*MockDamageReportUseCase)(nil).GetDamageReport), ctx, id)
+}
+
+// ListDamageReports mocks base method.
+func (m *MockDamageReportUseCase) ListDamageReports(ctx context.Context, params pagination.Params) ([]*model.DamageReport, pagination.Meta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDamageReports", ctx, params)
+	ret0, _ := ret[0].([]*model.DamageReport)
+	ret1, _ := ret[1].(pagination.Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDamageReports indicates an expected call of ListDamageReports.
+func (mr *MockDamageReportUseCaseMockRecorder) ListDamageReports(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDamageReports", reflect.TypeOf((*MockDamageReportUseCase)(nil).ListDamageReports), ctx, params)
+}
+
+// TransitionDamageReport mocks base method.
+func (m *MockDamageReportUseCase) TransitionDamageReport(ctx context.Context, id int, action usecase.DamageReportAction) (*model.DamageReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitionDamageReport", ctx, id, action)
+	ret0, _ := ret[0].(*model.DamageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransitionDamageReport indicates an expected call of TransitionDamageReport.
+func (mr *MockDamageReportUseCaseMockRecorder) TransitionDamageReport(ctx, id, action any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionDamageReport", reflect.TypeOf((*MockDamageReportUseCase)(nil).TransitionDamageReport), ctx, id, action)
+}
+
+// UpdateDamageReport mocks base method.
+func (m *MockDamageReportUseCase) UpdateDamageReport(ctx context.Context, damageReport *model.DamageReport) (*model.DamageReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDamageReport", ctx, damageReport)
+	ret0, _ := ret[0].(*model.DamageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDamageReport indicates an expected call of UpdateDamageReport.
+func (mr *MockDamageReportUseCaseMockRecorder) UpdateDamageReport(ctx, damageReport any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDamageReport", reflect.TypeOf((*MockDamageReportUseCase)(nil).UpdateDamageReport), ctx, damageReport)
+}
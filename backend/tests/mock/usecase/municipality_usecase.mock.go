@@ -0,0 +1,137 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: municipality_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=municipality_usecase.go -destination=../../tests/mock/usecase/municipality_usecase.mock.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	model "g_gen/internal/domain/model"
+	domain "g_gen/internal/domain/repository"
+	pagination "g_gen/internal/pagination"
+	usecase "g_gen/internal/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMunicipalityUseCase is a mock of MunicipalityUseCase interface.
+type MockMunicipalityUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockMunicipalityUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockMunicipalityUseCaseMockRecorder is the mock recorder for MockMunicipalityUseCase.
+type MockMunicipalityUseCaseMockRecorder struct {
+	mock *MockMunicipalityUseCase
+}
+
+// NewMockMunicipalityUseCase creates a new mock instance.
+func NewMockMunicipalityUseCase(ctrl *gomock.Controller) *MockMunicipalityUseCase {
+	mock := &MockMunicipalityUseCase{ctrl: ctrl}
+	mock.recorder = &MockMunicipalityUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMunicipalityUseCase) EXPECT() *MockMunicipalityUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetMunicipalityByID mocks base method.
+func (m *MockMunicipalityUseCase) GetMunicipalityByID(ctx context.Context, id int) (*model.Municipality, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMunicipalityByID", ctx, id)
+	ret0, _ := ret[0].(*model.Municipality)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMunicipalityByID indicates an expected call of GetMunicipalityByID.
+func (mr *MockMunicipalityUseCaseMockRecorder) GetMunicipalityByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMunicipalityByID", reflect.TypeOf((*MockMunicipalityUseCase)(nil).GetMunicipalityByID), ctx, id)
+}
+
+// GetMunicipalityStatistics mocks base method.
+func (m *MockMunicipalityUseCase) GetMunicipalityStatistics(ctx context.Context) (*domain.MunicipalityStatistics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMunicipalityStatistics", ctx)
+	ret0, _ := ret[0].(*domain.MunicipalityStatistics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMunicipalityStatistics indicates an expected call of GetMunicipalityStatistics.
+func (mr *MockMunicipalityUseCaseMockRecorder) GetMunicipalityStatistics(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMunicipalityStatistics", reflect.TypeOf((*MockMunicipalityUseCase)(nil).GetMunicipalityStatistics), ctx)
+}
+
+// ListMunicipalities mocks base method.
+func (m *MockMunicipalityUseCase) ListMunicipalities(ctx context.Context, params pagination.Params) ([]*model.Municipality, pagination.Meta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMunicipalities", ctx, params)
+	ret0, _ := ret[0].([]*model.Municipality)
+	ret1, _ := ret[1].(pagination.Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListMunicipalities indicates an expected call of ListMunicipalities.
+func (mr *MockMunicipalityUseCaseMockRecorder) ListMunicipalities(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMunicipalities", reflect.TypeOf((*MockMunicipalityUseCase)(nil).ListMunicipalities), ctx, params)
+}
+
+// ListMunicipalitiesByPrefectureCode mocks base method.
+func (m *MockMunicipalityUseCase) ListMunicipalitiesByPrefectureCode(ctx context.Context, prefectureCode string, params pagination.Params) ([]*model.Municipality, pagination.Meta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMunicipalitiesByPrefectureCode", ctx, prefectureCode, params)
+	ret0, _ := ret[0].([]*model.Municipality)
+	ret1, _ := ret[1].(pagination.Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListMunicipalitiesByPrefectureCode indicates an expected call of ListMunicipalitiesByPrefectureCode.
+func (mr *MockMunicipalityUseCaseMockRecorder) ListMunicipalitiesByPrefectureCode(ctx, prefectureCode, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMunicipalitiesByPrefectureCode", reflect.TypeOf((*MockMunicipalityUseCase)(nil).ListMunicipalitiesByPrefectureCode), ctx, prefectureCode, params)
+}
+
+// ResolveOrganizationCode mocks base method.
+func (m *MockMunicipalityUseCase) ResolveOrganizationCode(ctx context.Context, organizationCode string) (*usecase.MunicipalityResolution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveOrganizationCode", ctx, organizationCode)
+	ret0, _ := ret[0].(*usecase.MunicipalityResolution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveOrganizationCode indicates an expected call of ResolveOrganizationCode.
+func (mr *MockMunicipalityUseCaseMockRecorder) ResolveOrganizationCode(ctx, organizationCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveOrganizationCode", reflect.TypeOf((*MockMunicipalityUseCase)(nil).ResolveOrganizationCode), ctx, organizationCode)
+}
+
+// SearchMunicipalities mocks base method.
+func (m *MockMunicipalityUseCase) SearchMunicipalities(ctx context.Context, keyword, prefectureCode string, limit int) ([]*model.Municipality, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMunicipalities", ctx, keyword, prefectureCode, limit)
+	ret0, _ := ret[0].([]*model.Municipality)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMunicipalities indicates an expected call of SearchMunicipalities.
+func (mr *MockMunicipalityUseCaseMockRecorder) SearchMunicipalities(ctx, keyword, prefectureCode, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMunicipalities", reflect.TypeOf((*MockMunicipalityUseCase)(nil).SearchMunicipalities), ctx, keyword, prefectureCode, limit)
+}
@@ -11,9 +11,10 @@ package mock_usecase
 
 import (
 	context "context"
-	reflect "reflect"
-
 	model "g_gen/internal/domain/model"
+	pagination "g_gen/internal/pagination"
+	usecase "g_gen/internal/usecase"
+	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -22,6 +23,7 @@ import (
 type MockPrefectureUseCase struct {
 	ctrl     *gomock.Controller
 	recorder *MockPrefectureUseCaseMockRecorder
+	isgomock struct{}
 }
 
 // MockPrefectureUseCaseMockRecorder is the mock recorder for MockPrefectureUseCase.
@@ -57,16 +59,49 @@ func (mr *MockPrefectureUseCaseMockRecorder) GetPrefectureByCode(ctx, code any)
 }
 
 // ListPrefectures mocks base method.
-func (m *MockPrefectureUseCase) ListPrefectures(ctx context.Context) ([]*model.Prefecture, error) {
+func (m *MockPrefectureUseCase) ListPrefectures(ctx context.Context, params pagination.Params) ([]*model.Prefecture, pagination.Meta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPrefectures", ctx)
+	ret := m.ctrl.Call(m, "ListPrefectures", ctx, params)
 	ret0, _ := ret[0].([]*model.Prefecture)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(pagination.Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // ListPrefectures indicates an expected call of ListPrefectures.
-func (mr *MockPrefectureUseCaseMockRecorder) ListPrefectures(ctx any) *gomock.Call {
+func (mr *MockPrefectureUseCaseMockRecorder) ListPrefectures(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPrefectures", reflect.TypeOf((*MockPrefectureUseCase)(nil).ListPrefectures), ctx, params)
+}
+
+// ListPrefecturesByRegion mocks base method.
+func (m *MockPrefectureUseCase) ListPrefecturesByRegion(ctx context.Context, regionID int, params pagination.Params) ([]*model.Prefecture, pagination.Meta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPrefecturesByRegion", ctx, regionID, params)
+	ret0, _ := ret[0].([]*model.Prefecture)
+	ret1, _ := ret[1].(pagination.Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListPrefecturesByRegion indicates an expected call of ListPrefecturesByRegion.
+func (mr *MockPrefectureUseCaseMockRecorder) ListPrefecturesByRegion(ctx, regionID, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPrefecturesByRegion", reflect.TypeOf((*MockPrefectureUseCase)(nil).ListPrefecturesByRegion), ctx, regionID, params)
+}
+
+// ListPrefecturesGroupedByRegion mocks base method.
+func (m *MockPrefectureUseCase) ListPrefecturesGroupedByRegion(ctx context.Context, params pagination.Params) ([]*usecase.RegionPrefectures, pagination.Meta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPrefecturesGroupedByRegion", ctx, params)
+	ret0, _ := ret[0].([]*usecase.RegionPrefectures)
+	ret1, _ := ret[1].(pagination.Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListPrefecturesGroupedByRegion indicates an expected call of ListPrefecturesGroupedByRegion.
+func (mr *MockPrefectureUseCaseMockRecorder) ListPrefecturesGroupedByRegion(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPrefectures", reflect.TypeOf((*MockPrefectureUseCase)(nil).ListPrefectures), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPrefecturesGroupedByRegion", reflect.TypeOf((*MockPrefectureUseCase)(nil).ListPrefecturesGroupedByRegion), ctx, params)
 }
@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: region_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=region_usecase.go -destination=../../tests/mock/usecase/region_usecase.mock.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	model "g_gen/internal/domain/model"
+	pagination "g_gen/internal/pagination"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRegionUseCase is a mock of RegionUseCase interface.
+type MockRegionUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegionUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRegionUseCaseMockRecorder is the mock recorder for MockRegionUseCase.
+type MockRegionUseCaseMockRecorder struct {
+	mock *MockRegionUseCase
+}
+
+// NewMockRegionUseCase creates a new mock instance.
+func NewMockRegionUseCase(ctrl *gomock.Controller) *MockRegionUseCase {
+	mock := &MockRegionUseCase{ctrl: ctrl}
+	mock.recorder = &MockRegionUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegionUseCase) EXPECT() *MockRegionUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ListRegions mocks base method.
+func (m *MockRegionUseCase) ListRegions(ctx context.Context, params pagination.Params) ([]*model.Region, pagination.Meta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRegions", ctx, params)
+	ret0, _ := ret[0].([]*model.Region)
+	ret1, _ := ret[1].(pagination.Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRegions indicates an expected call of ListRegions.
+func (mr *MockRegionUseCaseMockRecorder) ListRegions(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegions", reflect.TypeOf((*MockRegionUseCase)(nil).ListRegions), ctx, params)
+}
@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: support_application_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=support_application_usecase.go -destination=../../tests/mock/usecase/support_application_usecase.mock.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	model "g_gen/internal/domain/model"
+	pagination "g_gen/internal/pagination"
+	usecase "g_gen/internal/usecase"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSupportApplicationUseCase is a mock of SupportApplicationUseCase interface.
+type MockSupportApplicationUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSupportApplicationUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockSupportApplicationUseCaseMockRecorder is the mock recorder for MockSupportApplicationUseCase.
+type MockSupportApplicationUseCaseMockRecorder struct {
+	mock *MockSupportApplicationUseCase
+}
+
+// NewMockSupportApplicationUseCase creates a new mock instance.
+func NewMockSupportApplicationUseCase(ctrl *gomock.Controller) *MockSupportApplicationUseCase {
+	mock := &MockSupportApplicationUseCase{ctrl: ctrl}
+	mock.recorder = &MockSupportApplicationUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSupportApplicationUseCase) EXPECT() *MockSupportApplicationUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CreateSupportApplication mocks base method.
+func (m *MockSupportApplicationUseCase) CreateSupportApplication(ctx context.Context, application *model.SupportApplication) (*model.SupportApplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSupportApplication", ctx, application)
+	ret0, _ := ret[0].(*model.SupportApplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSupportApplication indicates an expected call of CreateSupportApplication.
+func (mr *MockSupportApplicationUseCaseMockRecorder) CreateSupportApplication(ctx, application any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSupportApplication", reflect.TypeOf((*MockSupportApplicationUseCase)(nil).CreateSupportApplication), ctx, application)
+}
+
+// GetSupportApplication mocks base method.
+func (m *MockSupportApplicationUseCase) GetSupportApplication(ctx context.Context, id int) (*model.SupportApplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupportApplication", ctx, id)
+	ret0, _ := ret[0].(*model.SupportApplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupportApplication indicates an expected call of GetSupportApplication.
+func (mr *MockSupportApplicationUseCaseMockRecorder) GetSupportApplication(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupportApplication", reflect.TypeOf((*MockSupportApplicationUseCase)(nil).GetSupportApplication), ctx, id)
+}
+
+// ListSupportApplicationTransitions mocks base method.
+func (m *MockSupportApplicationUseCase) ListSupportApplicationTransitions(ctx context.Context, id int, params pagination.Params) ([]*model.SupportApplicationTransition, pagination.Meta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSupportApplicationTransitions", ctx, id, params)
+	ret0, _ := ret[0].([]*model.SupportApplicationTransition)
+	ret1, _ := ret[1].(pagination.Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListSupportApplicationTransitions indicates an expected call of ListSupportApplicationTransitions.
+func (mr *MockSupportApplicationUseCaseMockRecorder) ListSupportApplicationTransitions(ctx, id, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSupportApplicationTransitions", reflect.TypeOf((*MockSupportApplicationUseCase)(nil).ListSupportApplicationTransitions), ctx, id, params)
+}
+
+// TransitionSupportApplication mocks base method.
+func (m *MockSupportApplicationUseCase) TransitionSupportApplication(ctx context.Context, id int, action usecase.SupportApplicationAction, role string, comment *string) (*model.SupportApplication, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitionSupportApplication", ctx, id, action, role, comment)
+	ret0, _ := ret[0].(*model.SupportApplication)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransitionSupportApplication indicates an expected call of TransitionSupportApplication.
+func (mr *MockSupportApplicationUseCaseMockRecorder) TransitionSupportApplication(ctx, id, action, role, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionSupportApplication", reflect.TypeOf((*MockSupportApplicationUseCase)(nil).TransitionSupportApplication), ctx, id, action, role, comment)
+}
@@ -0,0 +1,133 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: work_category_usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=work_category_usecase.go -destination=../../tests/mock/usecase/work_category_usecase.mock.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	model "g_gen/internal/domain/model"
+	pagination "g_gen/internal/pagination"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWorkCategoryUseCase is a mock of WorkCategoryUseCase interface.
+type MockWorkCategoryUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkCategoryUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockWorkCategoryUseCaseMockRecorder is the mock recorder for MockWorkCategoryUseCase.
+type MockWorkCategoryUseCaseMockRecorder struct {
+	mock *MockWorkCategoryUseCase
+}
+
+// NewMockWorkCategoryUseCase creates a new mock instance.
+func NewMockWorkCategoryUseCase(ctrl *gomock.Controller) *MockWorkCategoryUseCase {
+	mock := &MockWorkCategoryUseCase{ctrl: ctrl}
+	mock.recorder = &MockWorkCategoryUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkCategoryUseCase) EXPECT() *MockWorkCategoryUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CreateWorkCategory mocks base method.
+func (m *MockWorkCategoryUseCase) CreateWorkCategory(ctx context.Context, workCategory *model.WorkCategory) (*model.WorkCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkCategory", ctx, workCategory)
+	ret0, _ := ret[0].(*model.WorkCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWorkCategory indicates an expected call of CreateWorkCategory.
+func (mr *MockWorkCategoryUseCaseMockRecorder) CreateWorkCategory(ctx, workCategory any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkCategory", reflect.TypeOf((*MockWorkCategoryUseCase)(nil).CreateWorkCategory), ctx, workCategory)
+}
+
+// DeactivateWorkCategory mocks base method.
+func (m *MockWorkCategoryUseCase) DeactivateWorkCategory(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateWorkCategory", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeactivateWorkCategory indicates an expected call of DeactivateWorkCategory.
+func (mr *MockWorkCategoryUseCaseMockRecorder) DeactivateWorkCategory(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateWorkCategory", reflect.TypeOf((*MockWorkCategoryUseCase)(nil).DeactivateWorkCategory), ctx, id)
+}
+
+// GetWorkCategory mocks base method.
+func (m *MockWorkCategoryUseCase) GetWorkCategory(ctx context.Context, id int) (*model.WorkCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkCategory", ctx, id)
+	ret0, _ := ret[0].(*model.WorkCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkCategory indicates an expected call of GetWorkCategory.
+func (mr *MockWorkCategoryUseCaseMockRecorder) GetWorkCategory(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkCategory", reflect.TypeOf((*MockWorkCategoryUseCase)(nil).GetWorkCategory), ctx, id)
+}
+
+// ListWorkCategories mocks base method.
+func (m *MockWorkCategoryUseCase) ListWorkCategories(ctx context.Context, params pagination.Params) ([]*model.WorkCategory, pagination.Meta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkCategories", ctx, params)
+	ret0, _ := ret[0].([]*model.WorkCategory)
+	ret1, _ := ret[1].(pagination.Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListWorkCategories indicates an expected call of ListWorkCategories.
+func (mr *MockWorkCategoryUseCaseMockRecorder) ListWorkCategories(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkCategories", reflect.TypeOf((*MockWorkCategoryUseCase)(nil).ListWorkCategories), ctx, params)
+}
+
+// ReorderWorkCategories mocks base method.
+func (m *MockWorkCategoryUseCase) ReorderWorkCategories(ctx context.Context, ids []int) ([]*model.WorkCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderWorkCategories", ctx, ids)
+	ret0, _ := ret[0].([]*model.WorkCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderWorkCategories indicates an expected call of ReorderWorkCategories.
+func (mr *MockWorkCategoryUseCaseMockRecorder) ReorderWorkCategories(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderWorkCategories", reflect.TypeOf((*MockWorkCategoryUseCase)(nil).ReorderWorkCategories), ctx, ids)
+}
+
+// UpdateWorkCategory mocks base method.
+func (m *MockWorkCategoryUseCase) UpdateWorkCategory(ctx context.Context, workCategory *model.WorkCategory) (*model.WorkCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkCategory", ctx, workCategory)
+	ret0, _ := ret[0].(*model.WorkCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkCategory indicates an expected call of UpdateWorkCategory.
+func (mr *MockWorkCategoryUseCaseMockRecorder) UpdateWorkCategory(ctx, workCategory any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkCategory", reflect.TypeOf((*MockWorkCategoryUseCase)(nil).UpdateWorkCategory), ctx, workCategory)
+}
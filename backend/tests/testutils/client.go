@@ -2,7 +2,9 @@ package testutils
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"gorm.io/driver/postgres"
@@ -15,11 +17,57 @@ import (
 
 // SetupTestDB テスト用のDBクライアントをセットアップする
 func SetupTestDB(t *testing.T) db.Client {
+	client, err := db.NewSQLHandler(testDBConfig(t), applogger.New(applogger.DefaultConfig()))
+	if err != nil {
+		t.Errorf("failed to connect to test database: %v", err)
+	}
+
+	return client
+}
+
+// SetupEmptyTestDB テスト用のDBサーバーに空のデータベースを作成し、そのDBクライアントを返す
+// マイグレーションの往復のように、他のテストと同じデータベースで行うとスキーマが変わるテストに使う。データベースはテストの終了時に削除する
+func SetupEmptyTestDB(t *testing.T) db.Client {
+	t.Helper()
+
+	config := testDBConfig(t)
+	admin, err := db.NewSQLHandler(config, applogger.New(applogger.DefaultConfig()))
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+
+	name := fmt.Sprintf("%s_%d", config.DBName, time.Now().UnixNano())
+	if err := admin.Conn(context.Background()).Exec(fmt.Sprintf("CREATE DATABASE %q", name)).Error; err != nil {
+		admin.Close()
+		t.Fatalf("failed to create database: %v", err)
+	}
+
+	config.DBName = name
+	client, err := db.NewSQLHandler(config, applogger.New(applogger.DefaultConfig()))
+	t.Cleanup(func() {
+		if client != nil {
+			client.Close()
+		}
+		if err := admin.Conn(context.Background()).Exec(fmt.Sprintf("DROP DATABASE IF EXISTS %q WITH (FORCE)", name)).Error; err != nil {
+			t.Errorf("failed to drop database: %v", err)
+		}
+		admin.Close()
+	})
+	if err != nil {
+		t.Fatalf("failed to connect to database: %v", err)
+	}
+
+	return client
+}
+
+// testDBConfig 環境変数からテスト用のDBの接続設定を作成する
+func testDBConfig(t *testing.T) *db.DatabaseConfig {
 	e, err := env.NewValues()
 	if err != nil {
 		t.Fatalf("failed to load environment variables: %v", err)
 	}
-	client, err := db.NewSQLHandler(&db.DatabaseConfig{
+
+	return &db.DatabaseConfig{
 		Host:     e.TestDB.TestDatabaseHost,
 		Port:     e.TestDB.TestDatabasePort,
 		User:     e.TestDB.TestDatabaseUsername,
@@ -27,12 +75,7 @@ func SetupTestDB(t *testing.T) db.Client {
 		DBName:   e.TestDB.TestDatabaseName,
 		SSLMode:  "disable",
 		Timezone: "Asia/Tokyo",
-	}, applogger.New(applogger.DefaultConfig()))
-	if err != nil {
-		t.Errorf("failed to connect to test database: %v", err)
 	}
-
-	return client
 }
 
 // TruncateAllTables テスト用のDBの全テーブルをトランケートする
@@ -44,7 +87,7 @@ func TruncateAllTables(t *testing.T, client db.Client) {
 	}
 
 	// 全テーブルをトランケート
-	if err := tx.Exec("TRUNCATE TABLE prefectures, municipalities RESTART IDENTITY CASCADE").Error; err != nil {
+	if err := tx.Exec("TRUNCATE TABLE prefectures, municipalities, municipality_successions, work_categories, damage_reports, support_applications, support_application_transitions, damage_report_attachments RESTART IDENTITY CASCADE").Error; err != nil {
 		tx.Rollback()
 		t.Fatalf("failed to truncate tables: %v", err)
 	}
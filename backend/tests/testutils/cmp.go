@@ -0,0 +1,19 @@
+package testutils
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"g_gen/internal/domain/model"
+)
+
+// IgnoreUpdatedAt データベースが設定する作成日時・更新日時を比較対象から除外する
+var IgnoreUpdatedAt = cmp.Options{
+	cmpopts.IgnoreFields(model.Prefecture{}, "UpdatedAt"),
+	cmpopts.IgnoreFields(model.Municipality{}, "UpdatedAt"),
+	cmpopts.IgnoreFields(model.WorkCategory{}, "UpdatedAt"),
+	cmpopts.IgnoreFields(model.DamageReport{}, "CreatedAt", "UpdatedAt"),
+	cmpopts.IgnoreFields(model.SupportApplication{}, "CreatedAt", "UpdatedAt"),
+	cmpopts.IgnoreFields(model.SupportApplicationTransition{}, "CreatedAt"),
+	cmpopts.IgnoreFields(model.DamageReportAttachment{}, "CreatedAt"),
+}